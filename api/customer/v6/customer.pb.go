@@ -21,16 +21,153 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CustomerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AgentId   uint64 `protobuf:"varint,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"` // 0表示在公共池
+	UserId    uint64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Mobile    string `protobuf:"bytes,5,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Stage     int32  `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"` // 0新线索 1带看中 2有意向 3已成交 4已流失
+	District  string `protobuf:"bytes,7,opt,name=district,proto3" json:"district,omitempty"`
+	Rooms     int32  `protobuf:"varint,8,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	BudgetMin int64  `protobuf:"varint,9,opt,name=budget_min,json=budgetMin,proto3" json:"budget_min,omitempty"`
+	BudgetMax int64  `protobuf:"varint,10,opt,name=budget_max,json=budgetMax,proto3" json:"budget_max,omitempty"`
+	Remark    string `protobuf:"bytes,11,opt,name=remark,proto3" json:"remark,omitempty"`
+	RegionId  uint64 `protobuf:"varint,12,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"` // 意向区域，填写时按区域补全 district
+}
+
+func (x *CustomerInfo) Reset() {
+	*x = CustomerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_customer_v6_customer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerInfo) ProtoMessage() {}
+
+func (x *CustomerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_customer_v6_customer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerInfo.ProtoReflect.Descriptor instead.
+func (*CustomerInfo) Descriptor() ([]byte, []int) {
+	return file_api_customer_v6_customer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CustomerInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CustomerInfo) GetAgentId() uint64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+func (x *CustomerInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CustomerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomerInfo) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *CustomerInfo) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *CustomerInfo) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *CustomerInfo) GetRooms() int32 {
+	if x != nil {
+		return x.Rooms
+	}
+	return 0
+}
+
+func (x *CustomerInfo) GetBudgetMin() int64 {
+	if x != nil {
+		return x.BudgetMin
+	}
+	return 0
+}
+
+func (x *CustomerInfo) GetBudgetMax() int64 {
+	if x != nil {
+		return x.BudgetMax
+	}
+	return 0
+}
+
+func (x *CustomerInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CustomerInfo) GetRegionId() uint64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
 type CreateCustomerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Customer *CustomerInfo `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
 }
 
 func (x *CreateCustomerRequest) Reset() {
 	*x = CreateCustomerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_customer_v6_customer_proto_msgTypes[0]
+		mi := &file_api_customer_v6_customer_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -43,7 +180,7 @@ func (x *CreateCustomerRequest) String() string {
 func (*CreateCustomerRequest) ProtoMessage() {}
 
 func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_customer_v6_customer_proto_msgTypes[0]
+	mi := &file_api_customer_v6_customer_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,19 +193,28 @@ func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
 func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_api_customer_v6_customer_proto_rawDescGZIP(), []int{0}
+	return file_api_customer_v6_customer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCustomerRequest) GetCustomer() *CustomerInfo {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
 }
 
 type CreateCustomerReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Customer *CustomerInfo `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
 }
 
 func (x *CreateCustomerReply) Reset() {
 	*x = CreateCustomerReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_customer_v6_customer_proto_msgTypes[1]
+		mi := &file_api_customer_v6_customer_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -81,7 +227,7 @@ func (x *CreateCustomerReply) String() string {
 func (*CreateCustomerReply) ProtoMessage() {}
 
 func (x *CreateCustomerReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_customer_v6_customer_proto_msgTypes[1]
+	mi := &file_api_customer_v6_customer_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -94,7 +240,14 @@ func (x *CreateCustomerReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCustomerReply.ProtoReflect.Descriptor instead.
 func (*CreateCustomerReply) Descriptor() ([]byte, []int) {
-	return file_api_customer_v6_customer_proto_rawDescGZIP(), []int{1}
+	return file_api_customer_v6_customer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCustomerReply) GetCustomer() *CustomerInfo {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
 }
 
 var File_api_customer_v6_customer_proto protoreflect.FileDescriptor
@@ -105,23 +258,50 @@ var file_api_customer_v6_customer_proto_rawDesc = []byte{
 	0x12, 0x0f, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x76,
 	0x36, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
 	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32,
-	0x87, 0x01, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x7b, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x26,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x36,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x36, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x3f, 0x0a, 0x0f, 0x61, 0x70, 0x69,
-	0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x36, 0x42, 0x0f, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x36, 0x50, 0x01, 0x5a,
-	0x19, 0x61, 0x6e, 0x6a, 0x75, 0x6b, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x2f, 0x76, 0x36, 0x3b, 0x76, 0x36, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0xb9, 0x02, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x36, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22,
+	0x50, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x36, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x32, 0x87, 0x01, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x7b,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e,
+	0x76, 0x36, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x36, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x3f, 0x0a, 0x0f, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2e, 0x76, 0x36, 0x42, 0x0f,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x36, 0x50,
+	0x01, 0x5a, 0x19, 0x61, 0x6e, 0x6a, 0x75, 0x6b, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x2f, 0x76, 0x36, 0x3b, 0x76, 0x36, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -136,19 +316,22 @@ func file_api_customer_v6_customer_proto_rawDescGZIP() []byte {
 	return file_api_customer_v6_customer_proto_rawDescData
 }
 
-var file_api_customer_v6_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_customer_v6_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_api_customer_v6_customer_proto_goTypes = []any{
-	(*CreateCustomerRequest)(nil), // 0: api.customer.v6.CreateCustomerRequest
-	(*CreateCustomerReply)(nil),   // 1: api.customer.v6.CreateCustomerReply
+	(*CustomerInfo)(nil),          // 0: api.customer.v6.CustomerInfo
+	(*CreateCustomerRequest)(nil), // 1: api.customer.v6.CreateCustomerRequest
+	(*CreateCustomerReply)(nil),   // 2: api.customer.v6.CreateCustomerReply
 }
 var file_api_customer_v6_customer_proto_depIdxs = []int32{
-	0, // 0: api.customer.v6.Customer.CreateCustomer:input_type -> api.customer.v6.CreateCustomerRequest
-	1, // 1: api.customer.v6.Customer.CreateCustomer:output_type -> api.customer.v6.CreateCustomerReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: api.customer.v6.CreateCustomerRequest.customer:type_name -> api.customer.v6.CustomerInfo
+	0, // 1: api.customer.v6.CreateCustomerReply.customer:type_name -> api.customer.v6.CustomerInfo
+	1, // 2: api.customer.v6.Customer.CreateCustomer:input_type -> api.customer.v6.CreateCustomerRequest
+	2, // 3: api.customer.v6.Customer.CreateCustomer:output_type -> api.customer.v6.CreateCustomerReply
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_api_customer_v6_customer_proto_init() }
@@ -158,7 +341,7 @@ func file_api_customer_v6_customer_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_api_customer_v6_customer_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateCustomerRequest); i {
+			switch v := v.(*CustomerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -170,6 +353,18 @@ func file_api_customer_v6_customer_proto_init() {
 			}
 		}
 		file_api_customer_v6_customer_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateCustomerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_customer_v6_customer_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*CreateCustomerReply); i {
 			case 0:
 				return &v.state
@@ -188,7 +383,7 @@ func file_api_customer_v6_customer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_customer_v6_customer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
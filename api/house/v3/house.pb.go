@@ -21,16 +21,330 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type HouseInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       uint64   `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ListingType   int32    `protobuf:"varint,3,opt,name=listing_type,json=listingType,proto3" json:"listing_type,omitempty"` // 0出售 1出租
+	Title         string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	City          string   `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	District      string   `protobuf:"bytes,7,opt,name=district,proto3" json:"district,omitempty"`
+	CommunityName string   `protobuf:"bytes,8,opt,name=community_name,json=communityName,proto3" json:"community_name,omitempty"`
+	Rooms         int32    `protobuf:"varint,9,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	Halls         int32    `protobuf:"varint,10,opt,name=halls,proto3" json:"halls,omitempty"`
+	Baths         int32    `protobuf:"varint,11,opt,name=baths,proto3" json:"baths,omitempty"`
+	Area          float64  `protobuf:"fixed64,12,opt,name=area,proto3" json:"area,omitempty"` // 建筑面积（㎡）
+	Floor         int32    `protobuf:"varint,13,opt,name=floor,proto3" json:"floor,omitempty"`
+	TotalFloors   int32    `protobuf:"varint,14,opt,name=total_floors,json=totalFloors,proto3" json:"total_floors,omitempty"`
+	Orientation   string   `protobuf:"bytes,15,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	BuildYear     int32    `protobuf:"varint,16,opt,name=build_year,json=buildYear,proto3" json:"build_year,omitempty"`
+	Price         int64    `protobuf:"varint,17,opt,name=price,proto3" json:"price,omitempty"`                                      // 总价（元），出租房源为月租金
+	UnitPrice     int64    `protobuf:"varint,18,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`             // 单价（元/㎡）
+	Status        int32    `protobuf:"varint,19,opt,name=status,proto3" json:"status,omitempty"`                                    // 0在售 1已售 2下架 3已出租
+	CommunityId   uint64   `protobuf:"varint,20,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`       // 小区，发布时填写则按小区补全城市、区县、小区名称和建成年份
+	RegionId      uint64   `protobuf:"varint,21,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`                // 区域（区县或商圈），为空时按城市和区县名称匹配
+	Tags          []string `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`                                         // 标签，如"满五唯一""近地铁"
+	FavoriteCount int64    `protobuf:"varint,23,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"` // 收藏人数
+	BuildingId    uint64   `protobuf:"varint,24,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`          // 楼栋，须属于所填小区
+	Images        []string `protobuf:"bytes,25,rep,name=images,proto3" json:"images,omitempty"`                                     // 图片地址
+	// 以下为出租房源的信息，出售房源不填
+	MonthlyRent   int64    `protobuf:"varint,26,opt,name=monthly_rent,json=monthlyRent,proto3" json:"monthly_rent,omitempty"`       // 月租金（元），为空取 price
+	DepositMonths int32    `protobuf:"varint,27,opt,name=deposit_months,json=depositMonths,proto3" json:"deposit_months,omitempty"` // 押几个月，0-3
+	PayMonths     int32    `protobuf:"varint,28,opt,name=pay_months,json=payMonths,proto3" json:"pay_months,omitempty"`             // 付几个月，1-12，为空按月付
+	PaymentCycle  string   `protobuf:"bytes,29,opt,name=payment_cycle,json=paymentCycle,proto3" json:"payment_cycle,omitempty"`     // 押付方式，如"押一付三"，只读
+	RentalMode    int32    `protobuf:"varint,30,opt,name=rental_mode,json=rentalMode,proto3" json:"rental_mode,omitempty"`          // 0整租 1合租
+	ParentId      uint64   `protobuf:"varint,31,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                // 合租房间所在的整套房源，填写时按整套房源补全位置和户型
+	RoomName      string   `protobuf:"bytes,32,opt,name=room_name,json=roomName,proto3" json:"room_name,omitempty"`                 // 合租房间名，如"主卧"
+	Utilities     []string `protobuf:"bytes,33,rep,name=utilities,proto3" json:"utilities,omitempty"`                               // 租金包含的费用：水费 电费 燃气费 网费 物业费 取暖费
+	AvailableFrom string   `protobuf:"bytes,34,opt,name=available_from,json=availableFrom,proto3" json:"available_from,omitempty"`  // 可入住日期，如 2025-07-01，为空即可入住
+}
+
+func (x *HouseInfo) Reset() {
+	*x = HouseInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseInfo) ProtoMessage() {}
+
+func (x *HouseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseInfo.ProtoReflect.Descriptor instead.
+func (*HouseInfo) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HouseInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetListingType() int32 {
+	if x != nil {
+		return x.ListingType
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *HouseInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HouseInfo) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *HouseInfo) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *HouseInfo) GetCommunityName() string {
+	if x != nil {
+		return x.CommunityName
+	}
+	return ""
+}
+
+func (x *HouseInfo) GetRooms() int32 {
+	if x != nil {
+		return x.Rooms
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetHalls() int32 {
+	if x != nil {
+		return x.Halls
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetBaths() int32 {
+	if x != nil {
+		return x.Baths
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetTotalFloors() int32 {
+	if x != nil {
+		return x.TotalFloors
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetOrientation() string {
+	if x != nil {
+		return x.Orientation
+	}
+	return ""
+}
+
+func (x *HouseInfo) GetBuildYear() int32 {
+	if x != nil {
+		return x.BuildYear
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetCommunityId() uint64 {
+	if x != nil {
+		return x.CommunityId
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetRegionId() uint64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *HouseInfo) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetBuildingId() uint64 {
+	if x != nil {
+		return x.BuildingId
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *HouseInfo) GetMonthlyRent() int64 {
+	if x != nil {
+		return x.MonthlyRent
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetDepositMonths() int32 {
+	if x != nil {
+		return x.DepositMonths
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetPayMonths() int32 {
+	if x != nil {
+		return x.PayMonths
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetPaymentCycle() string {
+	if x != nil {
+		return x.PaymentCycle
+	}
+	return ""
+}
+
+func (x *HouseInfo) GetRentalMode() int32 {
+	if x != nil {
+		return x.RentalMode
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *HouseInfo) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *HouseInfo) GetUtilities() []string {
+	if x != nil {
+		return x.Utilities
+	}
+	return nil
+}
+
+func (x *HouseInfo) GetAvailableFrom() string {
+	if x != nil {
+		return x.AvailableFrom
+	}
+	return ""
+}
+
 type CreateHouseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	House *HouseInfo `protobuf:"bytes,1,opt,name=house,proto3" json:"house,omitempty"`
 }
 
 func (x *CreateHouseRequest) Reset() {
 	*x = CreateHouseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_house_v3_house_proto_msgTypes[0]
+		mi := &file_api_house_v3_house_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -43,7 +357,7 @@ func (x *CreateHouseRequest) String() string {
 func (*CreateHouseRequest) ProtoMessage() {}
 
 func (x *CreateHouseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_house_v3_house_proto_msgTypes[0]
+	mi := &file_api_house_v3_house_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,19 +370,28 @@ func (x *CreateHouseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHouseRequest.ProtoReflect.Descriptor instead.
 func (*CreateHouseRequest) Descriptor() ([]byte, []int) {
-	return file_api_house_v3_house_proto_rawDescGZIP(), []int{0}
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateHouseRequest) GetHouse() *HouseInfo {
+	if x != nil {
+		return x.House
+	}
+	return nil
 }
 
 type CreateHouseReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	House *HouseInfo `protobuf:"bytes,1,opt,name=house,proto3" json:"house,omitempty"`
 }
 
 func (x *CreateHouseReply) Reset() {
 	*x = CreateHouseReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_house_v3_house_proto_msgTypes[1]
+		mi := &file_api_house_v3_house_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -81,7 +404,7 @@ func (x *CreateHouseReply) String() string {
 func (*CreateHouseReply) ProtoMessage() {}
 
 func (x *CreateHouseReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_house_v3_house_proto_msgTypes[1]
+	mi := &file_api_house_v3_house_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -94,80 +417,3079 @@ func (x *CreateHouseReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHouseReply.ProtoReflect.Descriptor instead.
 func (*CreateHouseReply) Descriptor() ([]byte, []int) {
-	return file_api_house_v3_house_proto_rawDescGZIP(), []int{1}
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{2}
 }
 
-var File_api_house_v3_house_proto protoreflect.FileDescriptor
+func (x *CreateHouseReply) GetHouse() *HouseInfo {
+	if x != nil {
+		return x.House
+	}
+	return nil
+}
 
-var file_api_house_v3_house_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x68,
-	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x61, 0x70, 0x69, 0x2e,
-	0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x48, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x32, 0x72, 0x0a, 0x05, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
-	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f,
-	0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x48, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x36, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73,
-	0x65, 0x2e, 0x76, 0x33, 0x42, 0x0c, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x56, 0x33, 0x50, 0x01, 0x5a, 0x16, 0x61, 0x6e, 0x6a, 0x75, 0x6b, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x76, 0x33, 0x3b, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+type GetHouseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId uint64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 浏览的用户，填写时记入浏览记录
 }
 
-var (
-	file_api_house_v3_house_proto_rawDescOnce sync.Once
-	file_api_house_v3_house_proto_rawDescData = file_api_house_v3_house_proto_rawDesc
-)
+func (x *GetHouseRequest) Reset() {
+	*x = GetHouseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_house_v3_house_proto_rawDescGZIP() []byte {
-	file_api_house_v3_house_proto_rawDescOnce.Do(func() {
-		file_api_house_v3_house_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_house_v3_house_proto_rawDescData)
-	})
-	return file_api_house_v3_house_proto_rawDescData
+func (x *GetHouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_house_v3_house_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_api_house_v3_house_proto_goTypes = []any{
-	(*CreateHouseRequest)(nil), // 0: api.house.v3.CreateHouseRequest
-	(*CreateHouseReply)(nil),   // 1: api.house.v3.CreateHouseReply
+func (*GetHouseRequest) ProtoMessage() {}
+
+func (x *GetHouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_house_v3_house_proto_depIdxs = []int32{
-	0, // 0: api.house.v3.House.CreateHouse:input_type -> api.house.v3.CreateHouseRequest
-	1, // 1: api.house.v3.House.CreateHouse:output_type -> api.house.v3.CreateHouseReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use GetHouseRequest.ProtoReflect.Descriptor instead.
+func (*GetHouseRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{3}
 }
 
-func init() { file_api_house_v3_house_proto_init() }
-func file_api_house_v3_house_proto_init() {
-	if File_api_house_v3_house_proto != nil {
-		return
+func (x *GetHouseRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_house_v3_house_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateHouseRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *GetHouseRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetHouseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	House *HouseInfo   `protobuf:"bytes,1,opt,name=house,proto3" json:"house,omitempty"`
+	Rooms []*HouseInfo `protobuf:"bytes,2,rep,name=rooms,proto3" json:"rooms,omitempty"` // 合租房源的各个房间
+}
+
+func (x *GetHouseReply) Reset() {
+	*x = GetHouseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHouseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHouseReply) ProtoMessage() {}
+
+func (x *GetHouseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_house_v3_house_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateHouseReply); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHouseReply.ProtoReflect.Descriptor instead.
+func (*GetHouseReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetHouseReply) GetHouse() *HouseInfo {
+	if x != nil {
+		return x.House
+	}
+	return nil
+}
+
+func (x *GetHouseReply) GetRooms() []*HouseInfo {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type SearchHousesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword     string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`                                   // 空格分隔的词都要命中，"两室"与"2室"等价
+	RegionId    uint64 `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`                // 区域，任意层级
+	ListingType *int32 `protobuf:"varint,3,opt,name=listing_type,json=listingType,proto3,oneof" json:"listing_type,omitempty"` // 0出售 1出租，不传不限
+	Rooms       int32  `protobuf:"varint,4,opt,name=rooms,proto3" json:"rooms,omitempty"`
+	MinPrice    int64  `protobuf:"varint,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice    int64  `protobuf:"varint,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Page        int32  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`                         // 从1开始
+	PageSize    int32  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 默认20，最大50
+}
+
+func (x *SearchHousesRequest) Reset() {
+	*x = SearchHousesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchHousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHousesRequest) ProtoMessage() {}
+
+func (x *SearchHousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHousesRequest.ProtoReflect.Descriptor instead.
+func (*SearchHousesRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchHousesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchHousesRequest) GetRegionId() uint64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *SearchHousesRequest) GetListingType() int32 {
+	if x != nil && x.ListingType != nil {
+		return *x.ListingType
+	}
+	return 0
+}
+
+func (x *SearchHousesRequest) GetRooms() int32 {
+	if x != nil {
+		return x.Rooms
+	}
+	return 0
+}
+
+func (x *SearchHousesRequest) GetMinPrice() int64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *SearchHousesRequest) GetMaxPrice() int64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SearchHousesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchHousesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type HouseHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	House *HouseInfo `protobuf:"bytes,1,opt,name=house,proto3" json:"house,omitempty"`
+	// 命中字段的高亮片段，键为 title、description 或 community，命中词以 <mark> 包裹
+	Highlights map[string]string `protobuf:"bytes,2,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HouseHit) Reset() {
+	*x = HouseHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseHit) ProtoMessage() {}
+
+func (x *HouseHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseHit.ProtoReflect.Descriptor instead.
+func (*HouseHit) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HouseHit) GetHouse() *HouseInfo {
+	if x != nil {
+		return x.House
+	}
+	return nil
+}
+
+func (x *HouseHit) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchHousesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint64      `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Hits  []*HouseHit `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"`
+}
+
+func (x *SearchHousesReply) Reset() {
+	*x = SearchHousesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchHousesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHousesReply) ProtoMessage() {}
+
+func (x *SearchHousesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHousesReply.ProtoReflect.Descriptor instead.
+func (*SearchHousesReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchHousesReply) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchHousesReply) GetHits() []*HouseHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type SuggestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // 已输入的内容，如"万科""wanke""wk"；为空时返回热搜词
+	City    string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`       // 城市简称，为空时不限
+	Limit   int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`    // 默认10，最大20
+}
+
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequest) ProtoMessage() {}
+
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SuggestRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // community、district、business_area、station 或 keyword
+	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Id       uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"` // 小区ID或区域ID
+	RegionId uint64 `protobuf:"varint,4,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	City     string `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	Hint     string `protobuf:"bytes,6,opt,name=hint,proto3" json:"hint,omitempty"` // 小区和商圈为区县，区县为城市，地铁站为线路
+}
+
+func (x *SuggestionInfo) Reset() {
+	*x = SuggestionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionInfo) ProtoMessage() {}
+
+func (x *SuggestionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionInfo.ProtoReflect.Descriptor instead.
+func (*SuggestionInfo) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SuggestionInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SuggestionInfo) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SuggestionInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SuggestionInfo) GetRegionId() uint64 {
+	if x != nil {
+		return x.RegionId
+	}
+	return 0
+}
+
+func (x *SuggestionInfo) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *SuggestionInfo) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+type SuggestReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suggestions []*SuggestionInfo `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+}
+
+func (x *SuggestReply) Reset() {
+	*x = SuggestReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestReply) ProtoMessage() {}
+
+func (x *SuggestReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestReply.ProtoReflect.Descriptor instead.
+func (*SuggestReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SuggestReply) GetSuggestions() []*SuggestionInfo {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type ChangeHousePriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // 新总价（元）
+}
+
+func (x *ChangeHousePriceRequest) Reset() {
+	*x = ChangeHousePriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeHousePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeHousePriceRequest) ProtoMessage() {}
+
+func (x *ChangeHousePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeHousePriceRequest.ProtoReflect.Descriptor instead.
+func (*ChangeHousePriceRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChangeHousePriceRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeHousePriceRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ChangeHousePriceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	House *HouseInfo `protobuf:"bytes,1,opt,name=house,proto3" json:"house,omitempty"`
+}
+
+func (x *ChangeHousePriceReply) Reset() {
+	*x = ChangeHousePriceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeHousePriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeHousePriceReply) ProtoMessage() {}
+
+func (x *ChangeHousePriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeHousePriceReply.ProtoReflect.Descriptor instead.
+func (*ChangeHousePriceReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChangeHousePriceReply) GetHouse() *HouseInfo {
+	if x != nil {
+		return x.House
+	}
+	return nil
+}
+
+type PriceChangeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldPrice  int64 `protobuf:"varint,1,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`    // 调整前总价（元）
+	NewPrice  int64 `protobuf:"varint,2,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`    // 调整后总价（元）
+	ChangedAt int64 `protobuf:"varint,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"` // unix秒
+}
+
+func (x *PriceChangeInfo) Reset() {
+	*x = PriceChangeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceChangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChangeInfo) ProtoMessage() {}
+
+func (x *PriceChangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChangeInfo.ProtoReflect.Descriptor instead.
+func (*PriceChangeInfo) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PriceChangeInfo) GetOldPrice() int64 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *PriceChangeInfo) GetNewPrice() int64 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+func (x *PriceChangeInfo) GetChangedAt() int64 {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return 0
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPriceHistoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetPriceHistoryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*PriceChangeInfo `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // 按时间正序
+}
+
+func (x *GetPriceHistoryReply) Reset() {
+	*x = GetPriceHistoryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriceHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryReply) ProtoMessage() {}
+
+func (x *GetPriceHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryReply.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPriceHistoryReply) GetChanges() []*PriceChangeInfo {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type SetPriceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HouseId     uint64 `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+	DropPercent int32  `protobuf:"varint,3,opt,name=drop_percent,json=dropPercent,proto3" json:"drop_percent,omitempty"` // 相对当前总价的降幅（%），1-99，0为取消提醒
+}
+
+func (x *SetPriceAlertRequest) Reset() {
+	*x = SetPriceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceAlertRequest) ProtoMessage() {}
+
+func (x *SetPriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetPriceAlertRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetPriceAlertRequest) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *SetPriceAlertRequest) GetDropPercent() int32 {
+	if x != nil {
+		return x.DropPercent
+	}
+	return 0
+}
+
+type SetPriceAlertReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DropPercent int32 `protobuf:"varint,1,opt,name=drop_percent,json=dropPercent,proto3" json:"drop_percent,omitempty"`
+	BasePrice   int64 `protobuf:"varint,2,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"` // 计算降幅的基准总价（元）
+}
+
+func (x *SetPriceAlertReply) Reset() {
+	*x = SetPriceAlertReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPriceAlertReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceAlertReply) ProtoMessage() {}
+
+func (x *SetPriceAlertReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceAlertReply.ProtoReflect.Descriptor instead.
+func (*SetPriceAlertReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetPriceAlertReply) GetDropPercent() int32 {
+	if x != nil {
+		return x.DropPercent
+	}
+	return 0
+}
+
+func (x *SetPriceAlertReply) GetBasePrice() int64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+type CompareHousesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseIds []uint64 `protobuf:"varint,1,rep,packed,name=house_ids,json=houseIds,proto3" json:"house_ids,omitempty"`
+	UserId   uint64   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CompareHousesRequest) Reset() {
+	*x = CompareHousesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareHousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareHousesRequest) ProtoMessage() {}
+
+func (x *CompareHousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareHousesRequest.ProtoReflect.Descriptor instead.
+func (*CompareHousesRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompareHousesRequest) GetHouseIds() []uint64 {
+	if x != nil {
+		return x.HouseIds
+	}
+	return nil
+}
+
+func (x *CompareHousesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 对比表的一行，values 与 houses 按列对齐
+type CompareRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`           // price、unit_price、area、layout、floor、orientation、age、property_fee、subway
+	Label  string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`       // 展示名称，如"单价"
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`     // 展示值，缺失时为空
+	Best   []int32  `protobuf:"varint,4,rep,packed,name=best,proto3" json:"best,omitempty"` // 最优的列，无优劣或全部相同时为空
+}
+
+func (x *CompareRow) Reset() {
+	*x = CompareRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRow) ProtoMessage() {}
+
+func (x *CompareRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRow.ProtoReflect.Descriptor instead.
+func (*CompareRow) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CompareRow) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CompareRow) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CompareRow) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *CompareRow) GetBest() []int32 {
+	if x != nil {
+		return x.Best
+	}
+	return nil
+}
+
+type CompareHousesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Houses []*HouseInfo  `protobuf:"bytes,1,rep,name=houses,proto3" json:"houses,omitempty"`
+	Rows   []*CompareRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *CompareHousesReply) Reset() {
+	*x = CompareHousesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareHousesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareHousesReply) ProtoMessage() {}
+
+func (x *CompareHousesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareHousesReply.ProtoReflect.Descriptor instead.
+func (*CompareHousesReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CompareHousesReply) GetHouses() []*HouseInfo {
+	if x != nil {
+		return x.Houses
+	}
+	return nil
+}
+
+func (x *CompareHousesReply) GetRows() []*CompareRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type CompareBasketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HouseId uint64 `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+}
+
+func (x *CompareBasketRequest) Reset() {
+	*x = CompareBasketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareBasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareBasketRequest) ProtoMessage() {}
+
+func (x *CompareBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareBasketRequest.ProtoReflect.Descriptor instead.
+func (*CompareBasketRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CompareBasketRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompareBasketRequest) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+type GetCompareBasketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetCompareBasketRequest) Reset() {
+	*x = GetCompareBasketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompareBasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompareBasketRequest) ProtoMessage() {}
+
+func (x *GetCompareBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompareBasketRequest.ProtoReflect.Descriptor instead.
+func (*GetCompareBasketRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCompareBasketRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CompareBasketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseIds []uint64 `protobuf:"varint,1,rep,packed,name=house_ids,json=houseIds,proto3" json:"house_ids,omitempty"` // 按加入顺序
+}
+
+func (x *CompareBasketReply) Reset() {
+	*x = CompareBasketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareBasketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareBasketReply) ProtoMessage() {}
+
+func (x *CompareBasketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareBasketReply.ProtoReflect.Descriptor instead.
+func (*CompareBasketReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CompareBasketReply) GetHouseIds() []uint64 {
+	if x != nil {
+		return x.HouseIds
+	}
+	return nil
+}
+
+type EstimatePriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseId     uint64  `protobuf:"varint,1,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"` // 已有房源，传入时忽略以下属性
+	CommunityId uint64  `protobuf:"varint,2,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	City        string  `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"` // 无小区时按城市和区县估价
+	District    string  `protobuf:"bytes,4,opt,name=district,proto3" json:"district,omitempty"`
+	Area        float64 `protobuf:"fixed64,5,opt,name=area,proto3" json:"area,omitempty"`
+	Floor       int32   `protobuf:"varint,6,opt,name=floor,proto3" json:"floor,omitempty"`
+	TotalFloors int32   `protobuf:"varint,7,opt,name=total_floors,json=totalFloors,proto3" json:"total_floors,omitempty"`
+	Orientation string  `protobuf:"bytes,8,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	BuildYear   int32   `protobuf:"varint,9,opt,name=build_year,json=buildYear,proto3" json:"build_year,omitempty"` // 为0时取小区建成年份
+}
+
+func (x *EstimatePriceRequest) Reset() {
+	*x = EstimatePriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimatePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatePriceRequest) ProtoMessage() {}
+
+func (x *EstimatePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatePriceRequest.ProtoReflect.Descriptor instead.
+func (*EstimatePriceRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EstimatePriceRequest) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *EstimatePriceRequest) GetCommunityId() uint64 {
+	if x != nil {
+		return x.CommunityId
+	}
+	return 0
+}
+
+func (x *EstimatePriceRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *EstimatePriceRequest) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *EstimatePriceRequest) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *EstimatePriceRequest) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *EstimatePriceRequest) GetTotalFloors() int32 {
+	if x != nil {
+		return x.TotalFloors
+	}
+	return 0
+}
+
+func (x *EstimatePriceRequest) GetOrientation() string {
+	if x != nil {
+		return x.Orientation
+	}
+	return ""
+}
+
+func (x *EstimatePriceRequest) GetBuildYear() int32 {
+	if x != nil {
+		return x.BuildYear
+	}
+	return 0
+}
+
+// 估价采用的可比案例
+type Comparable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source            string  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // sale成交 listing挂牌
+	HouseId           uint64  `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+	Area              float64 `protobuf:"fixed64,3,opt,name=area,proto3" json:"area,omitempty"`
+	Floor             int32   `protobuf:"varint,4,opt,name=floor,proto3" json:"floor,omitempty"`
+	TotalFloors       int32   `protobuf:"varint,5,opt,name=total_floors,json=totalFloors,proto3" json:"total_floors,omitempty"`
+	Orientation       string  `protobuf:"bytes,6,opt,name=orientation,proto3" json:"orientation,omitempty"`
+	BuildYear         int32   `protobuf:"varint,7,opt,name=build_year,json=buildYear,proto3" json:"build_year,omitempty"`
+	Price             int64   `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"` // 成交价或挂牌价（元）
+	UnitPrice         int64   `protobuf:"varint,9,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	AdjustedUnitPrice int64   `protobuf:"varint,10,opt,name=adjusted_unit_price,json=adjustedUnitPrice,proto3" json:"adjusted_unit_price,omitempty"` // 按面积、楼层、朝向、房龄修正到估价房源后的单价
+	Weight            float64 `protobuf:"fixed64,11,opt,name=weight,proto3" json:"weight,omitempty"`
+	Date              int64   `protobuf:"varint,12,opt,name=date,proto3" json:"date,omitempty"` // 成交或挂牌时间（unix秒）
+}
+
+func (x *Comparable) Reset() {
+	*x = Comparable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comparable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comparable) ProtoMessage() {}
+
+func (x *Comparable) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comparable.ProtoReflect.Descriptor instead.
+func (*Comparable) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Comparable) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Comparable) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *Comparable) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *Comparable) GetFloor() int32 {
+	if x != nil {
+		return x.Floor
+	}
+	return 0
+}
+
+func (x *Comparable) GetTotalFloors() int32 {
+	if x != nil {
+		return x.TotalFloors
+	}
+	return 0
+}
+
+func (x *Comparable) GetOrientation() string {
+	if x != nil {
+		return x.Orientation
+	}
+	return ""
+}
+
+func (x *Comparable) GetBuildYear() int32 {
+	if x != nil {
+		return x.BuildYear
+	}
+	return 0
+}
+
+func (x *Comparable) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Comparable) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *Comparable) GetAdjustedUnitPrice() int64 {
+	if x != nil {
+		return x.AdjustedUnitPrice
+	}
+	return 0
+}
+
+func (x *Comparable) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Comparable) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+type EstimatePriceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price       int64         `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"` // 估价（元），取整到千元
+	UnitPrice   int64         `protobuf:"varint,2,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Low         int64         `protobuf:"varint,3,opt,name=low,proto3" json:"low,omitempty"` // 估价区间（元）
+	High        int64         `protobuf:"varint,4,opt,name=high,proto3" json:"high,omitempty"`
+	Confidence  int32         `protobuf:"varint,5,opt,name=confidence,proto3" json:"confidence,omitempty"` // 置信度 0-100
+	Scope       string        `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`            // community同小区 district同区县
+	Comparables []*Comparable `protobuf:"bytes,7,rep,name=comparables,proto3" json:"comparables,omitempty"`
+}
+
+func (x *EstimatePriceReply) Reset() {
+	*x = EstimatePriceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimatePriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatePriceReply) ProtoMessage() {}
+
+func (x *EstimatePriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatePriceReply.ProtoReflect.Descriptor instead.
+func (*EstimatePriceReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EstimatePriceReply) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *EstimatePriceReply) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *EstimatePriceReply) GetLow() int64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *EstimatePriceReply) GetHigh() int64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *EstimatePriceReply) GetConfidence() int32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *EstimatePriceReply) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *EstimatePriceReply) GetComparables() []*Comparable {
+	if x != nil {
+		return x.Comparables
+	}
+	return nil
+}
+
+type ModerationReason struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // duplicate重复发布 price价格异常 image图片相似
+	Detail   string   `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	HouseIds []uint64 `protobuf:"varint,3,rep,packed,name=house_ids,json=houseIds,proto3" json:"house_ids,omitempty"` // 相关房源
+	Score    int32    `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ModerationReason) Reset() {
+	*x = ModerationReason{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationReason) ProtoMessage() {}
+
+func (x *ModerationReason) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationReason.ProtoReflect.Descriptor instead.
+func (*ModerationReason) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ModerationReason) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ModerationReason) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ModerationReason) GetHouseIds() []uint64 {
+	if x != nil {
+		return x.HouseIds
+	}
+	return nil
+}
+
+func (x *ModerationReason) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ModerationItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HouseId    uint64              `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+	Status     int32               `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"` // 0待审核 1通过 2驳回
+	Score      int32               `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`   // 可疑程度 0-100
+	Reasons    []*ModerationReason `protobuf:"bytes,5,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	ReviewerId uint64              `protobuf:"varint,6,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Note       string              `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt  int64               `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // 入队时间（unix秒）
+	ReviewedAt int64               `protobuf:"varint,9,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"` // 审核时间（unix秒），未审核为0
+	House      *HouseInfo          `protobuf:"bytes,10,opt,name=house,proto3" json:"house,omitempty"`
+}
+
+func (x *ModerationItem) Reset() {
+	*x = ModerationItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationItem) ProtoMessage() {}
+
+func (x *ModerationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationItem.ProtoReflect.Descriptor instead.
+func (*ModerationItem) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ModerationItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetReasons() []*ModerationReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ModerationItem) GetReviewerId() uint64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetHouse() *HouseInfo {
+	if x != nil {
+		return x.House
+	}
+	return nil
+}
+
+type ListModerationQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                     // 默认0待审核
+	Page     int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 从1开始
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 默认20，最大100
+}
+
+func (x *ListModerationQueueRequest) Reset() {
+	*x = ListModerationQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListModerationQueueRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListModerationQueueRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListModerationQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListModerationQueueReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ModerationItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total int64             `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListModerationQueueReply) Reset() {
+	*x = ListModerationQueueReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModerationQueueReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueReply) ProtoMessage() {}
+
+func (x *ListModerationQueueReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueReply.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListModerationQueueReply) GetItems() []*ModerationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListModerationQueueReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReviewListingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerId uint64 `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Approve    bool   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"` // false 为驳回
+	Note       string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *ReviewListingRequest) Reset() {
+	*x = ReviewListingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewListingRequest) ProtoMessage() {}
+
+func (x *ReviewListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewListingRequest.ProtoReflect.Descriptor instead.
+func (*ReviewListingRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReviewListingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewListingRequest) GetReviewerId() uint64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ReviewListingRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewListingRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReviewListingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ModerationItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ReviewListingReply) Reset() {
+	*x = ReviewListingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewListingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewListingReply) ProtoMessage() {}
+
+func (x *ReviewListingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewListingReply.ProtoReflect.Descriptor instead.
+func (*ReviewListingReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReviewListingReply) GetItem() *ModerationItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CheckListingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseId uint64 `protobuf:"varint,1,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+}
+
+func (x *CheckListingRequest) Reset() {
+	*x = CheckListingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckListingRequest) ProtoMessage() {}
+
+func (x *CheckListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckListingRequest.ProtoReflect.Descriptor instead.
+func (*CheckListingRequest) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CheckListingRequest) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+type CheckListingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queued bool            `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"` // 本次检测可疑，已加入或更新审核队列
+	Item   *ModerationItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CheckListingReply) Reset() {
+	*x = CheckListingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_house_v3_house_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckListingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckListingReply) ProtoMessage() {}
+
+func (x *CheckListingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_house_v3_house_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckListingReply.ProtoReflect.Descriptor instead.
+func (*CheckListingReply) Descriptor() ([]byte, []int) {
+	return file_api_house_v3_house_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CheckListingReply) GetQueued() bool {
+	if x != nil {
+		return x.Queued
+	}
+	return false
+}
+
+func (x *CheckListingReply) GetItem() *ModerationItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_api_house_v3_house_proto protoreflect.FileDescriptor
+
+var file_api_house_v3_house_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x07, 0x0a, 0x09, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x61, 0x6c, 0x6c, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x61, 0x74, 0x68, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x79, 0x65, 0x61, 0x72,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x59, 0x65, 0x61,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x69,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c,
+	0x79, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x79, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x79, 0x63, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x21, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x22, 0x43, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x2e, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x2e, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e,
+	0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72, 0x6f,
+	0x6f, 0x6d, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f,
+	0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x6f, 0x6d, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc0, 0x01, 0x0a,
+	0x08, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x48, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x48, 0x69, 0x74, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x55, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x68, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x48, 0x69, 0x74,
+	0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x22, 0x54, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8d, 0x01, 0x0a,
+	0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x0c,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0b,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33,
+	0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3f, 0x0a, 0x17,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x46, 0x0a,
+	0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x2e, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x6c, 0x64,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x2e, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x6d, 0x0a, 0x14,
+	0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x72, 0x6f, 0x70,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x64, 0x72, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x22, 0x4c, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x60, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x77, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x62,
+	0x65, 0x73, 0x74, 0x22, 0x73, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x06, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52,
+	0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x4a, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x08, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x14,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x59, 0x65, 0x61, 0x72,
+	0x22, 0xde, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x59, 0x65, 0x61, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0xe1, 0x01, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68,
+	0x69, 0x67, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xc7, 0x02, 0x0a, 0x0e, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76,
+	0x33, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x22, 0x65, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x64, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22,
+	0x75, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x46, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x30,
+	0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64,
+	0x22, 0x5d, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x30, 0x0a,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x32,
+	0xf6, 0x0d, 0x0a, 0x05, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f,
+	0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x48, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x67, 0x65, 0x74,
+	0x12, 0x69, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73,
+	0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e,
+	0x76, 0x33, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x5b, 0x0a, 0x07, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x2e, 0x76, 0x33, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e,
+	0x76, 0x33, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x79, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x2f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x74, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x6d, 0x0a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x76, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x2f, 0x61, 0x64, 0x64,
+	0x12, 0x7c, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x7a,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76,
+	0x33, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x12, 0x7b, 0x0a, 0x0d, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x7c, 0x0a, 0x0c, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x36, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x2e, 0x76, 0x33, 0x42, 0x0c, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x56, 0x33, 0x50, 0x01, 0x5a, 0x16, 0x61, 0x6e, 0x6a, 0x75, 0x6b, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x76, 0x33, 0x3b, 0x76, 0x33,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_house_v3_house_proto_rawDescOnce sync.Once
+	file_api_house_v3_house_proto_rawDescData = file_api_house_v3_house_proto_rawDesc
+)
+
+func file_api_house_v3_house_proto_rawDescGZIP() []byte {
+	file_api_house_v3_house_proto_rawDescOnce.Do(func() {
+		file_api_house_v3_house_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_house_v3_house_proto_rawDescData)
+	})
+	return file_api_house_v3_house_proto_rawDescData
+}
+
+var file_api_house_v3_house_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_api_house_v3_house_proto_goTypes = []any{
+	(*HouseInfo)(nil),                  // 0: api.house.v3.HouseInfo
+	(*CreateHouseRequest)(nil),         // 1: api.house.v3.CreateHouseRequest
+	(*CreateHouseReply)(nil),           // 2: api.house.v3.CreateHouseReply
+	(*GetHouseRequest)(nil),            // 3: api.house.v3.GetHouseRequest
+	(*GetHouseReply)(nil),              // 4: api.house.v3.GetHouseReply
+	(*SearchHousesRequest)(nil),        // 5: api.house.v3.SearchHousesRequest
+	(*HouseHit)(nil),                   // 6: api.house.v3.HouseHit
+	(*SearchHousesReply)(nil),          // 7: api.house.v3.SearchHousesReply
+	(*SuggestRequest)(nil),             // 8: api.house.v3.SuggestRequest
+	(*SuggestionInfo)(nil),             // 9: api.house.v3.SuggestionInfo
+	(*SuggestReply)(nil),               // 10: api.house.v3.SuggestReply
+	(*ChangeHousePriceRequest)(nil),    // 11: api.house.v3.ChangeHousePriceRequest
+	(*ChangeHousePriceReply)(nil),      // 12: api.house.v3.ChangeHousePriceReply
+	(*PriceChangeInfo)(nil),            // 13: api.house.v3.PriceChangeInfo
+	(*GetPriceHistoryRequest)(nil),     // 14: api.house.v3.GetPriceHistoryRequest
+	(*GetPriceHistoryReply)(nil),       // 15: api.house.v3.GetPriceHistoryReply
+	(*SetPriceAlertRequest)(nil),       // 16: api.house.v3.SetPriceAlertRequest
+	(*SetPriceAlertReply)(nil),         // 17: api.house.v3.SetPriceAlertReply
+	(*CompareHousesRequest)(nil),       // 18: api.house.v3.CompareHousesRequest
+	(*CompareRow)(nil),                 // 19: api.house.v3.CompareRow
+	(*CompareHousesReply)(nil),         // 20: api.house.v3.CompareHousesReply
+	(*CompareBasketRequest)(nil),       // 21: api.house.v3.CompareBasketRequest
+	(*GetCompareBasketRequest)(nil),    // 22: api.house.v3.GetCompareBasketRequest
+	(*CompareBasketReply)(nil),         // 23: api.house.v3.CompareBasketReply
+	(*EstimatePriceRequest)(nil),       // 24: api.house.v3.EstimatePriceRequest
+	(*Comparable)(nil),                 // 25: api.house.v3.Comparable
+	(*EstimatePriceReply)(nil),         // 26: api.house.v3.EstimatePriceReply
+	(*ModerationReason)(nil),           // 27: api.house.v3.ModerationReason
+	(*ModerationItem)(nil),             // 28: api.house.v3.ModerationItem
+	(*ListModerationQueueRequest)(nil), // 29: api.house.v3.ListModerationQueueRequest
+	(*ListModerationQueueReply)(nil),   // 30: api.house.v3.ListModerationQueueReply
+	(*ReviewListingRequest)(nil),       // 31: api.house.v3.ReviewListingRequest
+	(*ReviewListingReply)(nil),         // 32: api.house.v3.ReviewListingReply
+	(*CheckListingRequest)(nil),        // 33: api.house.v3.CheckListingRequest
+	(*CheckListingReply)(nil),          // 34: api.house.v3.CheckListingReply
+	nil,                                // 35: api.house.v3.HouseHit.HighlightsEntry
+}
+var file_api_house_v3_house_proto_depIdxs = []int32{
+	0,  // 0: api.house.v3.CreateHouseRequest.house:type_name -> api.house.v3.HouseInfo
+	0,  // 1: api.house.v3.CreateHouseReply.house:type_name -> api.house.v3.HouseInfo
+	0,  // 2: api.house.v3.GetHouseReply.house:type_name -> api.house.v3.HouseInfo
+	0,  // 3: api.house.v3.GetHouseReply.rooms:type_name -> api.house.v3.HouseInfo
+	0,  // 4: api.house.v3.HouseHit.house:type_name -> api.house.v3.HouseInfo
+	35, // 5: api.house.v3.HouseHit.highlights:type_name -> api.house.v3.HouseHit.HighlightsEntry
+	6,  // 6: api.house.v3.SearchHousesReply.hits:type_name -> api.house.v3.HouseHit
+	9,  // 7: api.house.v3.SuggestReply.suggestions:type_name -> api.house.v3.SuggestionInfo
+	0,  // 8: api.house.v3.ChangeHousePriceReply.house:type_name -> api.house.v3.HouseInfo
+	13, // 9: api.house.v3.GetPriceHistoryReply.changes:type_name -> api.house.v3.PriceChangeInfo
+	0,  // 10: api.house.v3.CompareHousesReply.houses:type_name -> api.house.v3.HouseInfo
+	19, // 11: api.house.v3.CompareHousesReply.rows:type_name -> api.house.v3.CompareRow
+	25, // 12: api.house.v3.EstimatePriceReply.comparables:type_name -> api.house.v3.Comparable
+	27, // 13: api.house.v3.ModerationItem.reasons:type_name -> api.house.v3.ModerationReason
+	0,  // 14: api.house.v3.ModerationItem.house:type_name -> api.house.v3.HouseInfo
+	28, // 15: api.house.v3.ListModerationQueueReply.items:type_name -> api.house.v3.ModerationItem
+	28, // 16: api.house.v3.ReviewListingReply.item:type_name -> api.house.v3.ModerationItem
+	28, // 17: api.house.v3.CheckListingReply.item:type_name -> api.house.v3.ModerationItem
+	1,  // 18: api.house.v3.House.CreateHouse:input_type -> api.house.v3.CreateHouseRequest
+	3,  // 19: api.house.v3.House.GetHouse:input_type -> api.house.v3.GetHouseRequest
+	5,  // 20: api.house.v3.House.SearchHouses:input_type -> api.house.v3.SearchHousesRequest
+	8,  // 21: api.house.v3.House.Suggest:input_type -> api.house.v3.SuggestRequest
+	11, // 22: api.house.v3.House.ChangeHousePrice:input_type -> api.house.v3.ChangeHousePriceRequest
+	14, // 23: api.house.v3.House.GetPriceHistory:input_type -> api.house.v3.GetPriceHistoryRequest
+	16, // 24: api.house.v3.House.SetPriceAlert:input_type -> api.house.v3.SetPriceAlertRequest
+	18, // 25: api.house.v3.House.CompareHouses:input_type -> api.house.v3.CompareHousesRequest
+	21, // 26: api.house.v3.House.AddCompareHouse:input_type -> api.house.v3.CompareBasketRequest
+	21, // 27: api.house.v3.House.RemoveCompareHouse:input_type -> api.house.v3.CompareBasketRequest
+	22, // 28: api.house.v3.House.GetCompareBasket:input_type -> api.house.v3.GetCompareBasketRequest
+	24, // 29: api.house.v3.House.EstimatePrice:input_type -> api.house.v3.EstimatePriceRequest
+	29, // 30: api.house.v3.House.ListModerationQueue:input_type -> api.house.v3.ListModerationQueueRequest
+	31, // 31: api.house.v3.House.ReviewListing:input_type -> api.house.v3.ReviewListingRequest
+	33, // 32: api.house.v3.House.CheckListing:input_type -> api.house.v3.CheckListingRequest
+	2,  // 33: api.house.v3.House.CreateHouse:output_type -> api.house.v3.CreateHouseReply
+	4,  // 34: api.house.v3.House.GetHouse:output_type -> api.house.v3.GetHouseReply
+	7,  // 35: api.house.v3.House.SearchHouses:output_type -> api.house.v3.SearchHousesReply
+	10, // 36: api.house.v3.House.Suggest:output_type -> api.house.v3.SuggestReply
+	12, // 37: api.house.v3.House.ChangeHousePrice:output_type -> api.house.v3.ChangeHousePriceReply
+	15, // 38: api.house.v3.House.GetPriceHistory:output_type -> api.house.v3.GetPriceHistoryReply
+	17, // 39: api.house.v3.House.SetPriceAlert:output_type -> api.house.v3.SetPriceAlertReply
+	20, // 40: api.house.v3.House.CompareHouses:output_type -> api.house.v3.CompareHousesReply
+	23, // 41: api.house.v3.House.AddCompareHouse:output_type -> api.house.v3.CompareBasketReply
+	23, // 42: api.house.v3.House.RemoveCompareHouse:output_type -> api.house.v3.CompareBasketReply
+	23, // 43: api.house.v3.House.GetCompareBasket:output_type -> api.house.v3.CompareBasketReply
+	26, // 44: api.house.v3.House.EstimatePrice:output_type -> api.house.v3.EstimatePriceReply
+	30, // 45: api.house.v3.House.ListModerationQueue:output_type -> api.house.v3.ListModerationQueueReply
+	32, // 46: api.house.v3.House.ReviewListing:output_type -> api.house.v3.ReviewListingReply
+	34, // 47: api.house.v3.House.CheckListing:output_type -> api.house.v3.CheckListingReply
+	33, // [33:48] is the sub-list for method output_type
+	18, // [18:33] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_api_house_v3_house_proto_init() }
+func file_api_house_v3_house_proto_init() {
+	if File_api_house_v3_house_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_house_v3_house_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*HouseInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateHouseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateHouseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetHouseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetHouseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchHousesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*HouseHit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchHousesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SuggestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SuggestionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SuggestReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeHousePriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeHousePriceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceChangeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPriceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPriceHistoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*SetPriceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*SetPriceAlertReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareHousesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareHousesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareBasketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCompareBasketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareBasketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*EstimatePriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*Comparable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*EstimatePriceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ModerationReason); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ModerationItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ListModerationQueueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ListModerationQueueReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewListingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*ReviewListingReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckListingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_house_v3_house_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckListingReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -179,13 +3501,14 @@ func file_api_house_v3_house_proto_init() {
 			}
 		}
 	}
+	file_api_house_v3_house_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_house_v3_house_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
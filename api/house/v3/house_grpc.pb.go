@@ -19,7 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	House_CreateHouse_FullMethodName = "/api.house.v3.House/CreateHouse"
+	House_CreateHouse_FullMethodName         = "/api.house.v3.House/CreateHouse"
+	House_GetHouse_FullMethodName            = "/api.house.v3.House/GetHouse"
+	House_SearchHouses_FullMethodName        = "/api.house.v3.House/SearchHouses"
+	House_Suggest_FullMethodName             = "/api.house.v3.House/Suggest"
+	House_ChangeHousePrice_FullMethodName    = "/api.house.v3.House/ChangeHousePrice"
+	House_GetPriceHistory_FullMethodName     = "/api.house.v3.House/GetPriceHistory"
+	House_SetPriceAlert_FullMethodName       = "/api.house.v3.House/SetPriceAlert"
+	House_CompareHouses_FullMethodName       = "/api.house.v3.House/CompareHouses"
+	House_AddCompareHouse_FullMethodName     = "/api.house.v3.House/AddCompareHouse"
+	House_RemoveCompareHouse_FullMethodName  = "/api.house.v3.House/RemoveCompareHouse"
+	House_GetCompareBasket_FullMethodName    = "/api.house.v3.House/GetCompareBasket"
+	House_EstimatePrice_FullMethodName       = "/api.house.v3.House/EstimatePrice"
+	House_ListModerationQueue_FullMethodName = "/api.house.v3.House/ListModerationQueue"
+	House_ReviewListing_FullMethodName       = "/api.house.v3.House/ReviewListing"
+	House_CheckListing_FullMethodName        = "/api.house.v3.House/CheckListing"
 )
 
 // HouseClient is the client API for House service.
@@ -27,6 +41,30 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type HouseClient interface {
 	CreateHouse(ctx context.Context, in *CreateHouseRequest, opts ...grpc.CallOption) (*CreateHouseReply, error)
+	GetHouse(ctx context.Context, in *GetHouseRequest, opts ...grpc.CallOption) (*GetHouseReply, error)
+	// 全文检索在售房源
+	SearchHouses(ctx context.Context, in *SearchHousesRequest, opts ...grpc.CallOption) (*SearchHousesReply, error)
+	// 搜索框联想：小区、区县、商圈、地铁站和热搜词，支持拼音和首字母
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestReply, error)
+	// 调整在售房源总价，记入调价历史
+	ChangeHousePrice(ctx context.Context, in *ChangeHousePriceRequest, opts ...grpc.CallOption) (*ChangeHousePriceReply, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryReply, error)
+	// 设置降价提醒，降幅达到阈值时发送站内通知
+	SetPriceAlert(ctx context.Context, in *SetPriceAlertRequest, opts ...grpc.CallOption) (*SetPriceAlertReply, error)
+	// 对比2到4套房源，house_ids 为空时对比用户对比栏中的房源
+	CompareHouses(ctx context.Context, in *CompareHousesRequest, opts ...grpc.CallOption) (*CompareHousesReply, error)
+	// 加入对比栏，最多4套
+	AddCompareHouse(ctx context.Context, in *CompareBasketRequest, opts ...grpc.CallOption) (*CompareBasketReply, error)
+	RemoveCompareHouse(ctx context.Context, in *CompareBasketRequest, opts ...grpc.CallOption) (*CompareBasketReply, error)
+	GetCompareBasket(ctx context.Context, in *GetCompareBasketRequest, opts ...grpc.CallOption) (*CompareBasketReply, error)
+	// 按同小区（不足时同区县）的成交和挂牌案例估价，传 house_id 时估算该房源
+	EstimatePrice(ctx context.Context, in *EstimatePriceRequest, opts ...grpc.CallOption) (*EstimatePriceReply, error)
+	// 审核队列：重复发布、单价偏离小区中位数、图片与其他房源相似的房源，按入队先后排列
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueReply, error)
+	// 审核通过或驳回，驳回的房源下架
+	ReviewListing(ctx context.Context, in *ReviewListingRequest, opts ...grpc.CallOption) (*ReviewListingReply, error)
+	// 重新检测房源，房源发布和修改时会自动检测
+	CheckListing(ctx context.Context, in *CheckListingRequest, opts ...grpc.CallOption) (*CheckListingReply, error)
 }
 
 type houseClient struct {
@@ -47,11 +85,175 @@ func (c *houseClient) CreateHouse(ctx context.Context, in *CreateHouseRequest, o
 	return out, nil
 }
 
+func (c *houseClient) GetHouse(ctx context.Context, in *GetHouseRequest, opts ...grpc.CallOption) (*GetHouseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHouseReply)
+	err := c.cc.Invoke(ctx, House_GetHouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) SearchHouses(ctx context.Context, in *SearchHousesRequest, opts ...grpc.CallOption) (*SearchHousesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchHousesReply)
+	err := c.cc.Invoke(ctx, House_SearchHouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestReply)
+	err := c.cc.Invoke(ctx, House_Suggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) ChangeHousePrice(ctx context.Context, in *ChangeHousePriceRequest, opts ...grpc.CallOption) (*ChangeHousePriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeHousePriceReply)
+	err := c.cc.Invoke(ctx, House_ChangeHousePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryReply)
+	err := c.cc.Invoke(ctx, House_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) SetPriceAlert(ctx context.Context, in *SetPriceAlertRequest, opts ...grpc.CallOption) (*SetPriceAlertReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPriceAlertReply)
+	err := c.cc.Invoke(ctx, House_SetPriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) CompareHouses(ctx context.Context, in *CompareHousesRequest, opts ...grpc.CallOption) (*CompareHousesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareHousesReply)
+	err := c.cc.Invoke(ctx, House_CompareHouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) AddCompareHouse(ctx context.Context, in *CompareBasketRequest, opts ...grpc.CallOption) (*CompareBasketReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareBasketReply)
+	err := c.cc.Invoke(ctx, House_AddCompareHouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) RemoveCompareHouse(ctx context.Context, in *CompareBasketRequest, opts ...grpc.CallOption) (*CompareBasketReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareBasketReply)
+	err := c.cc.Invoke(ctx, House_RemoveCompareHouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) GetCompareBasket(ctx context.Context, in *GetCompareBasketRequest, opts ...grpc.CallOption) (*CompareBasketReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareBasketReply)
+	err := c.cc.Invoke(ctx, House_GetCompareBasket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) EstimatePrice(ctx context.Context, in *EstimatePriceRequest, opts ...grpc.CallOption) (*EstimatePriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EstimatePriceReply)
+	err := c.cc.Invoke(ctx, House_EstimatePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationQueueReply)
+	err := c.cc.Invoke(ctx, House_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) ReviewListing(ctx context.Context, in *ReviewListingRequest, opts ...grpc.CallOption) (*ReviewListingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewListingReply)
+	err := c.cc.Invoke(ctx, House_ReviewListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *houseClient) CheckListing(ctx context.Context, in *CheckListingRequest, opts ...grpc.CallOption) (*CheckListingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckListingReply)
+	err := c.cc.Invoke(ctx, House_CheckListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HouseServer is the server API for House service.
 // All implementations must embed UnimplementedHouseServer
 // for forward compatibility
 type HouseServer interface {
 	CreateHouse(context.Context, *CreateHouseRequest) (*CreateHouseReply, error)
+	GetHouse(context.Context, *GetHouseRequest) (*GetHouseReply, error)
+	// 全文检索在售房源
+	SearchHouses(context.Context, *SearchHousesRequest) (*SearchHousesReply, error)
+	// 搜索框联想：小区、区县、商圈、地铁站和热搜词，支持拼音和首字母
+	Suggest(context.Context, *SuggestRequest) (*SuggestReply, error)
+	// 调整在售房源总价，记入调价历史
+	ChangeHousePrice(context.Context, *ChangeHousePriceRequest) (*ChangeHousePriceReply, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error)
+	// 设置降价提醒，降幅达到阈值时发送站内通知
+	SetPriceAlert(context.Context, *SetPriceAlertRequest) (*SetPriceAlertReply, error)
+	// 对比2到4套房源，house_ids 为空时对比用户对比栏中的房源
+	CompareHouses(context.Context, *CompareHousesRequest) (*CompareHousesReply, error)
+	// 加入对比栏，最多4套
+	AddCompareHouse(context.Context, *CompareBasketRequest) (*CompareBasketReply, error)
+	RemoveCompareHouse(context.Context, *CompareBasketRequest) (*CompareBasketReply, error)
+	GetCompareBasket(context.Context, *GetCompareBasketRequest) (*CompareBasketReply, error)
+	// 按同小区（不足时同区县）的成交和挂牌案例估价，传 house_id 时估算该房源
+	EstimatePrice(context.Context, *EstimatePriceRequest) (*EstimatePriceReply, error)
+	// 审核队列：重复发布、单价偏离小区中位数、图片与其他房源相似的房源，按入队先后排列
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueReply, error)
+	// 审核通过或驳回，驳回的房源下架
+	ReviewListing(context.Context, *ReviewListingRequest) (*ReviewListingReply, error)
+	// 重新检测房源，房源发布和修改时会自动检测
+	CheckListing(context.Context, *CheckListingRequest) (*CheckListingReply, error)
 	mustEmbedUnimplementedHouseServer()
 }
 
@@ -62,6 +264,48 @@ type UnimplementedHouseServer struct {
 func (UnimplementedHouseServer) CreateHouse(context.Context, *CreateHouseRequest) (*CreateHouseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateHouse not implemented")
 }
+func (UnimplementedHouseServer) GetHouse(context.Context, *GetHouseRequest) (*GetHouseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHouse not implemented")
+}
+func (UnimplementedHouseServer) SearchHouses(context.Context, *SearchHousesRequest) (*SearchHousesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchHouses not implemented")
+}
+func (UnimplementedHouseServer) Suggest(context.Context, *SuggestRequest) (*SuggestReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedHouseServer) ChangeHousePrice(context.Context, *ChangeHousePriceRequest) (*ChangeHousePriceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeHousePrice not implemented")
+}
+func (UnimplementedHouseServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedHouseServer) SetPriceAlert(context.Context, *SetPriceAlertRequest) (*SetPriceAlertReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceAlert not implemented")
+}
+func (UnimplementedHouseServer) CompareHouses(context.Context, *CompareHousesRequest) (*CompareHousesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareHouses not implemented")
+}
+func (UnimplementedHouseServer) AddCompareHouse(context.Context, *CompareBasketRequest) (*CompareBasketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCompareHouse not implemented")
+}
+func (UnimplementedHouseServer) RemoveCompareHouse(context.Context, *CompareBasketRequest) (*CompareBasketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompareHouse not implemented")
+}
+func (UnimplementedHouseServer) GetCompareBasket(context.Context, *GetCompareBasketRequest) (*CompareBasketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompareBasket not implemented")
+}
+func (UnimplementedHouseServer) EstimatePrice(context.Context, *EstimatePriceRequest) (*EstimatePriceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatePrice not implemented")
+}
+func (UnimplementedHouseServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedHouseServer) ReviewListing(context.Context, *ReviewListingRequest) (*ReviewListingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewListing not implemented")
+}
+func (UnimplementedHouseServer) CheckListing(context.Context, *CheckListingRequest) (*CheckListingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckListing not implemented")
+}
 func (UnimplementedHouseServer) mustEmbedUnimplementedHouseServer() {}
 
 // UnsafeHouseServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +337,258 @@ func _House_CreateHouse_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _House_GetHouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).GetHouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_GetHouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).GetHouse(ctx, req.(*GetHouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_SearchHouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchHousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).SearchHouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_SearchHouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).SearchHouses(ctx, req.(*SearchHousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_ChangeHousePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeHousePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).ChangeHousePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_ChangeHousePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).ChangeHousePrice(ctx, req.(*ChangeHousePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_SetPriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).SetPriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_SetPriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).SetPriceAlert(ctx, req.(*SetPriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_CompareHouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareHousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).CompareHouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_CompareHouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).CompareHouses(ctx, req.(*CompareHousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_AddCompareHouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).AddCompareHouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_AddCompareHouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).AddCompareHouse(ctx, req.(*CompareBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_RemoveCompareHouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).RemoveCompareHouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_RemoveCompareHouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).RemoveCompareHouse(ctx, req.(*CompareBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_GetCompareBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompareBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).GetCompareBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_GetCompareBasket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).GetCompareBasket(ctx, req.(*GetCompareBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_EstimatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimatePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).EstimatePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_EstimatePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).EstimatePrice(ctx, req.(*EstimatePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_ReviewListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).ReviewListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_ReviewListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).ReviewListing(ctx, req.(*ReviewListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _House_CheckListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseServer).CheckListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: House_CheckListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseServer).CheckListing(ctx, req.(*CheckListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // House_ServiceDesc is the grpc.ServiceDesc for House service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,6 +600,62 @@ var House_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateHouse",
 			Handler:    _House_CreateHouse_Handler,
 		},
+		{
+			MethodName: "GetHouse",
+			Handler:    _House_GetHouse_Handler,
+		},
+		{
+			MethodName: "SearchHouses",
+			Handler:    _House_SearchHouses_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _House_Suggest_Handler,
+		},
+		{
+			MethodName: "ChangeHousePrice",
+			Handler:    _House_ChangeHousePrice_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _House_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "SetPriceAlert",
+			Handler:    _House_SetPriceAlert_Handler,
+		},
+		{
+			MethodName: "CompareHouses",
+			Handler:    _House_CompareHouses_Handler,
+		},
+		{
+			MethodName: "AddCompareHouse",
+			Handler:    _House_AddCompareHouse_Handler,
+		},
+		{
+			MethodName: "RemoveCompareHouse",
+			Handler:    _House_RemoveCompareHouse_Handler,
+		},
+		{
+			MethodName: "GetCompareBasket",
+			Handler:    _House_GetCompareBasket_Handler,
+		},
+		{
+			MethodName: "EstimatePrice",
+			Handler:    _House_EstimatePrice_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _House_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ReviewListing",
+			Handler:    _House_ReviewListing_Handler,
+		},
+		{
+			MethodName: "CheckListing",
+			Handler:    _House_CheckListing_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/house/v3/house.proto",
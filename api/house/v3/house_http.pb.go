@@ -20,14 +20,66 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationHouseCreateHouse = "/api.house.v3.House/CreateHouse"
+const OperationHouseGetHouse = "/api.house.v3.House/GetHouse"
+const OperationHouseSearchHouses = "/api.house.v3.House/SearchHouses"
+const OperationHouseSuggest = "/api.house.v3.House/Suggest"
+const OperationHouseChangeHousePrice = "/api.house.v3.House/ChangeHousePrice"
+const OperationHouseGetPriceHistory = "/api.house.v3.House/GetPriceHistory"
+const OperationHouseSetPriceAlert = "/api.house.v3.House/SetPriceAlert"
+const OperationHouseCompareHouses = "/api.house.v3.House/CompareHouses"
+const OperationHouseAddCompareHouse = "/api.house.v3.House/AddCompareHouse"
+const OperationHouseRemoveCompareHouse = "/api.house.v3.House/RemoveCompareHouse"
+const OperationHouseGetCompareBasket = "/api.house.v3.House/GetCompareBasket"
+const OperationHouseEstimatePrice = "/api.house.v3.House/EstimatePrice"
+const OperationHouseListModerationQueue = "/api.house.v3.House/ListModerationQueue"
+const OperationHouseReviewListing = "/api.house.v3.House/ReviewListing"
+const OperationHouseCheckListing = "/api.house.v3.House/CheckListing"
 
 type HouseHTTPServer interface {
 	CreateHouse(context.Context, *CreateHouseRequest) (*CreateHouseReply, error)
+	GetHouse(context.Context, *GetHouseRequest) (*GetHouseReply, error)
+	// 全文检索在售房源
+	SearchHouses(context.Context, *SearchHousesRequest) (*SearchHousesReply, error)
+	// 搜索框联想：小区、区县、商圈、地铁站和热搜词，支持拼音和首字母
+	Suggest(context.Context, *SuggestRequest) (*SuggestReply, error)
+	// 调整在售房源总价，记入调价历史
+	ChangeHousePrice(context.Context, *ChangeHousePriceRequest) (*ChangeHousePriceReply, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error)
+	// 设置降价提醒，降幅达到阈值时发送站内通知
+	SetPriceAlert(context.Context, *SetPriceAlertRequest) (*SetPriceAlertReply, error)
+	// 对比2到4套房源，house_ids 为空时对比用户对比栏中的房源
+	CompareHouses(context.Context, *CompareHousesRequest) (*CompareHousesReply, error)
+	// 加入对比栏，最多4套
+	AddCompareHouse(context.Context, *CompareBasketRequest) (*CompareBasketReply, error)
+	RemoveCompareHouse(context.Context, *CompareBasketRequest) (*CompareBasketReply, error)
+	GetCompareBasket(context.Context, *GetCompareBasketRequest) (*CompareBasketReply, error)
+	// 按同小区（不足时同区县）的成交和挂牌案例估价，传 house_id 时估算该房源
+	EstimatePrice(context.Context, *EstimatePriceRequest) (*EstimatePriceReply, error)
+	// 审核队列：重复发布、单价偏离小区中位数、图片与其他房源相似的房源，按入队先后排列
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueReply, error)
+	// 审核通过或驳回，驳回的房源下架
+	ReviewListing(context.Context, *ReviewListingRequest) (*ReviewListingReply, error)
+	// 重新检测房源，房源发布和修改时会自动检测
+	CheckListing(context.Context, *CheckListingRequest) (*CheckListingReply, error)
 }
 
 func RegisterHouseHTTPServer(s *http.Server, srv HouseHTTPServer) {
 	r := s.Route("/")
 	r.POST("/house/create", _House_CreateHouse0_HTTP_Handler(srv))
+	r.GET("/house/get", _House_GetHouse0_HTTP_Handler(srv))
+	r.GET("/house/search", _House_SearchHouses0_HTTP_Handler(srv))
+	r.GET("/house/suggest", _House_Suggest0_HTTP_Handler(srv))
+	r.POST("/house/price/change", _House_ChangeHousePrice0_HTTP_Handler(srv))
+	r.GET("/house/price/history", _House_GetPriceHistory0_HTTP_Handler(srv))
+	r.POST("/house/price/alert", _House_SetPriceAlert0_HTTP_Handler(srv))
+	r.GET("/house/compare", _House_CompareHouses0_HTTP_Handler(srv))
+	r.POST("/house/compare/add", _House_AddCompareHouse0_HTTP_Handler(srv))
+	r.POST("/house/compare/remove", _House_RemoveCompareHouse0_HTTP_Handler(srv))
+	r.GET("/house/compare/basket", _House_GetCompareBasket0_HTTP_Handler(srv))
+	r.POST("/house/valuation/estimate", _House_EstimatePrice0_HTTP_Handler(srv))
+	r.GET("/admin/house/moderation/list", _House_ListModerationQueue0_HTTP_Handler(srv))
+	r.POST("/admin/house/moderation/review", _House_ReviewListing0_HTTP_Handler(srv))
+	r.POST("/admin/house/moderation/check", _House_CheckListing0_HTTP_Handler(srv))
 }
 
 func _House_CreateHouse0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
@@ -52,8 +104,309 @@ func _House_CreateHouse0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context
 	}
 }
 
+func _House_GetHouse0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetHouseRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseGetHouse)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetHouse(ctx, req.(*GetHouseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetHouseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_SearchHouses0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchHousesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseSearchHouses)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchHouses(ctx, req.(*SearchHousesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchHousesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_Suggest0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseSuggest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Suggest(ctx, req.(*SuggestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuggestReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_ChangeHousePrice0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeHousePriceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseChangeHousePrice)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangeHousePrice(ctx, req.(*ChangeHousePriceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangeHousePriceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_GetPriceHistory0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPriceHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseGetPriceHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPriceHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_SetPriceAlert0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetPriceAlertRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseSetPriceAlert)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetPriceAlert(ctx, req.(*SetPriceAlertRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetPriceAlertReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_CompareHouses0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompareHousesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseCompareHouses)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompareHouses(ctx, req.(*CompareHousesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompareHousesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_AddCompareHouse0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompareBasketRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseAddCompareHouse)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddCompareHouse(ctx, req.(*CompareBasketRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompareBasketReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_RemoveCompareHouse0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompareBasketRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseRemoveCompareHouse)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveCompareHouse(ctx, req.(*CompareBasketRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompareBasketReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_GetCompareBasket0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCompareBasketRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseGetCompareBasket)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCompareBasket(ctx, req.(*GetCompareBasketRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompareBasketReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_EstimatePrice0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EstimatePriceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseEstimatePrice)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EstimatePrice(ctx, req.(*EstimatePriceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EstimatePriceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_ListModerationQueue0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListModerationQueueRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseListModerationQueue)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListModerationQueueReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_ReviewListing0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReviewListingRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseReviewListing)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReviewListing(ctx, req.(*ReviewListingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewListingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _House_CheckListing0_HTTP_Handler(srv HouseHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckListingRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHouseCheckListing)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckListing(ctx, req.(*CheckListingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckListingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type HouseHTTPClient interface {
 	CreateHouse(ctx context.Context, req *CreateHouseRequest, opts ...http.CallOption) (rsp *CreateHouseReply, err error)
+	GetHouse(ctx context.Context, req *GetHouseRequest, opts ...http.CallOption) (rsp *GetHouseReply, err error)
+	SearchHouses(ctx context.Context, req *SearchHousesRequest, opts ...http.CallOption) (rsp *SearchHousesReply, err error)
+	Suggest(ctx context.Context, req *SuggestRequest, opts ...http.CallOption) (rsp *SuggestReply, err error)
+	ChangeHousePrice(ctx context.Context, req *ChangeHousePriceRequest, opts ...http.CallOption) (rsp *ChangeHousePriceReply, err error)
+	GetPriceHistory(ctx context.Context, req *GetPriceHistoryRequest, opts ...http.CallOption) (rsp *GetPriceHistoryReply, err error)
+	SetPriceAlert(ctx context.Context, req *SetPriceAlertRequest, opts ...http.CallOption) (rsp *SetPriceAlertReply, err error)
+	CompareHouses(ctx context.Context, req *CompareHousesRequest, opts ...http.CallOption) (rsp *CompareHousesReply, err error)
+	AddCompareHouse(ctx context.Context, req *CompareBasketRequest, opts ...http.CallOption) (rsp *CompareBasketReply, err error)
+	RemoveCompareHouse(ctx context.Context, req *CompareBasketRequest, opts ...http.CallOption) (rsp *CompareBasketReply, err error)
+	GetCompareBasket(ctx context.Context, req *GetCompareBasketRequest, opts ...http.CallOption) (rsp *CompareBasketReply, err error)
+	EstimatePrice(ctx context.Context, req *EstimatePriceRequest, opts ...http.CallOption) (rsp *EstimatePriceReply, err error)
+	ListModerationQueue(ctx context.Context, req *ListModerationQueueRequest, opts ...http.CallOption) (rsp *ListModerationQueueReply, err error)
+	ReviewListing(ctx context.Context, req *ReviewListingRequest, opts ...http.CallOption) (rsp *ReviewListingReply, err error)
+	CheckListing(ctx context.Context, req *CheckListingRequest, opts ...http.CallOption) (rsp *CheckListingReply, err error)
 }
 
 type HouseHTTPClientImpl struct {
@@ -76,3 +429,185 @@ func (c *HouseHTTPClientImpl) CreateHouse(ctx context.Context, in *CreateHouseRe
 	}
 	return &out, nil
 }
+
+func (c *HouseHTTPClientImpl) GetHouse(ctx context.Context, in *GetHouseRequest, opts ...http.CallOption) (*GetHouseReply, error) {
+	var out GetHouseReply
+	pattern := "/house/get"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHouseGetHouse))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) SearchHouses(ctx context.Context, in *SearchHousesRequest, opts ...http.CallOption) (*SearchHousesReply, error) {
+	var out SearchHousesReply
+	pattern := "/house/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHouseSearchHouses))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) Suggest(ctx context.Context, in *SuggestRequest, opts ...http.CallOption) (*SuggestReply, error) {
+	var out SuggestReply
+	pattern := "/house/suggest"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHouseSuggest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) ChangeHousePrice(ctx context.Context, in *ChangeHousePriceRequest, opts ...http.CallOption) (*ChangeHousePriceReply, error) {
+	var out ChangeHousePriceReply
+	pattern := "/house/price/change"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHouseChangeHousePrice))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...http.CallOption) (*GetPriceHistoryReply, error) {
+	var out GetPriceHistoryReply
+	pattern := "/house/price/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHouseGetPriceHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) SetPriceAlert(ctx context.Context, in *SetPriceAlertRequest, opts ...http.CallOption) (*SetPriceAlertReply, error) {
+	var out SetPriceAlertReply
+	pattern := "/house/price/alert"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHouseSetPriceAlert))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) CompareHouses(ctx context.Context, in *CompareHousesRequest, opts ...http.CallOption) (*CompareHousesReply, error) {
+	var out CompareHousesReply
+	pattern := "/house/compare"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHouseCompareHouses))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) AddCompareHouse(ctx context.Context, in *CompareBasketRequest, opts ...http.CallOption) (*CompareBasketReply, error) {
+	var out CompareBasketReply
+	pattern := "/house/compare/add"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHouseAddCompareHouse))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) RemoveCompareHouse(ctx context.Context, in *CompareBasketRequest, opts ...http.CallOption) (*CompareBasketReply, error) {
+	var out CompareBasketReply
+	pattern := "/house/compare/remove"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHouseRemoveCompareHouse))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) GetCompareBasket(ctx context.Context, in *GetCompareBasketRequest, opts ...http.CallOption) (*CompareBasketReply, error) {
+	var out CompareBasketReply
+	pattern := "/house/compare/basket"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHouseGetCompareBasket))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) EstimatePrice(ctx context.Context, in *EstimatePriceRequest, opts ...http.CallOption) (*EstimatePriceReply, error) {
+	var out EstimatePriceReply
+	pattern := "/house/valuation/estimate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHouseEstimatePrice))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...http.CallOption) (*ListModerationQueueReply, error) {
+	var out ListModerationQueueReply
+	pattern := "/admin/house/moderation/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHouseListModerationQueue))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) ReviewListing(ctx context.Context, in *ReviewListingRequest, opts ...http.CallOption) (*ReviewListingReply, error) {
+	var out ReviewListingReply
+	pattern := "/admin/house/moderation/review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHouseReviewListing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HouseHTTPClientImpl) CheckListing(ctx context.Context, in *CheckListingRequest, opts ...http.CallOption) (*CheckListingReply, error) {
+	var out CheckListingReply
+	pattern := "/admin/house/moderation/check"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHouseCheckListing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
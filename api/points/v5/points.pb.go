@@ -21,16 +21,118 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PointsRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount    int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`   // 正数获得，负数消耗
+	Balance   int64  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"` // 变动后余额
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	BizType   string `protobuf:"bytes,5,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
+	BizId     uint64 `protobuf:"varint,6,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	CreatedAt int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix秒
+}
+
+func (x *PointsRecord) Reset() {
+	*x = PointsRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_points_v5_points_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointsRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointsRecord) ProtoMessage() {}
+
+func (x *PointsRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_points_v5_points_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointsRecord.ProtoReflect.Descriptor instead.
+func (*PointsRecord) Descriptor() ([]byte, []int) {
+	return file_api_points_v5_points_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PointsRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PointsRecord) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PointsRecord) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *PointsRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PointsRecord) GetBizType() string {
+	if x != nil {
+		return x.BizType
+	}
+	return ""
+}
+
+func (x *PointsRecord) GetBizId() uint64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *PointsRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 获得积分
 type CreatePointsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId  uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	BizType string `protobuf:"bytes,4,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
+	BizId   uint64 `protobuf:"varint,5,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
 }
 
 func (x *CreatePointsRequest) Reset() {
 	*x = CreatePointsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_points_v5_points_proto_msgTypes[0]
+		mi := &file_api_points_v5_points_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -43,7 +145,7 @@ func (x *CreatePointsRequest) String() string {
 func (*CreatePointsRequest) ProtoMessage() {}
 
 func (x *CreatePointsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_points_v5_points_proto_msgTypes[0]
+	mi := &file_api_points_v5_points_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,19 +158,56 @@ func (x *CreatePointsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePointsRequest.ProtoReflect.Descriptor instead.
 func (*CreatePointsRequest) Descriptor() ([]byte, []int) {
-	return file_api_points_v5_points_proto_rawDescGZIP(), []int{0}
+	return file_api_points_v5_points_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePointsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePointsRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreatePointsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreatePointsRequest) GetBizType() string {
+	if x != nil {
+		return x.BizType
+	}
+	return ""
+}
+
+func (x *CreatePointsRequest) GetBizId() uint64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
 }
 
 type CreatePointsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Record *PointsRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
 }
 
 func (x *CreatePointsReply) Reset() {
 	*x = CreatePointsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_points_v5_points_proto_msgTypes[1]
+		mi := &file_api_points_v5_points_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -81,7 +220,7 @@ func (x *CreatePointsReply) String() string {
 func (*CreatePointsReply) ProtoMessage() {}
 
 func (x *CreatePointsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_points_v5_points_proto_msgTypes[1]
+	mi := &file_api_points_v5_points_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -94,7 +233,243 @@ func (x *CreatePointsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePointsReply.ProtoReflect.Descriptor instead.
 func (*CreatePointsReply) Descriptor() ([]byte, []int) {
-	return file_api_points_v5_points_proto_rawDescGZIP(), []int{1}
+	return file_api_points_v5_points_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePointsReply) GetRecord() *PointsRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// 兑换（消耗）积分，余额不足时失败
+type RedeemPointsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	BizType string `protobuf:"bytes,4,opt,name=biz_type,json=bizType,proto3" json:"biz_type,omitempty"`
+	BizId   uint64 `protobuf:"varint,5,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+}
+
+func (x *RedeemPointsRequest) Reset() {
+	*x = RedeemPointsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_points_v5_points_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemPointsRequest) ProtoMessage() {}
+
+func (x *RedeemPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_points_v5_points_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemPointsRequest.ProtoReflect.Descriptor instead.
+func (*RedeemPointsRequest) Descriptor() ([]byte, []int) {
+	return file_api_points_v5_points_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RedeemPointsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RedeemPointsRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RedeemPointsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RedeemPointsRequest) GetBizType() string {
+	if x != nil {
+		return x.BizType
+	}
+	return ""
+}
+
+func (x *RedeemPointsRequest) GetBizId() uint64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+type RedeemPointsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *PointsRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *RedeemPointsReply) Reset() {
+	*x = RedeemPointsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_points_v5_points_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemPointsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemPointsReply) ProtoMessage() {}
+
+func (x *RedeemPointsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_points_v5_points_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemPointsReply.ProtoReflect.Descriptor instead.
+func (*RedeemPointsReply) Descriptor() ([]byte, []int) {
+	return file_api_points_v5_points_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RedeemPointsReply) GetRecord() *PointsRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type GetPointsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetPointsRequest) Reset() {
+	*x = GetPointsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_points_v5_points_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointsRequest) ProtoMessage() {}
+
+func (x *GetPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_points_v5_points_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointsRequest.ProtoReflect.Descriptor instead.
+func (*GetPointsRequest) Descriptor() ([]byte, []int) {
+	return file_api_points_v5_points_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPointsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetPointsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance int64           `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Records []*PointsRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (x *GetPointsReply) Reset() {
+	*x = GetPointsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_points_v5_points_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPointsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointsReply) ProtoMessage() {}
+
+func (x *GetPointsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_points_v5_points_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointsReply.ProtoReflect.Descriptor instead.
+func (*GetPointsReply) Descriptor() ([]byte, []int) {
+	return file_api_points_v5_points_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPointsReply) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetPointsReply) GetRecords() []*PointsRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
 }
 
 var File_api_points_v5_points_proto protoreflect.FileDescriptor
@@ -104,22 +479,81 @@ var file_api_points_v5_points_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x70,
 	0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x13, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0x79, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x6f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x35, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a,
-	0x22, 0x0e, 0x2f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x39, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x35, 0x42, 0x0d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x35,
-	0x50, 0x01, 0x5a, 0x17, 0x61, 0x6e, 0x6a, 0x75, 0x6b, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x35, 0x3b, 0x76, 0x35, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x7a, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69, 0x7a, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x7a, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69, 0x7a, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a,
+	0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x7a, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69, 0x7a, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15,
+	0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x62, 0x69, 0x7a, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x11, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22,
+	0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x32,
+	0xcc, 0x02, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x52,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e,
+	0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x12, 0x60, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0d, 0x12, 0x0b, 0x2f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x65, 0x74, 0x42, 0x39,
+	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x35, 0x42,
+	0x0d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x35, 0x50, 0x01,
+	0x5a, 0x17, 0x61, 0x6e, 0x6a, 0x75, 0x6b, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x2f, 0x76, 0x35, 0x3b, 0x76, 0x35, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -134,19 +568,31 @@ func file_api_points_v5_points_proto_rawDescGZIP() []byte {
 	return file_api_points_v5_points_proto_rawDescData
 }
 
-var file_api_points_v5_points_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_points_v5_points_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_points_v5_points_proto_goTypes = []any{
-	(*CreatePointsRequest)(nil), // 0: api.points.v5.CreatePointsRequest
-	(*CreatePointsReply)(nil),   // 1: api.points.v5.CreatePointsReply
+	(*PointsRecord)(nil),        // 0: api.points.v5.PointsRecord
+	(*CreatePointsRequest)(nil), // 1: api.points.v5.CreatePointsRequest
+	(*CreatePointsReply)(nil),   // 2: api.points.v5.CreatePointsReply
+	(*RedeemPointsRequest)(nil), // 3: api.points.v5.RedeemPointsRequest
+	(*RedeemPointsReply)(nil),   // 4: api.points.v5.RedeemPointsReply
+	(*GetPointsRequest)(nil),    // 5: api.points.v5.GetPointsRequest
+	(*GetPointsReply)(nil),      // 6: api.points.v5.GetPointsReply
 }
 var file_api_points_v5_points_proto_depIdxs = []int32{
-	0, // 0: api.points.v5.Points.CreatePoints:input_type -> api.points.v5.CreatePointsRequest
-	1, // 1: api.points.v5.Points.CreatePoints:output_type -> api.points.v5.CreatePointsReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: api.points.v5.CreatePointsReply.record:type_name -> api.points.v5.PointsRecord
+	0, // 1: api.points.v5.RedeemPointsReply.record:type_name -> api.points.v5.PointsRecord
+	0, // 2: api.points.v5.GetPointsReply.records:type_name -> api.points.v5.PointsRecord
+	1, // 3: api.points.v5.Points.CreatePoints:input_type -> api.points.v5.CreatePointsRequest
+	3, // 4: api.points.v5.Points.RedeemPoints:input_type -> api.points.v5.RedeemPointsRequest
+	5, // 5: api.points.v5.Points.GetPoints:input_type -> api.points.v5.GetPointsRequest
+	2, // 6: api.points.v5.Points.CreatePoints:output_type -> api.points.v5.CreatePointsReply
+	4, // 7: api.points.v5.Points.RedeemPoints:output_type -> api.points.v5.RedeemPointsReply
+	6, // 8: api.points.v5.Points.GetPoints:output_type -> api.points.v5.GetPointsReply
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_api_points_v5_points_proto_init() }
@@ -156,7 +602,7 @@ func file_api_points_v5_points_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_api_points_v5_points_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePointsRequest); i {
+			switch v := v.(*PointsRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -168,6 +614,18 @@ func file_api_points_v5_points_proto_init() {
 			}
 		}
 		file_api_points_v5_points_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePointsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_points_v5_points_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*CreatePointsReply); i {
 			case 0:
 				return &v.state
@@ -179,6 +637,54 @@ func file_api_points_v5_points_proto_init() {
 				return nil
 			}
 		}
+		file_api_points_v5_points_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*RedeemPointsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_points_v5_points_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RedeemPointsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_points_v5_points_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPointsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_points_v5_points_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPointsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -186,7 +692,7 @@ func file_api_points_v5_points_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_points_v5_points_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
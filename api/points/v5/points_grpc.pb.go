@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion8
 
 const (
 	Points_CreatePoints_FullMethodName = "/api.points.v5.Points/CreatePoints"
+	Points_RedeemPoints_FullMethodName = "/api.points.v5.Points/RedeemPoints"
+	Points_GetPoints_FullMethodName    = "/api.points.v5.Points/GetPoints"
 )
 
 // PointsClient is the client API for Points service.
@@ -27,6 +29,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PointsClient interface {
 	CreatePoints(ctx context.Context, in *CreatePointsRequest, opts ...grpc.CallOption) (*CreatePointsReply, error)
+	RedeemPoints(ctx context.Context, in *RedeemPointsRequest, opts ...grpc.CallOption) (*RedeemPointsReply, error)
+	GetPoints(ctx context.Context, in *GetPointsRequest, opts ...grpc.CallOption) (*GetPointsReply, error)
 }
 
 type pointsClient struct {
@@ -47,11 +51,33 @@ func (c *pointsClient) CreatePoints(ctx context.Context, in *CreatePointsRequest
 	return out, nil
 }
 
+func (c *pointsClient) RedeemPoints(ctx context.Context, in *RedeemPointsRequest, opts ...grpc.CallOption) (*RedeemPointsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemPointsReply)
+	err := c.cc.Invoke(ctx, Points_RedeemPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointsClient) GetPoints(ctx context.Context, in *GetPointsRequest, opts ...grpc.CallOption) (*GetPointsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPointsReply)
+	err := c.cc.Invoke(ctx, Points_GetPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PointsServer is the server API for Points service.
 // All implementations must embed UnimplementedPointsServer
 // for forward compatibility
 type PointsServer interface {
 	CreatePoints(context.Context, *CreatePointsRequest) (*CreatePointsReply, error)
+	RedeemPoints(context.Context, *RedeemPointsRequest) (*RedeemPointsReply, error)
+	GetPoints(context.Context, *GetPointsRequest) (*GetPointsReply, error)
 	mustEmbedUnimplementedPointsServer()
 }
 
@@ -62,6 +88,12 @@ type UnimplementedPointsServer struct {
 func (UnimplementedPointsServer) CreatePoints(context.Context, *CreatePointsRequest) (*CreatePointsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePoints not implemented")
 }
+func (UnimplementedPointsServer) RedeemPoints(context.Context, *RedeemPointsRequest) (*RedeemPointsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemPoints not implemented")
+}
+func (UnimplementedPointsServer) GetPoints(context.Context, *GetPointsRequest) (*GetPointsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoints not implemented")
+}
 func (UnimplementedPointsServer) mustEmbedUnimplementedPointsServer() {}
 
 // UnsafePointsServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +125,42 @@ func _Points_CreatePoints_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Points_RedeemPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointsServer).RedeemPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Points_RedeemPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointsServer).RedeemPoints(ctx, req.(*RedeemPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Points_GetPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointsServer).GetPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Points_GetPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointsServer).GetPoints(ctx, req.(*GetPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Points_ServiceDesc is the grpc.ServiceDesc for Points service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,6 +172,14 @@ var Points_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePoints",
 			Handler:    _Points_CreatePoints_Handler,
 		},
+		{
+			MethodName: "RedeemPoints",
+			Handler:    _Points_RedeemPoints_Handler,
+		},
+		{
+			MethodName: "GetPoints",
+			Handler:    _Points_GetPoints_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/points/v5/points.proto",
@@ -20,14 +20,20 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationPointsCreatePoints = "/api.points.v5.Points/CreatePoints"
+const OperationPointsRedeemPoints = "/api.points.v5.Points/RedeemPoints"
+const OperationPointsGetPoints = "/api.points.v5.Points/GetPoints"
 
 type PointsHTTPServer interface {
 	CreatePoints(context.Context, *CreatePointsRequest) (*CreatePointsReply, error)
+	RedeemPoints(context.Context, *RedeemPointsRequest) (*RedeemPointsReply, error)
+	GetPoints(context.Context, *GetPointsRequest) (*GetPointsReply, error)
 }
 
 func RegisterPointsHTTPServer(s *http.Server, srv PointsHTTPServer) {
 	r := s.Route("/")
 	r.POST("/points/create", _Points_CreatePoints0_HTTP_Handler(srv))
+	r.POST("/points/redeem", _Points_RedeemPoints0_HTTP_Handler(srv))
+	r.GET("/points/get", _Points_GetPoints0_HTTP_Handler(srv))
 }
 
 func _Points_CreatePoints0_HTTP_Handler(srv PointsHTTPServer) func(ctx http.Context) error {
@@ -52,8 +58,51 @@ func _Points_CreatePoints0_HTTP_Handler(srv PointsHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _Points_RedeemPoints0_HTTP_Handler(srv PointsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RedeemPointsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPointsRedeemPoints)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RedeemPoints(ctx, req.(*RedeemPointsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RedeemPointsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Points_GetPoints0_HTTP_Handler(srv PointsHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPointsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPointsGetPoints)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPoints(ctx, req.(*GetPointsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPointsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type PointsHTTPClient interface {
 	CreatePoints(ctx context.Context, req *CreatePointsRequest, opts ...http.CallOption) (rsp *CreatePointsReply, err error)
+	RedeemPoints(ctx context.Context, req *RedeemPointsRequest, opts ...http.CallOption) (rsp *RedeemPointsReply, err error)
+	GetPoints(ctx context.Context, req *GetPointsRequest, opts ...http.CallOption) (rsp *GetPointsReply, err error)
 }
 
 type PointsHTTPClientImpl struct {
@@ -76,3 +125,29 @@ func (c *PointsHTTPClientImpl) CreatePoints(ctx context.Context, in *CreatePoint
 	}
 	return &out, nil
 }
+
+func (c *PointsHTTPClientImpl) RedeemPoints(ctx context.Context, in *RedeemPointsRequest, opts ...http.CallOption) (*RedeemPointsReply, error) {
+	var out RedeemPointsReply
+	pattern := "/points/redeem"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPointsRedeemPoints))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PointsHTTPClientImpl) GetPoints(ctx context.Context, in *GetPointsRequest, opts ...http.CallOption) (*GetPointsReply, error) {
+	var out GetPointsReply
+	pattern := "/points/get"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPointsGetPoints))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
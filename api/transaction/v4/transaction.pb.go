@@ -21,16 +21,116 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DealInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HouseId     uint64 `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+	BuyerId     uint64 `protobuf:"varint,3,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	AgentId     uint64 `protobuf:"varint,4,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Price       int64  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`                                // 成交价（元）
+	Status      int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                              // 0进行中 1已成交 2已取消
+	CompletedAt int64  `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // 成交时间（unix秒）
+}
+
+func (x *DealInfo) Reset() {
+	*x = DealInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DealInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DealInfo) ProtoMessage() {}
+
+func (x *DealInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DealInfo.ProtoReflect.Descriptor instead.
+func (*DealInfo) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DealInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DealInfo) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *DealInfo) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+func (x *DealInfo) GetAgentId() uint64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+func (x *DealInfo) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *DealInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DealInfo) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
 type CreateTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	HouseId uint64 `protobuf:"varint,1,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+	BuyerId uint64 `protobuf:"varint,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	AgentId uint64 `protobuf:"varint,3,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Price   int64  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
 }
 
 func (x *CreateTransactionRequest) Reset() {
 	*x = CreateTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_transaction_v4_transaction_proto_msgTypes[0]
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -43,7 +143,7 @@ func (x *CreateTransactionRequest) String() string {
 func (*CreateTransactionRequest) ProtoMessage() {}
 
 func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_transaction_v4_transaction_proto_msgTypes[0]
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,19 +156,49 @@ func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{0}
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTransactionRequest) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetBuyerId() uint64 {
+	if x != nil {
+		return x.BuyerId
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetAgentId() uint64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
 type CreateTransactionReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Deal *DealInfo `protobuf:"bytes,1,opt,name=deal,proto3" json:"deal,omitempty"`
 }
 
 func (x *CreateTransactionReply) Reset() {
 	*x = CreateTransactionReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_transaction_v4_transaction_proto_msgTypes[1]
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -81,7 +211,7 @@ func (x *CreateTransactionReply) String() string {
 func (*CreateTransactionReply) ProtoMessage() {}
 
 func (x *CreateTransactionReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_transaction_v4_transaction_proto_msgTypes[1]
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -94,85 +224,2765 @@ func (x *CreateTransactionReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTransactionReply.ProtoReflect.Descriptor instead.
 func (*CreateTransactionReply) Descriptor() ([]byte, []int) {
-	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{1}
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{2}
 }
 
-var File_api_transaction_v4_transaction_proto protoreflect.FileDescriptor
+func (x *CreateTransactionReply) GetDeal() *DealInfo {
+	if x != nil {
+		return x.Deal
+	}
+	return nil
+}
 
-var file_api_transaction_v4_transaction_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x76, 0x34, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0x9d,
-	0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8d,
-	0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x48,
-	0x0a, 0x12, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x34, 0x42, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x34, 0x50, 0x01, 0x5a, 0x1c, 0x61, 0x6e, 0x6a, 0x75,
-	0x6b, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x76, 0x34, 0x3b, 0x76, 0x34, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type CompleteTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var (
-	file_api_transaction_v4_transaction_proto_rawDescOnce sync.Once
-	file_api_transaction_v4_transaction_proto_rawDescData = file_api_transaction_v4_transaction_proto_rawDesc
-)
+func (x *CompleteTransactionRequest) Reset() {
+	*x = CompleteTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_transaction_v4_transaction_proto_rawDescGZIP() []byte {
-	file_api_transaction_v4_transaction_proto_rawDescOnce.Do(func() {
-		file_api_transaction_v4_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_transaction_v4_transaction_proto_rawDescData)
-	})
-	return file_api_transaction_v4_transaction_proto_rawDescData
+func (x *CompleteTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_transaction_v4_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_api_transaction_v4_transaction_proto_goTypes = []any{
-	(*CreateTransactionRequest)(nil), // 0: api.transaction.v4.CreateTransactionRequest
-	(*CreateTransactionReply)(nil),   // 1: api.transaction.v4.CreateTransactionReply
+func (*CompleteTransactionRequest) ProtoMessage() {}
+
+func (x *CompleteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_transaction_v4_transaction_proto_depIdxs = []int32{
-	0, // 0: api.transaction.v4.Transaction.CreateTransaction:input_type -> api.transaction.v4.CreateTransactionRequest
-	1, // 1: api.transaction.v4.Transaction.CreateTransaction:output_type -> api.transaction.v4.CreateTransactionReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use CompleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{3}
 }
 
-func init() { file_api_transaction_v4_transaction_proto_init() }
-func file_api_transaction_v4_transaction_proto_init() {
-	if File_api_transaction_v4_transaction_proto != nil {
-		return
+func (x *CompleteTransactionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_transaction_v4_transaction_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTransactionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+type CompleteTransactionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deal *DealInfo `protobuf:"bytes,1,opt,name=deal,proto3" json:"deal,omitempty"`
+}
+
+func (x *CompleteTransactionReply) Reset() {
+	*x = CompleteTransactionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteTransactionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTransactionReply) ProtoMessage() {}
+
+func (x *CompleteTransactionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_api_transaction_v4_transaction_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTransactionReply); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTransactionReply.ProtoReflect.Descriptor instead.
+func (*CompleteTransactionReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompleteTransactionReply) GetDeal() *DealInfo {
+	if x != nil {
+		return x.Deal
+	}
+	return nil
+}
+
+type CalculateMortgageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price              int64   `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`                                                        // 总价（元）
+	Area               float64 `protobuf:"fixed64,2,opt,name=area,proto3" json:"area,omitempty"`                                                         // 建筑面积（㎡），决定契税档位
+	Home               int32   `protobuf:"varint,3,opt,name=home,proto3" json:"home,omitempty"`                                                          // 1首套 2二套
+	DownPaymentPercent float64 `protobuf:"fixed64,4,opt,name=down_payment_percent,json=downPaymentPercent,proto3" json:"down_payment_percent,omitempty"` // 首付比例（%），0取最低首付
+	LoanType           int32   `protobuf:"varint,5,opt,name=loan_type,json=loanType,proto3" json:"loan_type,omitempty"`                                  // 1商业贷款 2公积金贷款 3组合贷款
+	FundAmount         int64   `protobuf:"varint,6,opt,name=fund_amount,json=fundAmount,proto3" json:"fund_amount,omitempty"`                            // 组合贷款中的公积金部分（元），0取可贷上限
+	Years              int32   `protobuf:"varint,7,opt,name=years,proto3" json:"years,omitempty"`                                                        // 贷款年限
+	Method             int32   `protobuf:"varint,8,opt,name=method,proto3" json:"method,omitempty"`                                                      // 1等额本息 2等额本金
+	CommercialRate     float64 `protobuf:"fixed64,9,opt,name=commercial_rate,json=commercialRate,proto3" json:"commercial_rate,omitempty"`               // 商贷年利率（%），0取利率表
+	FundRate           float64 `protobuf:"fixed64,10,opt,name=fund_rate,json=fundRate,proto3" json:"fund_rate,omitempty"`                                // 公积金年利率（%），0取利率表
+	YearsHeld          int32   `protobuf:"varint,11,opt,name=years_held,json=yearsHeld,proto3" json:"years_held,omitempty"`                              // 卖方持有年数，决定是否免征增值税
+}
+
+func (x *CalculateMortgageRequest) Reset() {
+	*x = CalculateMortgageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateMortgageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateMortgageRequest) ProtoMessage() {}
+
+func (x *CalculateMortgageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateMortgageRequest.ProtoReflect.Descriptor instead.
+func (*CalculateMortgageRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CalculateMortgageRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetArea() float64 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetHome() int32 {
+	if x != nil {
+		return x.Home
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetDownPaymentPercent() float64 {
+	if x != nil {
+		return x.DownPaymentPercent
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetLoanType() int32 {
+	if x != nil {
+		return x.LoanType
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetFundAmount() int64 {
+	if x != nil {
+		return x.FundAmount
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetYears() int32 {
+	if x != nil {
+		return x.Years
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetMethod() int32 {
+	if x != nil {
+		return x.Method
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetCommercialRate() float64 {
+	if x != nil {
+		return x.CommercialRate
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetFundRate() float64 {
+	if x != nil {
+		return x.FundRate
+	}
+	return 0
+}
+
+func (x *CalculateMortgageRequest) GetYearsHeld() int32 {
+	if x != nil {
+		return x.YearsHeld
+	}
+	return 0
+}
+
+type MortgagePayment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Month     int32   `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	Payment   float64 `protobuf:"fixed64,2,opt,name=payment,proto3" json:"payment,omitempty"`     // 月供（元）
+	Principal float64 `protobuf:"fixed64,3,opt,name=principal,proto3" json:"principal,omitempty"` // 其中本金
+	Interest  float64 `protobuf:"fixed64,4,opt,name=interest,proto3" json:"interest,omitempty"`   // 其中利息
+	Balance   float64 `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance,omitempty"`     // 剩余本金
+}
+
+func (x *MortgagePayment) Reset() {
+	*x = MortgagePayment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MortgagePayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MortgagePayment) ProtoMessage() {}
+
+func (x *MortgagePayment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MortgagePayment.ProtoReflect.Descriptor instead.
+func (*MortgagePayment) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MortgagePayment) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *MortgagePayment) GetPayment() float64 {
+	if x != nil {
+		return x.Payment
+	}
+	return 0
+}
+
+func (x *MortgagePayment) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *MortgagePayment) GetInterest() float64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *MortgagePayment) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type PurchaseFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // deed_tax、vat 或 agency_fee
+	Label  string  `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Amount float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // 元
+}
+
+func (x *PurchaseFee) Reset() {
+	*x = PurchaseFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseFee) ProtoMessage() {}
+
+func (x *PurchaseFee) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseFee.ProtoReflect.Descriptor instead.
+func (*PurchaseFee) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PurchaseFee) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PurchaseFee) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PurchaseFee) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CalculateMortgageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownPayment    int64              `protobuf:"varint,1,opt,name=down_payment,json=downPayment,proto3" json:"down_payment,omitempty"`           // 首付（元）
+	CommercialLoan int64              `protobuf:"varint,2,opt,name=commercial_loan,json=commercialLoan,proto3" json:"commercial_loan,omitempty"`  // 商业贷款（元）
+	FundLoan       int64              `protobuf:"varint,3,opt,name=fund_loan,json=fundLoan,proto3" json:"fund_loan,omitempty"`                    // 公积金贷款（元）
+	CommercialRate float64            `protobuf:"fixed64,4,opt,name=commercial_rate,json=commercialRate,proto3" json:"commercial_rate,omitempty"` // 实际采用的年利率（%）
+	FundRate       float64            `protobuf:"fixed64,5,opt,name=fund_rate,json=fundRate,proto3" json:"fund_rate,omitempty"`
+	MonthlyPayment float64            `protobuf:"fixed64,6,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"` // 首月月供，等额本金逐月递减
+	TotalInterest  float64            `protobuf:"fixed64,7,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	TotalPayment   float64            `protobuf:"fixed64,8,opt,name=total_payment,json=totalPayment,proto3" json:"total_payment,omitempty"` // 还款总额
+	Fees           []*PurchaseFee     `protobuf:"bytes,9,rep,name=fees,proto3" json:"fees,omitempty"`
+	TotalFees      float64            `protobuf:"fixed64,10,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	UpfrontCost    float64            `protobuf:"fixed64,11,opt,name=upfront_cost,json=upfrontCost,proto3" json:"upfront_cost,omitempty"` // 首付加税费
+	Schedule       []*MortgagePayment `protobuf:"bytes,12,rep,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *CalculateMortgageReply) Reset() {
+	*x = CalculateMortgageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateMortgageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateMortgageReply) ProtoMessage() {}
+
+func (x *CalculateMortgageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateMortgageReply.ProtoReflect.Descriptor instead.
+func (*CalculateMortgageReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CalculateMortgageReply) GetDownPayment() int64 {
+	if x != nil {
+		return x.DownPayment
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetCommercialLoan() int64 {
+	if x != nil {
+		return x.CommercialLoan
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetFundLoan() int64 {
+	if x != nil {
+		return x.FundLoan
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetCommercialRate() float64 {
+	if x != nil {
+		return x.CommercialRate
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetFundRate() float64 {
+	if x != nil {
+		return x.FundRate
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetMonthlyPayment() float64 {
+	if x != nil {
+		return x.MonthlyPayment
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetTotalInterest() float64 {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetTotalPayment() float64 {
+	if x != nil {
+		return x.TotalPayment
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetFees() []*PurchaseFee {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *CalculateMortgageReply) GetTotalFees() float64 {
+	if x != nil {
+		return x.TotalFees
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetUpfrontCost() float64 {
+	if x != nil {
+		return x.UpfrontCost
+	}
+	return 0
+}
+
+func (x *CalculateMortgageReply) GetSchedule() []*MortgagePayment {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type RentPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq     int32  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`                       // 第几期
+	Start   string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`                    // 计租开始，如 2025-07-01
+	End     string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`                        // 计租结束，不含当天
+	DueDate string `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"` // 应付日
+	Amount  int64  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`                 // 租金（元），提前解约的最后一个月按天计
+}
+
+func (x *RentPeriod) Reset() {
+	*x = RentPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RentPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RentPeriod) ProtoMessage() {}
+
+func (x *RentPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RentPeriod.ProtoReflect.Descriptor instead.
+func (*RentPeriod) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RentPeriod) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RentPeriod) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *RentPeriod) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *RentPeriod) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *RentPeriod) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type LeaseInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HouseId         uint64         `protobuf:"varint,2,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+	LandlordId      uint64         `protobuf:"varint,3,opt,name=landlord_id,json=landlordId,proto3" json:"landlord_id,omitempty"`
+	TenantId        uint64         `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	MonthlyRent     int64          `protobuf:"varint,5,opt,name=monthly_rent,json=monthlyRent,proto3" json:"monthly_rent,omitempty"` // 月租金（元）
+	DepositMonths   int32          `protobuf:"varint,6,opt,name=deposit_months,json=depositMonths,proto3" json:"deposit_months,omitempty"`
+	PayMonths       int32          `protobuf:"varint,7,opt,name=pay_months,json=payMonths,proto3" json:"pay_months,omitempty"`
+	Deposit         int64          `protobuf:"varint,8,opt,name=deposit,proto3" json:"deposit,omitempty"`                               // 押金（元）
+	StartDate       string         `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`           // 起租日
+	Months          int32          `protobuf:"varint,10,opt,name=months,proto3" json:"months,omitempty"`                                // 租期（月）
+	EndDate         string         `protobuf:"bytes,11,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                // 到期日，不含当天
+	Status          int32          `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`                                // 0生效中 1已到期 2已解约
+	RenewedFrom     uint64         `protobuf:"varint,13,opt,name=renewed_from,json=renewedFrom,proto3" json:"renewed_from,omitempty"`   // 续租前的租约
+	RenewalId       uint64         `protobuf:"varint,14,opt,name=renewal_id,json=renewalId,proto3" json:"renewal_id,omitempty"`         // 续租后的租约
+	TerminatedAt    string         `protobuf:"bytes,15,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"` // 提前解约日
+	Penalty         int64          `protobuf:"varint,16,opt,name=penalty,proto3" json:"penalty,omitempty"`                              // 违约金（元）
+	TerminateReason string         `protobuf:"bytes,17,opt,name=terminate_reason,json=terminateReason,proto3" json:"terminate_reason,omitempty"`
+	Schedule        []*RentPeriod  `protobuf:"bytes,18,rep,name=schedule,proto3" json:"schedule,omitempty"` // 付款计划
+	Charges         []*LeaseCharge `protobuf:"bytes,19,rep,name=charges,proto3" json:"charges,omitempty"`   // 随租金收取的固定费用
+}
+
+func (x *LeaseInfo) Reset() {
+	*x = LeaseInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseInfo) ProtoMessage() {}
+
+func (x *LeaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseInfo.ProtoReflect.Descriptor instead.
+func (*LeaseInfo) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LeaseInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetLandlordId() uint64 {
+	if x != nil {
+		return x.LandlordId
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetMonthlyRent() int64 {
+	if x != nil {
+		return x.MonthlyRent
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetDepositMonths() int32 {
+	if x != nil {
+		return x.DepositMonths
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetPayMonths() int32 {
+	if x != nil {
+		return x.PayMonths
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetDeposit() int64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *LeaseInfo) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *LeaseInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetRenewedFrom() uint64 {
+	if x != nil {
+		return x.RenewedFrom
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetRenewalId() uint64 {
+	if x != nil {
+		return x.RenewalId
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetTerminatedAt() string {
+	if x != nil {
+		return x.TerminatedAt
+	}
+	return ""
+}
+
+func (x *LeaseInfo) GetPenalty() int64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *LeaseInfo) GetTerminateReason() string {
+	if x != nil {
+		return x.TerminateReason
+	}
+	return ""
+}
+
+func (x *LeaseInfo) GetSchedule() []*RentPeriod {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *LeaseInfo) GetCharges() []*LeaseCharge {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+type LeaseCharge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`        // 费用名称，须为房源租金不含的费用，如"网费"
+	Monthly int64  `protobuf:"varint,2,opt,name=monthly,proto3" json:"monthly,omitempty"` // 每月金额（元）；在账单明细中为该期金额
+}
+
+func (x *LeaseCharge) Reset() {
+	*x = LeaseCharge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseCharge) ProtoMessage() {}
+
+func (x *LeaseCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseCharge.ProtoReflect.Descriptor instead.
+func (*LeaseCharge) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LeaseCharge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeaseCharge) GetMonthly() int64 {
+	if x != nil {
+		return x.Monthly
+	}
+	return 0
+}
+
+type SignLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HouseId       uint64         `protobuf:"varint,1,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+	TenantId      uint64         `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	StartDate     string         `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`              // 起租日，如 2025-07-01
+	Months        int32          `protobuf:"varint,4,opt,name=months,proto3" json:"months,omitempty"`                                    // 租期（月），1-60
+	MonthlyRent   int64          `protobuf:"varint,5,opt,name=monthly_rent,json=monthlyRent,proto3" json:"monthly_rent,omitempty"`       // 为空取房源月租金
+	DepositMonths int32          `protobuf:"varint,6,opt,name=deposit_months,json=depositMonths,proto3" json:"deposit_months,omitempty"` // 为空取房源押付方式
+	PayMonths     int32          `protobuf:"varint,7,opt,name=pay_months,json=payMonths,proto3" json:"pay_months,omitempty"`
+	Charges       []*LeaseCharge `protobuf:"bytes,8,rep,name=charges,proto3" json:"charges,omitempty"`
+}
+
+func (x *SignLeaseRequest) Reset() {
+	*x = SignLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignLeaseRequest) ProtoMessage() {}
+
+func (x *SignLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignLeaseRequest.ProtoReflect.Descriptor instead.
+func (*SignLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SignLeaseRequest) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *SignLeaseRequest) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *SignLeaseRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SignLeaseRequest) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *SignLeaseRequest) GetMonthlyRent() int64 {
+	if x != nil {
+		return x.MonthlyRent
+	}
+	return 0
+}
+
+func (x *SignLeaseRequest) GetDepositMonths() int32 {
+	if x != nil {
+		return x.DepositMonths
+	}
+	return 0
+}
+
+func (x *SignLeaseRequest) GetPayMonths() int32 {
+	if x != nil {
+		return x.PayMonths
+	}
+	return 0
+}
+
+func (x *SignLeaseRequest) GetCharges() []*LeaseCharge {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+type SignLeaseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *LeaseInfo `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (x *SignLeaseReply) Reset() {
+	*x = SignLeaseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignLeaseReply) ProtoMessage() {}
+
+func (x *SignLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignLeaseReply.ProtoReflect.Descriptor instead.
+func (*SignLeaseReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SignLeaseReply) GetLease() *LeaseInfo {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type GetLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetLeaseRequest) Reset() {
+	*x = GetLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaseRequest) ProtoMessage() {}
+
+func (x *GetLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaseRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetLeaseRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetLeaseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *LeaseInfo `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (x *GetLeaseReply) Reset() {
+	*x = GetLeaseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaseReply) ProtoMessage() {}
+
+func (x *GetLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaseReply.ProtoReflect.Descriptor instead.
+func (*GetLeaseReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetLeaseReply) GetLease() *LeaseInfo {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type ListLeasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId   uint64 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	LandlordId uint64 `protobuf:"varint,2,opt,name=landlord_id,json=landlordId,proto3" json:"landlord_id,omitempty"`
+	HouseId    uint64 `protobuf:"varint,3,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+}
+
+func (x *ListLeasesRequest) Reset() {
+	*x = ListLeasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLeasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeasesRequest) ProtoMessage() {}
+
+func (x *ListLeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeasesRequest.ProtoReflect.Descriptor instead.
+func (*ListLeasesRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListLeasesRequest) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ListLeasesRequest) GetLandlordId() uint64 {
+	if x != nil {
+		return x.LandlordId
+	}
+	return 0
+}
+
+func (x *ListLeasesRequest) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+type ListLeasesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leases []*LeaseInfo `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
+}
+
+func (x *ListLeasesReply) Reset() {
+	*x = ListLeasesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLeasesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeasesReply) ProtoMessage() {}
+
+func (x *ListLeasesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeasesReply.ProtoReflect.Descriptor instead.
+func (*ListLeasesReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListLeasesReply) GetLeases() []*LeaseInfo {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+type RenewLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Months      int32  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`                              // 续租月数，1-60
+	MonthlyRent int64  `protobuf:"varint,3,opt,name=monthly_rent,json=monthlyRent,proto3" json:"monthly_rent,omitempty"` // 新的月租金，为空不变
+}
+
+func (x *RenewLeaseRequest) Reset() {
+	*x = RenewLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseRequest) ProtoMessage() {}
+
+func (x *RenewLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RenewLeaseRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenewLeaseRequest) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *RenewLeaseRequest) GetMonthlyRent() int64 {
+	if x != nil {
+		return x.MonthlyRent
+	}
+	return 0
+}
+
+type RenewLeaseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *LeaseInfo `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"` // 新租约
+}
+
+func (x *RenewLeaseReply) Reset() {
+	*x = RenewLeaseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseReply) ProtoMessage() {}
+
+func (x *RenewLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseReply.ProtoReflect.Descriptor instead.
+func (*RenewLeaseReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RenewLeaseReply) GetLease() *LeaseInfo {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type TerminateLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date    string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`        // 解约日，为空取今天
+	Penalty int64  `protobuf:"varint,3,opt,name=penalty,proto3" json:"penalty,omitempty"` // 违约金（元）
+	Reason  string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TerminateLeaseRequest) Reset() {
+	*x = TerminateLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminateLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateLeaseRequest) ProtoMessage() {}
+
+func (x *TerminateLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateLeaseRequest.ProtoReflect.Descriptor instead.
+func (*TerminateLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TerminateLeaseRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TerminateLeaseRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TerminateLeaseRequest) GetPenalty() int64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *TerminateLeaseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TerminateLeaseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lease *LeaseInfo `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (x *TerminateLeaseReply) Reset() {
+	*x = TerminateLeaseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminateLeaseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateLeaseReply) ProtoMessage() {}
+
+func (x *TerminateLeaseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateLeaseReply.ProtoReflect.Descriptor instead.
+func (*TerminateLeaseReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TerminateLeaseReply) GetLease() *LeaseInfo {
+	if x != nil {
+		return x.Lease
+	}
+	return nil
+}
+
+type BillInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeaseId     uint64         `protobuf:"varint,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	HouseId     uint64         `protobuf:"varint,3,opt,name=house_id,json=houseId,proto3" json:"house_id,omitempty"`
+	TenantId    uint64         `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	LandlordId  uint64         `protobuf:"varint,5,opt,name=landlord_id,json=landlordId,proto3" json:"landlord_id,omitempty"`
+	Kind        string         `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"` // rent租金 utility固定费用
+	Seq         int32          `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`  // 付款计划的第几期
+	PeriodStart string         `protobuf:"bytes,8,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   string         `protobuf:"bytes,9,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`      // 不含当天
+	DueDate     string         `protobuf:"bytes,10,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`           // 应付日
+	Amount      int64          `protobuf:"varint,11,opt,name=amount,proto3" json:"amount,omitempty"`                           // 应付（元）
+	Items       []*LeaseCharge `protobuf:"bytes,12,rep,name=items,proto3" json:"items,omitempty"`                              // 固定费用明细
+	LateFee     int64          `protobuf:"varint,13,opt,name=late_fee,json=lateFee,proto3" json:"late_fee,omitempty"`          // 滞纳金（元）
+	Status      int32          `protobuf:"varint,14,opt,name=status,proto3" json:"status,omitempty"`                           // 0待支付 1已支付 2已逾期 3已取消
+	PaidAmount  int64          `protobuf:"varint,15,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"` // 实收（元）
+	PaidAt      int64          `protobuf:"varint,16,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`             // 收款时间（unix秒）
+	Note        string         `protobuf:"bytes,17,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *BillInfo) Reset() {
+	*x = BillInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillInfo) ProtoMessage() {}
+
+func (x *BillInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillInfo.ProtoReflect.Descriptor instead.
+func (*BillInfo) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BillInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BillInfo) GetLeaseId() uint64 {
+	if x != nil {
+		return x.LeaseId
+	}
+	return 0
+}
+
+func (x *BillInfo) GetHouseId() uint64 {
+	if x != nil {
+		return x.HouseId
+	}
+	return 0
+}
+
+func (x *BillInfo) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *BillInfo) GetLandlordId() uint64 {
+	if x != nil {
+		return x.LandlordId
+	}
+	return 0
+}
+
+func (x *BillInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BillInfo) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *BillInfo) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *BillInfo) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *BillInfo) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *BillInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BillInfo) GetItems() []*LeaseCharge {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BillInfo) GetLateFee() int64 {
+	if x != nil {
+		return x.LateFee
+	}
+	return 0
+}
+
+func (x *BillInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *BillInfo) GetPaidAmount() int64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *BillInfo) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *BillInfo) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ListBillsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId   uint64 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	LandlordId uint64 `protobuf:"varint,2,opt,name=landlord_id,json=landlordId,proto3" json:"landlord_id,omitempty"`
+	LeaseId    uint64 `protobuf:"varint,3,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Status     *int32 `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status,omitempty"` // 不传不限
+}
+
+func (x *ListBillsRequest) Reset() {
+	*x = ListBillsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBillsRequest) ProtoMessage() {}
+
+func (x *ListBillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBillsRequest.ProtoReflect.Descriptor instead.
+func (*ListBillsRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListBillsRequest) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ListBillsRequest) GetLandlordId() uint64 {
+	if x != nil {
+		return x.LandlordId
+	}
+	return 0
+}
+
+func (x *ListBillsRequest) GetLeaseId() uint64 {
+	if x != nil {
+		return x.LeaseId
+	}
+	return 0
+}
+
+func (x *ListBillsRequest) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+type ListBillsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bills []*BillInfo `protobuf:"bytes,1,rep,name=bills,proto3" json:"bills,omitempty"`
+}
+
+func (x *ListBillsReply) Reset() {
+	*x = ListBillsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBillsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBillsReply) ProtoMessage() {}
+
+func (x *ListBillsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBillsReply.ProtoReflect.Descriptor instead.
+func (*ListBillsReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListBillsReply) GetBills() []*BillInfo {
+	if x != nil {
+		return x.Bills
+	}
+	return nil
+}
+
+type MarkBillPaidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LandlordId   uint64 `protobuf:"varint,2,opt,name=landlord_id,json=landlordId,proto3" json:"landlord_id,omitempty"`
+	WaiveLateFee bool   `protobuf:"varint,3,opt,name=waive_late_fee,json=waiveLateFee,proto3" json:"waive_late_fee,omitempty"` // 减免滞纳金
+	Note         string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *MarkBillPaidRequest) Reset() {
+	*x = MarkBillPaidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkBillPaidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkBillPaidRequest) ProtoMessage() {}
+
+func (x *MarkBillPaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkBillPaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkBillPaidRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MarkBillPaidRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarkBillPaidRequest) GetLandlordId() uint64 {
+	if x != nil {
+		return x.LandlordId
+	}
+	return 0
+}
+
+func (x *MarkBillPaidRequest) GetWaiveLateFee() bool {
+	if x != nil {
+		return x.WaiveLateFee
+	}
+	return false
+}
+
+func (x *MarkBillPaidRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type MarkBillPaidReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bill *BillInfo `protobuf:"bytes,1,opt,name=bill,proto3" json:"bill,omitempty"`
+}
+
+func (x *MarkBillPaidReply) Reset() {
+	*x = MarkBillPaidReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkBillPaidReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkBillPaidReply) ProtoMessage() {}
+
+func (x *MarkBillPaidReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkBillPaidReply.ProtoReflect.Descriptor instead.
+func (*MarkBillPaidReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MarkBillPaidReply) GetBill() *BillInfo {
+	if x != nil {
+		return x.Bill
+	}
+	return nil
+}
+
+type RunBillingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // 按该日执行，为空取今天
+}
+
+func (x *RunBillingRequest) Reset() {
+	*x = RunBillingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunBillingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunBillingRequest) ProtoMessage() {}
+
+func (x *RunBillingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunBillingRequest.ProtoReflect.Descriptor instead.
+func (*RunBillingRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RunBillingRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type RunBillingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issued  int32 `protobuf:"varint,1,opt,name=issued,proto3" json:"issued,omitempty"`   // 新出的账单
+	Overdue int32 `protobuf:"varint,2,opt,name=overdue,proto3" json:"overdue,omitempty"` // 逾期未付的账单
+	Expired int32 `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"` // 到期的租约
+}
+
+func (x *RunBillingReply) Reset() {
+	*x = RunBillingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_transaction_v4_transaction_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunBillingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunBillingReply) ProtoMessage() {}
+
+func (x *RunBillingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v4_transaction_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunBillingReply.ProtoReflect.Descriptor instead.
+func (*RunBillingReply) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v4_transaction_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RunBillingReply) GetIssued() int32 {
+	if x != nil {
+		return x.Issued
+	}
+	return 0
+}
+
+func (x *RunBillingReply) GetOverdue() int32 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+func (x *RunBillingReply) GetExpired() int32 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+var File_api_transaction_v4_transaction_proto protoreflect.FileDescriptor
+
+var file_api_transaction_v4_transaction_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x76, 0x34, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x61,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x62, 0x75, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x62, 0x75, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x4a, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x22, 0x2c, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64,
+	0x65, 0x61, 0x6c, 0x22, 0xdb, 0x02, 0x0a, 0x18, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x6f, 0x6d, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x64, 0x6f,
+	0x77, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x79, 0x65, 0x61, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x79,
+	0x65, 0x61, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x79, 0x65, 0x61, 0x72, 0x73, 0x5f, 0x68, 0x65, 0x6c, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x79, 0x65, 0x61, 0x72, 0x73, 0x48, 0x65, 0x6c,
+	0x64, 0x22, 0x95, 0x01, 0x0a, 0x0f, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x0b, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf4, 0x03, 0x0a, 0x16, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72,
+	0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x4c, 0x6f, 0x61, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x6c, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x70, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x75, 0x70, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x3f,
+	0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22,
+	0x79, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x84, 0x05, 0x0a, 0x09, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79,
+	0x52, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x79, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12,
+	0x29, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x34, 0x2e, 0x52, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x22, 0xa5,
+	0x02, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c,
+	0x79, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x79, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x34, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x21, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x44, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x64,
+	0x6c, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c,
+	0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0x5e,
+	0x0a, 0x11, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x52, 0x65, 0x6e, 0x74, 0x22, 0x46,
+	0x0a, 0x0f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x6d, 0x0a, 0x15, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x13, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x05,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34,
+	0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x22, 0xe1, 0x03, 0x0a, 0x08, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x75,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x75,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x34, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61,
+	0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
+	0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x6e, 0x64, 0x6c,
+	0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x61,
+	0x6e, 0x64, 0x6c, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a,
+	0x05, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x34, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x62, 0x69, 0x6c, 0x6c,
+	0x73, 0x22, 0x80, 0x01, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x6b, 0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61,
+	0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x6e,
+	0x64, 0x6c, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x6c, 0x61, 0x6e, 0x64, 0x6c, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x61,
+	0x69, 0x76, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x76, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x42, 0x69, 0x6c, 0x6c,
+	0x50, 0x61, 0x69, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x62, 0x69, 0x6c,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x42, 0x69, 0x6c,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x69, 0x6c, 0x6c, 0x22, 0x27, 0x0a, 0x11, 0x52,
+	0x75, 0x6e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x42, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x32, 0xcb, 0x0b, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x8d, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13,
+	0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x11,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
+	0x65, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x6f,
+	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2f, 0x73, 0x69,
+	0x67, 0x6e, 0x12, 0x72, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x34, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x79, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x7d, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2f, 0x72, 0x65, 0x6e, 0x65, 0x77,
+	0x12, 0x8d, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x34, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a,
+	0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x12, 0x75, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x34, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c,
+	0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
+	0x16, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x69,
+	0x6c, 0x6c, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b,
+	0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x69, 0x64, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x42, 0x69, 0x6c, 0x6c, 0x50,
+	0x61, 0x69, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x80,
+	0x01, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x34, 0x2e, 0x52, 0x75, 0x6e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x2e, 0x52, 0x75, 0x6e, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75,
+	0x6e, 0x42, 0x48, 0x0a, 0x12, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x34, 0x42, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x34, 0x50, 0x01, 0x5a, 0x1c, 0x61,
+	0x6e, 0x6a, 0x75, 0x6b, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x34, 0x3b, 0x76, 0x34, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_transaction_v4_transaction_proto_rawDescOnce sync.Once
+	file_api_transaction_v4_transaction_proto_rawDescData = file_api_transaction_v4_transaction_proto_rawDesc
+)
+
+func file_api_transaction_v4_transaction_proto_rawDescGZIP() []byte {
+	file_api_transaction_v4_transaction_proto_rawDescOnce.Do(func() {
+		file_api_transaction_v4_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_transaction_v4_transaction_proto_rawDescData)
+	})
+	return file_api_transaction_v4_transaction_proto_rawDescData
+}
+
+var file_api_transaction_v4_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_api_transaction_v4_transaction_proto_goTypes = []any{
+	(*DealInfo)(nil),                   // 0: api.transaction.v4.DealInfo
+	(*CreateTransactionRequest)(nil),   // 1: api.transaction.v4.CreateTransactionRequest
+	(*CreateTransactionReply)(nil),     // 2: api.transaction.v4.CreateTransactionReply
+	(*CompleteTransactionRequest)(nil), // 3: api.transaction.v4.CompleteTransactionRequest
+	(*CompleteTransactionReply)(nil),   // 4: api.transaction.v4.CompleteTransactionReply
+	(*CalculateMortgageRequest)(nil),   // 5: api.transaction.v4.CalculateMortgageRequest
+	(*MortgagePayment)(nil),            // 6: api.transaction.v4.MortgagePayment
+	(*PurchaseFee)(nil),                // 7: api.transaction.v4.PurchaseFee
+	(*CalculateMortgageReply)(nil),     // 8: api.transaction.v4.CalculateMortgageReply
+	(*RentPeriod)(nil),                 // 9: api.transaction.v4.RentPeriod
+	(*LeaseInfo)(nil),                  // 10: api.transaction.v4.LeaseInfo
+	(*LeaseCharge)(nil),                // 11: api.transaction.v4.LeaseCharge
+	(*SignLeaseRequest)(nil),           // 12: api.transaction.v4.SignLeaseRequest
+	(*SignLeaseReply)(nil),             // 13: api.transaction.v4.SignLeaseReply
+	(*GetLeaseRequest)(nil),            // 14: api.transaction.v4.GetLeaseRequest
+	(*GetLeaseReply)(nil),              // 15: api.transaction.v4.GetLeaseReply
+	(*ListLeasesRequest)(nil),          // 16: api.transaction.v4.ListLeasesRequest
+	(*ListLeasesReply)(nil),            // 17: api.transaction.v4.ListLeasesReply
+	(*RenewLeaseRequest)(nil),          // 18: api.transaction.v4.RenewLeaseRequest
+	(*RenewLeaseReply)(nil),            // 19: api.transaction.v4.RenewLeaseReply
+	(*TerminateLeaseRequest)(nil),      // 20: api.transaction.v4.TerminateLeaseRequest
+	(*TerminateLeaseReply)(nil),        // 21: api.transaction.v4.TerminateLeaseReply
+	(*BillInfo)(nil),                   // 22: api.transaction.v4.BillInfo
+	(*ListBillsRequest)(nil),           // 23: api.transaction.v4.ListBillsRequest
+	(*ListBillsReply)(nil),             // 24: api.transaction.v4.ListBillsReply
+	(*MarkBillPaidRequest)(nil),        // 25: api.transaction.v4.MarkBillPaidRequest
+	(*MarkBillPaidReply)(nil),          // 26: api.transaction.v4.MarkBillPaidReply
+	(*RunBillingRequest)(nil),          // 27: api.transaction.v4.RunBillingRequest
+	(*RunBillingReply)(nil),            // 28: api.transaction.v4.RunBillingReply
+}
+var file_api_transaction_v4_transaction_proto_depIdxs = []int32{
+	0,  // 0: api.transaction.v4.CreateTransactionReply.deal:type_name -> api.transaction.v4.DealInfo
+	0,  // 1: api.transaction.v4.CompleteTransactionReply.deal:type_name -> api.transaction.v4.DealInfo
+	7,  // 2: api.transaction.v4.CalculateMortgageReply.fees:type_name -> api.transaction.v4.PurchaseFee
+	6,  // 3: api.transaction.v4.CalculateMortgageReply.schedule:type_name -> api.transaction.v4.MortgagePayment
+	9,  // 4: api.transaction.v4.LeaseInfo.schedule:type_name -> api.transaction.v4.RentPeriod
+	11, // 5: api.transaction.v4.LeaseInfo.charges:type_name -> api.transaction.v4.LeaseCharge
+	11, // 6: api.transaction.v4.SignLeaseRequest.charges:type_name -> api.transaction.v4.LeaseCharge
+	10, // 7: api.transaction.v4.SignLeaseReply.lease:type_name -> api.transaction.v4.LeaseInfo
+	10, // 8: api.transaction.v4.GetLeaseReply.lease:type_name -> api.transaction.v4.LeaseInfo
+	10, // 9: api.transaction.v4.ListLeasesReply.leases:type_name -> api.transaction.v4.LeaseInfo
+	10, // 10: api.transaction.v4.RenewLeaseReply.lease:type_name -> api.transaction.v4.LeaseInfo
+	10, // 11: api.transaction.v4.TerminateLeaseReply.lease:type_name -> api.transaction.v4.LeaseInfo
+	11, // 12: api.transaction.v4.BillInfo.items:type_name -> api.transaction.v4.LeaseCharge
+	22, // 13: api.transaction.v4.ListBillsReply.bills:type_name -> api.transaction.v4.BillInfo
+	22, // 14: api.transaction.v4.MarkBillPaidReply.bill:type_name -> api.transaction.v4.BillInfo
+	1,  // 15: api.transaction.v4.Transaction.CreateTransaction:input_type -> api.transaction.v4.CreateTransactionRequest
+	3,  // 16: api.transaction.v4.Transaction.CompleteTransaction:input_type -> api.transaction.v4.CompleteTransactionRequest
+	5,  // 17: api.transaction.v4.Transaction.CalculateMortgage:input_type -> api.transaction.v4.CalculateMortgageRequest
+	12, // 18: api.transaction.v4.Transaction.SignLease:input_type -> api.transaction.v4.SignLeaseRequest
+	14, // 19: api.transaction.v4.Transaction.GetLease:input_type -> api.transaction.v4.GetLeaseRequest
+	16, // 20: api.transaction.v4.Transaction.ListLeases:input_type -> api.transaction.v4.ListLeasesRequest
+	18, // 21: api.transaction.v4.Transaction.RenewLease:input_type -> api.transaction.v4.RenewLeaseRequest
+	20, // 22: api.transaction.v4.Transaction.TerminateLease:input_type -> api.transaction.v4.TerminateLeaseRequest
+	23, // 23: api.transaction.v4.Transaction.ListBills:input_type -> api.transaction.v4.ListBillsRequest
+	25, // 24: api.transaction.v4.Transaction.MarkBillPaid:input_type -> api.transaction.v4.MarkBillPaidRequest
+	27, // 25: api.transaction.v4.Transaction.RunBilling:input_type -> api.transaction.v4.RunBillingRequest
+	2,  // 26: api.transaction.v4.Transaction.CreateTransaction:output_type -> api.transaction.v4.CreateTransactionReply
+	4,  // 27: api.transaction.v4.Transaction.CompleteTransaction:output_type -> api.transaction.v4.CompleteTransactionReply
+	8,  // 28: api.transaction.v4.Transaction.CalculateMortgage:output_type -> api.transaction.v4.CalculateMortgageReply
+	13, // 29: api.transaction.v4.Transaction.SignLease:output_type -> api.transaction.v4.SignLeaseReply
+	15, // 30: api.transaction.v4.Transaction.GetLease:output_type -> api.transaction.v4.GetLeaseReply
+	17, // 31: api.transaction.v4.Transaction.ListLeases:output_type -> api.transaction.v4.ListLeasesReply
+	19, // 32: api.transaction.v4.Transaction.RenewLease:output_type -> api.transaction.v4.RenewLeaseReply
+	21, // 33: api.transaction.v4.Transaction.TerminateLease:output_type -> api.transaction.v4.TerminateLeaseReply
+	24, // 34: api.transaction.v4.Transaction.ListBills:output_type -> api.transaction.v4.ListBillsReply
+	26, // 35: api.transaction.v4.Transaction.MarkBillPaid:output_type -> api.transaction.v4.MarkBillPaidReply
+	28, // 36: api.transaction.v4.Transaction.RunBilling:output_type -> api.transaction.v4.RunBillingReply
+	26, // [26:37] is the sub-list for method output_type
+	15, // [15:26] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_api_transaction_v4_transaction_proto_init() }
+func file_api_transaction_v4_transaction_proto_init() {
+	if File_api_transaction_v4_transaction_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_transaction_v4_transaction_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*DealInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTransactionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CompleteTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*CompleteTransactionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*CalculateMortgageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*MortgagePayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CalculateMortgageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RentPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaseInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaseCharge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*SignLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*SignLeaseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLeaseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLeasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLeasesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*RenewLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*RenewLeaseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*TerminateLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*TerminateLeaseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*BillInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBillsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ListBillsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkBillPaidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkBillPaidReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RunBillingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_transaction_v4_transaction_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*RunBillingReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -184,13 +2994,14 @@ func file_api_transaction_v4_transaction_proto_init() {
 			}
 		}
 	}
+	file_api_transaction_v4_transaction_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_transaction_v4_transaction_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
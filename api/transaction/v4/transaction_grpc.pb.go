@@ -19,7 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Transaction_CreateTransaction_FullMethodName = "/api.transaction.v4.Transaction/CreateTransaction"
+	Transaction_CreateTransaction_FullMethodName   = "/api.transaction.v4.Transaction/CreateTransaction"
+	Transaction_CompleteTransaction_FullMethodName = "/api.transaction.v4.Transaction/CompleteTransaction"
+	Transaction_CalculateMortgage_FullMethodName   = "/api.transaction.v4.Transaction/CalculateMortgage"
+	Transaction_SignLease_FullMethodName           = "/api.transaction.v4.Transaction/SignLease"
+	Transaction_GetLease_FullMethodName            = "/api.transaction.v4.Transaction/GetLease"
+	Transaction_ListLeases_FullMethodName          = "/api.transaction.v4.Transaction/ListLeases"
+	Transaction_RenewLease_FullMethodName          = "/api.transaction.v4.Transaction/RenewLease"
+	Transaction_TerminateLease_FullMethodName      = "/api.transaction.v4.Transaction/TerminateLease"
+	Transaction_ListBills_FullMethodName           = "/api.transaction.v4.Transaction/ListBills"
+	Transaction_MarkBillPaid_FullMethodName        = "/api.transaction.v4.Transaction/MarkBillPaid"
+	Transaction_RunBilling_FullMethodName          = "/api.transaction.v4.Transaction/RunBilling"
 )
 
 // TransactionClient is the client API for Transaction service.
@@ -27,6 +37,24 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TransactionClient interface {
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionReply, error)
+	CompleteTransaction(ctx context.Context, in *CompleteTransactionRequest, opts ...grpc.CallOption) (*CompleteTransactionReply, error)
+	// 房贷和购房成本计算，不落库
+	CalculateMortgage(ctx context.Context, in *CalculateMortgageRequest, opts ...grpc.CallOption) (*CalculateMortgageReply, error)
+	// 签订租约，房源须为在租的出租房源，签约后房源标记为已出租
+	SignLease(ctx context.Context, in *SignLeaseRequest, opts ...grpc.CallOption) (*SignLeaseReply, error)
+	GetLease(ctx context.Context, in *GetLeaseRequest, opts ...grpc.CallOption) (*GetLeaseReply, error)
+	// 按租客、房东或房源查询租约，新签的在前
+	ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesReply, error)
+	// 续租：从原租约到期日起签一份新租约，每份租约只能续租一次
+	RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*RenewLeaseReply, error)
+	// 提前解约：租金计到解约日前一天，房源自解约日起重新上架
+	TerminateLease(ctx context.Context, in *TerminateLeaseRequest, opts ...grpc.CallOption) (*TerminateLeaseReply, error)
+	// 按租客、房东或租约查询账单，应付日晚的在前，逾期状态和滞纳金算到今天
+	ListBills(ctx context.Context, in *ListBillsRequest, opts ...grpc.CallOption) (*ListBillsReply, error)
+	// 房东确认收款，实收为应付加截至今天的滞纳金，可减免滞纳金
+	MarkBillPaid(ctx context.Context, in *MarkBillPaidRequest, opts ...grpc.CallOption) (*MarkBillPaidReply, error)
+	// 立即执行每日账单任务：出账、标记到期租约和逾期账单
+	RunBilling(ctx context.Context, in *RunBillingRequest, opts ...grpc.CallOption) (*RunBillingReply, error)
 }
 
 type transactionClient struct {
@@ -47,11 +75,129 @@ func (c *transactionClient) CreateTransaction(ctx context.Context, in *CreateTra
 	return out, nil
 }
 
+func (c *transactionClient) CompleteTransaction(ctx context.Context, in *CompleteTransactionRequest, opts ...grpc.CallOption) (*CompleteTransactionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteTransactionReply)
+	err := c.cc.Invoke(ctx, Transaction_CompleteTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) CalculateMortgage(ctx context.Context, in *CalculateMortgageRequest, opts ...grpc.CallOption) (*CalculateMortgageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateMortgageReply)
+	err := c.cc.Invoke(ctx, Transaction_CalculateMortgage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) SignLease(ctx context.Context, in *SignLeaseRequest, opts ...grpc.CallOption) (*SignLeaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignLeaseReply)
+	err := c.cc.Invoke(ctx, Transaction_SignLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) GetLease(ctx context.Context, in *GetLeaseRequest, opts ...grpc.CallOption) (*GetLeaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaseReply)
+	err := c.cc.Invoke(ctx, Transaction_GetLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeasesReply)
+	err := c.cc.Invoke(ctx, Transaction_ListLeases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*RenewLeaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewLeaseReply)
+	err := c.cc.Invoke(ctx, Transaction_RenewLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) TerminateLease(ctx context.Context, in *TerminateLeaseRequest, opts ...grpc.CallOption) (*TerminateLeaseReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TerminateLeaseReply)
+	err := c.cc.Invoke(ctx, Transaction_TerminateLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) ListBills(ctx context.Context, in *ListBillsRequest, opts ...grpc.CallOption) (*ListBillsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBillsReply)
+	err := c.cc.Invoke(ctx, Transaction_ListBills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) MarkBillPaid(ctx context.Context, in *MarkBillPaidRequest, opts ...grpc.CallOption) (*MarkBillPaidReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkBillPaidReply)
+	err := c.cc.Invoke(ctx, Transaction_MarkBillPaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) RunBilling(ctx context.Context, in *RunBillingRequest, opts ...grpc.CallOption) (*RunBillingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunBillingReply)
+	err := c.cc.Invoke(ctx, Transaction_RunBilling_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServer is the server API for Transaction service.
 // All implementations must embed UnimplementedTransactionServer
 // for forward compatibility
 type TransactionServer interface {
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionReply, error)
+	CompleteTransaction(context.Context, *CompleteTransactionRequest) (*CompleteTransactionReply, error)
+	// 房贷和购房成本计算，不落库
+	CalculateMortgage(context.Context, *CalculateMortgageRequest) (*CalculateMortgageReply, error)
+	// 签订租约，房源须为在租的出租房源，签约后房源标记为已出租
+	SignLease(context.Context, *SignLeaseRequest) (*SignLeaseReply, error)
+	GetLease(context.Context, *GetLeaseRequest) (*GetLeaseReply, error)
+	// 按租客、房东或房源查询租约，新签的在前
+	ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesReply, error)
+	// 续租：从原租约到期日起签一份新租约，每份租约只能续租一次
+	RenewLease(context.Context, *RenewLeaseRequest) (*RenewLeaseReply, error)
+	// 提前解约：租金计到解约日前一天，房源自解约日起重新上架
+	TerminateLease(context.Context, *TerminateLeaseRequest) (*TerminateLeaseReply, error)
+	// 按租客、房东或租约查询账单，应付日晚的在前，逾期状态和滞纳金算到今天
+	ListBills(context.Context, *ListBillsRequest) (*ListBillsReply, error)
+	// 房东确认收款，实收为应付加截至今天的滞纳金，可减免滞纳金
+	MarkBillPaid(context.Context, *MarkBillPaidRequest) (*MarkBillPaidReply, error)
+	// 立即执行每日账单任务：出账、标记到期租约和逾期账单
+	RunBilling(context.Context, *RunBillingRequest) (*RunBillingReply, error)
 	mustEmbedUnimplementedTransactionServer()
 }
 
@@ -62,6 +208,36 @@ type UnimplementedTransactionServer struct {
 func (UnimplementedTransactionServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
 }
+func (UnimplementedTransactionServer) CompleteTransaction(context.Context, *CompleteTransactionRequest) (*CompleteTransactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTransaction not implemented")
+}
+func (UnimplementedTransactionServer) CalculateMortgage(context.Context, *CalculateMortgageRequest) (*CalculateMortgageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateMortgage not implemented")
+}
+func (UnimplementedTransactionServer) SignLease(context.Context, *SignLeaseRequest) (*SignLeaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignLease not implemented")
+}
+func (UnimplementedTransactionServer) GetLease(context.Context, *GetLeaseRequest) (*GetLeaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLease not implemented")
+}
+func (UnimplementedTransactionServer) ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeases not implemented")
+}
+func (UnimplementedTransactionServer) RenewLease(context.Context, *RenewLeaseRequest) (*RenewLeaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLease not implemented")
+}
+func (UnimplementedTransactionServer) TerminateLease(context.Context, *TerminateLeaseRequest) (*TerminateLeaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateLease not implemented")
+}
+func (UnimplementedTransactionServer) ListBills(context.Context, *ListBillsRequest) (*ListBillsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBills not implemented")
+}
+func (UnimplementedTransactionServer) MarkBillPaid(context.Context, *MarkBillPaidRequest) (*MarkBillPaidReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkBillPaid not implemented")
+}
+func (UnimplementedTransactionServer) RunBilling(context.Context, *RunBillingRequest) (*RunBillingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunBilling not implemented")
+}
 func (UnimplementedTransactionServer) mustEmbedUnimplementedTransactionServer() {}
 
 // UnsafeTransactionServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +269,186 @@ func _Transaction_CreateTransaction_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_CompleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).CompleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_CompleteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).CompleteTransaction(ctx, req.(*CompleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_CalculateMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateMortgageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).CalculateMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_CalculateMortgage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).CalculateMortgage(ctx, req.(*CalculateMortgageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_SignLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).SignLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_SignLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).SignLease(ctx, req.(*SignLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_GetLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_GetLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetLease(ctx, req.(*GetLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_ListLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).ListLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_ListLeases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).ListLeases(ctx, req.(*ListLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_RenewLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).RenewLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_RenewLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).RenewLease(ctx, req.(*RenewLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_TerminateLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).TerminateLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_TerminateLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).TerminateLease(ctx, req.(*TerminateLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_ListBills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).ListBills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_ListBills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).ListBills(ctx, req.(*ListBillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_MarkBillPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkBillPaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).MarkBillPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_MarkBillPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).MarkBillPaid(ctx, req.(*MarkBillPaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_RunBilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunBillingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).RunBilling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_RunBilling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).RunBilling(ctx, req.(*RunBillingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Transaction_ServiceDesc is the grpc.ServiceDesc for Transaction service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,6 +460,46 @@ var Transaction_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTransaction",
 			Handler:    _Transaction_CreateTransaction_Handler,
 		},
+		{
+			MethodName: "CompleteTransaction",
+			Handler:    _Transaction_CompleteTransaction_Handler,
+		},
+		{
+			MethodName: "CalculateMortgage",
+			Handler:    _Transaction_CalculateMortgage_Handler,
+		},
+		{
+			MethodName: "SignLease",
+			Handler:    _Transaction_SignLease_Handler,
+		},
+		{
+			MethodName: "GetLease",
+			Handler:    _Transaction_GetLease_Handler,
+		},
+		{
+			MethodName: "ListLeases",
+			Handler:    _Transaction_ListLeases_Handler,
+		},
+		{
+			MethodName: "RenewLease",
+			Handler:    _Transaction_RenewLease_Handler,
+		},
+		{
+			MethodName: "TerminateLease",
+			Handler:    _Transaction_TerminateLease_Handler,
+		},
+		{
+			MethodName: "ListBills",
+			Handler:    _Transaction_ListBills_Handler,
+		},
+		{
+			MethodName: "MarkBillPaid",
+			Handler:    _Transaction_MarkBillPaid_Handler,
+		},
+		{
+			MethodName: "RunBilling",
+			Handler:    _Transaction_RunBilling_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/transaction/v4/transaction.proto",
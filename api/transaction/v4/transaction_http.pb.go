@@ -20,14 +20,52 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationTransactionCreateTransaction = "/api.transaction.v4.Transaction/CreateTransaction"
+const OperationTransactionCompleteTransaction = "/api.transaction.v4.Transaction/CompleteTransaction"
+const OperationTransactionCalculateMortgage = "/api.transaction.v4.Transaction/CalculateMortgage"
+const OperationTransactionSignLease = "/api.transaction.v4.Transaction/SignLease"
+const OperationTransactionGetLease = "/api.transaction.v4.Transaction/GetLease"
+const OperationTransactionListLeases = "/api.transaction.v4.Transaction/ListLeases"
+const OperationTransactionRenewLease = "/api.transaction.v4.Transaction/RenewLease"
+const OperationTransactionTerminateLease = "/api.transaction.v4.Transaction/TerminateLease"
+const OperationTransactionListBills = "/api.transaction.v4.Transaction/ListBills"
+const OperationTransactionMarkBillPaid = "/api.transaction.v4.Transaction/MarkBillPaid"
+const OperationTransactionRunBilling = "/api.transaction.v4.Transaction/RunBilling"
 
 type TransactionHTTPServer interface {
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionReply, error)
+	CompleteTransaction(context.Context, *CompleteTransactionRequest) (*CompleteTransactionReply, error)
+	// 房贷和购房成本计算，不落库
+	CalculateMortgage(context.Context, *CalculateMortgageRequest) (*CalculateMortgageReply, error)
+	// 签订租约，房源须为在租的出租房源，签约后房源标记为已出租
+	SignLease(context.Context, *SignLeaseRequest) (*SignLeaseReply, error)
+	GetLease(context.Context, *GetLeaseRequest) (*GetLeaseReply, error)
+	// 按租客、房东或房源查询租约，新签的在前
+	ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesReply, error)
+	// 续租：从原租约到期日起签一份新租约，每份租约只能续租一次
+	RenewLease(context.Context, *RenewLeaseRequest) (*RenewLeaseReply, error)
+	// 提前解约：租金计到解约日前一天，房源自解约日起重新上架
+	TerminateLease(context.Context, *TerminateLeaseRequest) (*TerminateLeaseReply, error)
+	// 按租客、房东或租约查询账单，应付日晚的在前，逾期状态和滞纳金算到今天
+	ListBills(context.Context, *ListBillsRequest) (*ListBillsReply, error)
+	// 房东确认收款，实收为应付加截至今天的滞纳金，可减免滞纳金
+	MarkBillPaid(context.Context, *MarkBillPaidRequest) (*MarkBillPaidReply, error)
+	// 立即执行每日账单任务：出账、标记到期租约和逾期账单
+	RunBilling(context.Context, *RunBillingRequest) (*RunBillingReply, error)
 }
 
 func RegisterTransactionHTTPServer(s *http.Server, srv TransactionHTTPServer) {
 	r := s.Route("/")
 	r.POST("/transaction/create", _Transaction_CreateTransaction0_HTTP_Handler(srv))
+	r.POST("/transaction/complete", _Transaction_CompleteTransaction0_HTTP_Handler(srv))
+	r.POST("/transaction/mortgage/calculate", _Transaction_CalculateMortgage0_HTTP_Handler(srv))
+	r.POST("/transaction/lease/sign", _Transaction_SignLease0_HTTP_Handler(srv))
+	r.GET("/transaction/lease/get", _Transaction_GetLease0_HTTP_Handler(srv))
+	r.GET("/transaction/lease/list", _Transaction_ListLeases0_HTTP_Handler(srv))
+	r.POST("/transaction/lease/renew", _Transaction_RenewLease0_HTTP_Handler(srv))
+	r.POST("/transaction/lease/terminate", _Transaction_TerminateLease0_HTTP_Handler(srv))
+	r.GET("/transaction/bill/list", _Transaction_ListBills0_HTTP_Handler(srv))
+	r.POST("/transaction/bill/receipt", _Transaction_MarkBillPaid0_HTTP_Handler(srv))
+	r.POST("/admin/transaction/bill/run", _Transaction_RunBilling0_HTTP_Handler(srv))
 }
 
 func _Transaction_CreateTransaction0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
@@ -52,8 +90,229 @@ func _Transaction_CreateTransaction0_HTTP_Handler(srv TransactionHTTPServer) fun
 	}
 }
 
+func _Transaction_CompleteTransaction0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompleteTransactionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionCompleteTransaction)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompleteTransaction(ctx, req.(*CompleteTransactionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompleteTransactionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_CalculateMortgage0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CalculateMortgageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionCalculateMortgage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CalculateMortgage(ctx, req.(*CalculateMortgageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CalculateMortgageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_SignLease0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SignLeaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionSignLease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SignLease(ctx, req.(*SignLeaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SignLeaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_GetLease0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetLeaseRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionGetLease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetLease(ctx, req.(*GetLeaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetLeaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_ListLeases0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLeasesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionListLeases)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLeases(ctx, req.(*ListLeasesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLeasesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_RenewLease0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenewLeaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionRenewLease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenewLease(ctx, req.(*RenewLeaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenewLeaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_TerminateLease0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TerminateLeaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionTerminateLease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TerminateLease(ctx, req.(*TerminateLeaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TerminateLeaseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_ListBills0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBillsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionListBills)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBills(ctx, req.(*ListBillsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBillsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_MarkBillPaid0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MarkBillPaidRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionMarkBillPaid)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MarkBillPaid(ctx, req.(*MarkBillPaidRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MarkBillPaidReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Transaction_RunBilling0_HTTP_Handler(srv TransactionHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RunBillingRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTransactionRunBilling)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RunBilling(ctx, req.(*RunBillingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RunBillingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type TransactionHTTPClient interface {
 	CreateTransaction(ctx context.Context, req *CreateTransactionRequest, opts ...http.CallOption) (rsp *CreateTransactionReply, err error)
+	CompleteTransaction(ctx context.Context, req *CompleteTransactionRequest, opts ...http.CallOption) (rsp *CompleteTransactionReply, err error)
+	CalculateMortgage(ctx context.Context, req *CalculateMortgageRequest, opts ...http.CallOption) (rsp *CalculateMortgageReply, err error)
+	SignLease(ctx context.Context, req *SignLeaseRequest, opts ...http.CallOption) (rsp *SignLeaseReply, err error)
+	GetLease(ctx context.Context, req *GetLeaseRequest, opts ...http.CallOption) (rsp *GetLeaseReply, err error)
+	ListLeases(ctx context.Context, req *ListLeasesRequest, opts ...http.CallOption) (rsp *ListLeasesReply, err error)
+	RenewLease(ctx context.Context, req *RenewLeaseRequest, opts ...http.CallOption) (rsp *RenewLeaseReply, err error)
+	TerminateLease(ctx context.Context, req *TerminateLeaseRequest, opts ...http.CallOption) (rsp *TerminateLeaseReply, err error)
+	ListBills(ctx context.Context, req *ListBillsRequest, opts ...http.CallOption) (rsp *ListBillsReply, err error)
+	MarkBillPaid(ctx context.Context, req *MarkBillPaidRequest, opts ...http.CallOption) (rsp *MarkBillPaidReply, err error)
+	RunBilling(ctx context.Context, req *RunBillingRequest, opts ...http.CallOption) (rsp *RunBillingReply, err error)
 }
 
 type TransactionHTTPClientImpl struct {
@@ -76,3 +335,133 @@ func (c *TransactionHTTPClientImpl) CreateTransaction(ctx context.Context, in *C
 	}
 	return &out, nil
 }
+
+func (c *TransactionHTTPClientImpl) CompleteTransaction(ctx context.Context, in *CompleteTransactionRequest, opts ...http.CallOption) (*CompleteTransactionReply, error) {
+	var out CompleteTransactionReply
+	pattern := "/transaction/complete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionCompleteTransaction))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) CalculateMortgage(ctx context.Context, in *CalculateMortgageRequest, opts ...http.CallOption) (*CalculateMortgageReply, error) {
+	var out CalculateMortgageReply
+	pattern := "/transaction/mortgage/calculate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionCalculateMortgage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) SignLease(ctx context.Context, in *SignLeaseRequest, opts ...http.CallOption) (*SignLeaseReply, error) {
+	var out SignLeaseReply
+	pattern := "/transaction/lease/sign"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionSignLease))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) GetLease(ctx context.Context, in *GetLeaseRequest, opts ...http.CallOption) (*GetLeaseReply, error) {
+	var out GetLeaseReply
+	pattern := "/transaction/lease/get"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTransactionGetLease))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...http.CallOption) (*ListLeasesReply, error) {
+	var out ListLeasesReply
+	pattern := "/transaction/lease/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTransactionListLeases))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...http.CallOption) (*RenewLeaseReply, error) {
+	var out RenewLeaseReply
+	pattern := "/transaction/lease/renew"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionRenewLease))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) TerminateLease(ctx context.Context, in *TerminateLeaseRequest, opts ...http.CallOption) (*TerminateLeaseReply, error) {
+	var out TerminateLeaseReply
+	pattern := "/transaction/lease/terminate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionTerminateLease))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) ListBills(ctx context.Context, in *ListBillsRequest, opts ...http.CallOption) (*ListBillsReply, error) {
+	var out ListBillsReply
+	pattern := "/transaction/bill/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTransactionListBills))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) MarkBillPaid(ctx context.Context, in *MarkBillPaidRequest, opts ...http.CallOption) (*MarkBillPaidReply, error) {
+	var out MarkBillPaidReply
+	pattern := "/transaction/bill/receipt"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionMarkBillPaid))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TransactionHTTPClientImpl) RunBilling(ctx context.Context, in *RunBillingRequest, opts ...http.CallOption) (*RunBillingReply, error) {
+	var out RunBillingReply
+	pattern := "/admin/transaction/bill/run"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTransactionRunBilling))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// runRebuildIndex implements the `rebuild-index` subcommand. The index is
+// built next to the configured one and then swapped in, so a failed run
+// leaves the old index untouched. Run it on each instance with the service
+// stopped: listing changes made meanwhile wait in the instance's consumer
+// group and are applied once it starts again, while the changes the new
+// index already has are skipped by moving the group past them.
+func runRebuildIndex(bc *conf.Bootstrap, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("rebuild-index", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if !conf.ValidSearchInstance(bc.Data.GetSearch().GetInstance()) {
+		return fmt.Errorf("data.search.instance 不能为空（设置环境变量 ANJUKE_SEARCH_INSTANCE）")
+	}
+	path := bc.Data.GetSearch().GetPath()
+	tmp, old := path+".rebuild", path+".old"
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+
+	c := proto.Clone(bc.Data).(*conf.Data)
+	c.Search.Path = tmp
+	uc, cleanup, err := wireSearch(c, logger)
+	if err != nil {
+		return err
+	}
+	n, pos, err := uc.Rebuild(context.Background())
+	cleanup()
+	if err != nil {
+		os.RemoveAll(tmp)
+		return err
+	}
+
+	if err := os.RemoveAll(old); err != nil {
+		return err
+	}
+	if err := os.Rename(path, old); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	if err := resumeIndex(bc.Data, logger, pos); err != nil {
+		return fmt.Errorf("索引已替换，但消费组未能跳过 %s 之前的事件: %w", pos, err)
+	}
+	fmt.Printf("indexed %d houses into %s\n", n, path)
+	return os.RemoveAll(old)
+}
+
+// resumeIndex moves the consumer group of the swapped-in index to pos. The
+// group is only moved once the index is in place, so a failed swap leaves
+// the old index with every change it still has to apply.
+func resumeIndex(c *conf.Data, logger log.Logger, pos string) error {
+	uc, cleanup, err := wireSearch(c, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	return uc.Resume(context.Background(), pos)
+}
@@ -2,12 +2,16 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"anjuke/internal/conf"
+	"anjuke/internal/data"
+	"anjuke/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -25,15 +29,88 @@ var (
 	Version string
 	// flagconf is the config flag.
 	flagconf string
+	// flagsecrets is an optional local file holding secrets, never committed.
+	flagsecrets string
 
 	id, _ = os.Hostname()
 )
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.StringVar(&flagsecrets, "secrets", "", "optional secrets file, eg: -secrets ~/.anjuke/secrets.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+// envPrefix is stripped from environment variables before they are merged,
+// e.g. ANJUKE_MYSQL_DSN resolves the ${MYSQL_DSN} placeholder.
+const envPrefix = "ANJUKE_"
+
+// hotKeys are the config keys whose changes are applied without a restart;
+// the components reading them hold the *conf.Dynamic.
+var hotKeys = []string{"log", "features", "server.rate_limit"}
+
+// newConfig layers the config sources; later sources override earlier ones.
+// A secrets file given explicitly must exist.
+func newConfig(confPath, secretsPath string) (config.Config, error) {
+	sources := []config.Source{file.NewSource(confPath)}
+	if secretsPath != "" {
+		if _, err := os.Stat(secretsPath); err != nil {
+			return nil, fmt.Errorf("secrets file: %v", err)
+		}
+		sources = append(sources, file.NewSource(secretsPath))
+	}
+	sources = append(sources, env.NewSource(envPrefix))
+	return config.New(config.WithSource(sources...)), nil
+}
+
+// watchConfig reloads the config on change and pushes it to dc. Invalid
+// updates are logged and dropped so a bad edit never takes the server down.
+func watchConfig(c config.Config, dc *conf.Dynamic, logger log.Logger) {
+	helper := log.NewHelper(logger)
+	reload := func(key string, _ config.Value) {
+		var bc conf.Bootstrap
+		if err := c.Scan(&bc); err != nil {
+			helper.Errorf("config reload %s: %v", key, err)
+			return
+		}
+		if err := bc.Validate(); err != nil {
+			helper.Errorf("config reload %s: %v", key, err)
+			return
+		}
+		dc.Update(&bc)
+		helper.Infof("config reloaded: %s", key)
+	}
+	for _, key := range hotKeys {
+		if err := c.Watch(key, reload); err != nil {
+			helper.Warnf("config watch %s: %v", key, err)
+		}
+	}
+}
+
+// validate checks the config for what is about to run: the subcommands
+// only use the data section, so they need no server settings such as the
+// admin token.
+func validate(bc *conf.Bootstrap, args []string) error {
+	if len(args) > 0 {
+		return bc.ValidateData()
+	}
+	return bc.Validate()
+}
+
+// runCommand dispatches the subcommands that run instead of the server.
+func runCommand(bc *conf.Bootstrap, logger log.Logger, args []string) error {
+	switch args[0] {
+	case "migrate":
+		return runMigrate(bc, logger, args[1:])
+	case "seed":
+		return runSeed(bc, logger, args[1:])
+	case "rebuild-index":
+		return runRebuildIndex(bc, logger, args[1:])
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, relay *data.OutboxRelay, bus *data.RedisEventBus, browse *data.BrowseFlusher, stats *server.StatsScheduler, billing *server.BillingScheduler) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,26 +120,21 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			relay,
+			bus,
+			browse,
+			stats,
+			billing,
 		),
 	)
 }
 
 func main() {
 	flag.Parse()
-	logger := log.With(log.NewStdLogger(os.Stdout),
-		"ts", log.DefaultTimestamp,
-		"caller", log.DefaultCaller,
-		"service.id", id,
-		"service.name", Name,
-		"service.version", Version,
-		"trace.id", tracing.TraceID(),
-		"span.id", tracing.SpanID(),
-	)
-	c := config.New(
-		config.WithSource(
-			file.NewSource(flagconf),
-		),
-	)
+	c, err := newConfig(flagconf, flagsecrets)
+	if err != nil {
+		panic(err)
+	}
 	defer c.Close()
 
 	if err := c.Load(); err != nil {
@@ -73,8 +145,35 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
+	if err := validate(&bc, flag.Args()); err != nil {
+		panic(err)
+	}
+	dc := conf.NewDynamic(&bc)
+
+	// 日志级别随配置热更新
+	stdLogger := log.NewFilter(log.NewStdLogger(os.Stdout), log.FilterFunc(func(level log.Level, _ ...interface{}) bool {
+		return level < dc.Level()
+	}))
+	logger := log.With(stdLogger,
+		"ts", log.DefaultTimestamp,
+		"caller", log.DefaultCaller,
+		"service.id", id,
+		"service.name", Name,
+		"service.version", Version,
+		"trace.id", tracing.TraceID(),
+		"span.id", tracing.SpanID(),
+	)
+	// 子命令，例如 anjuke -conf ../../configs migrate up
+	if args := flag.Args(); len(args) > 0 {
+		if err := runCommand(&bc, logger, args); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	watchConfig(c, dc, logger)
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, dc, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const testConfig = `
+server:
+  http: {addr: 127.0.0.1:8000}
+  grpc: {addr: 127.0.0.1:9000}
+  rate_limit:
+    policies:
+      - {operation: /api.house.v3.House/SearchHouses, key: ip, limit: %LIMIT%, window: 60s}
+data:
+  database: {driver: mysql, source: "${MYSQL_DSN}"}
+  redis: {addr: 127.0.0.1:6379}
+  search: {path: houses.bleve, instance: test}
+log:
+  level: %LEVEL%
+features: {rate_limit_dry_run: %DRYRUN%}
+`
+
+func writeConfig(t *testing.T, path, limit, level, dryRun string) {
+	t.Helper()
+	s := strings.NewReplacer("%LIMIT%", limit, "%LEVEL%", level, "%DRYRUN%", dryRun).Replace(testConfig)
+	if err := os.WriteFile(path, []byte(s), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewConfig_Secrets(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, filepath.Join(dir, "config.yaml"), "60", "info", "false")
+	if _, err := newConfig(dir, filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("newConfig() with a missing secrets file error = nil")
+	}
+
+	secrets := filepath.Join(t.TempDir(), "secrets.yaml")
+	if err := os.WriteFile(secrets, []byte("MYSQL_DSN: root:secret@tcp(127.0.0.1:3306)/anjuke\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := newConfig(dir, secrets)
+	if err != nil {
+		t.Fatalf("newConfig() error = %v", err)
+	}
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		t.Fatal(err)
+	}
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		t.Fatal(err)
+	}
+	if got := bc.GetData().GetDatabase().GetSource(); got != "root:secret@tcp(127.0.0.1:3306)/anjuke" {
+		t.Errorf("source = %q, want the DSN from the secrets file", got)
+	}
+}
+
+func TestWatchConfig(t *testing.T) {
+	t.Setenv(envPrefix+"MYSQL_DSN", "root@tcp(127.0.0.1:3306)/anjuke")
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	writeConfig(t, path, "60", "info", "false")
+	c, err := newConfig(dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		t.Fatal(err)
+	}
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		t.Fatal(err)
+	}
+	dc := conf.NewDynamic(&bc)
+	updates := make(chan *conf.Bootstrap, 16)
+	dc.Subscribe(func(bc *conf.Bootstrap) { updates <- bc })
+	watchConfig(c, dc, log.DefaultLogger)
+
+	limit := func() int32 { return dc.Current().GetServer().GetRateLimit().GetPolicies()[0].GetLimit() }
+	// 等到三个热更新项都生效：每个键的变化各触发一次更新
+	waitFor := func(ok func() bool) {
+		t.Helper()
+		deadline := time.After(5 * time.Second)
+		for !ok() {
+			select {
+			case <-updates:
+			case <-deadline:
+				t.Fatalf("config not reloaded: level %v, dry run %v, limit %d", dc.Level(), dc.Enabled("rate_limit_dry_run"), limit())
+			}
+		}
+	}
+
+	writeConfig(t, path, "30", "debug", "true")
+	waitFor(func() bool {
+		return dc.Level() == log.LevelDebug && dc.Enabled("rate_limit_dry_run") && limit() == 30
+	})
+
+	// 同一次修改的其他键可能稍后才触发更新，先排空
+	for drained := false; !drained; {
+		select {
+		case <-updates:
+		case <-time.After(300 * time.Millisecond):
+			drained = true
+		}
+	}
+
+	// 校验不通过的修改被丢弃，保留上一份配置
+	writeConfig(t, path, "0", "debug", "true")
+	select {
+	case bc := <-updates:
+		t.Errorf("invalid config applied: %v", bc)
+	case <-time.After(time.Second):
+	}
+	if limit() != 30 {
+		t.Errorf("limit = %d, want the last valid 30", limit())
+	}
+}
+
+func TestValidate_Subcommands(t *testing.T) {
+	bc := &conf.Bootstrap{
+		Server: &conf.Server{
+			Http:  &conf.Server_HTTP{Addr: ":8000"},
+			Grpc:  &conf.Server_GRPC{Addr: ":9000"},
+			Admin: &conf.Server_Admin{Operations: []string{"/api.community.v7.Community/CreateCommunity"}, Token: "${ADMIN_TOKEN}"},
+		},
+		Data: &conf.Data{
+			Database: &conf.Data_Database{Driver: "mysql", Source: "root@tcp(127.0.0.1:3306)/anjuke"},
+			Redis:    &conf.Data_Redis{Addr: "127.0.0.1:6379"},
+			Search:   &conf.Data_Search{Path: "houses.bleve"},
+		},
+	}
+	// 离线子命令不提供运营接口，不需要令牌
+	for _, cmd := range []string{"migrate", "seed", "rebuild-index"} {
+		if err := validate(bc, []string{cmd}); err != nil {
+			t.Errorf("validate(%s) error = %v", cmd, err)
+		}
+	}
+	if err := validate(bc, nil); err == nil || !strings.Contains(err.Error(), "server.admin.token") {
+		t.Errorf("validate() for serving error = %v, want the admin token required", err)
+	}
+	bc.Data.Database.Source = ""
+	if err := validate(bc, []string{"migrate"}); err == nil {
+		t.Error("validate(migrate) without a database error = nil")
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"anjuke/internal/conf"
+	"anjuke/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const migrateUsage = `usage: anjuke [-conf dir] migrate <command>
+
+commands:
+  up [-n N]          apply pending migrations (all by default)
+  down [-n N]        roll back the last N migrations (1 by default)
+  status             list migrations and whether they are applied
+  create [-dir D] NAME
+                     write an empty up/down pair with the next version`
+
+// runMigrate implements the `migrate` subcommand on top of conf.Data.
+func runMigrate(bc *conf.Bootstrap, logger log.Logger, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	fs := flag.NewFlagSet("migrate "+args[0], flag.ContinueOnError)
+	n := fs.Int("n", 0, "number of migrations")
+	dir := fs.String("dir", "../../internal/data/migrations", "migrations source directory")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if args[0] == "create" {
+		if fs.NArg() != 1 {
+			return fmt.Errorf("usage: migrate create [-dir D] NAME")
+		}
+		up, down, err := data.CreateMigration(*dir, fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created %s\ncreated %s\n", up, down)
+		return nil
+	}
+
+	db, err := data.MysqlInit(bc.Data, logger)
+	if err != nil {
+		return err
+	}
+	m, err := data.NewMigrator(db, logger)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		done, err := m.Up(ctx, *n)
+		for _, mg := range done {
+			fmt.Printf("applied  %04d_%s\n", mg.Version, mg.Name)
+		}
+		if err == nil && len(done) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+	case "down":
+		done, err := m.Down(ctx, *n)
+		for _, mg := range done {
+			fmt.Printf("reverted %04d_%s\n", mg.Version, mg.Name)
+		}
+		return err
+	case "status":
+		sts, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, st := range sts {
+			state, at := "pending", ""
+			if st.Applied {
+				state, at = "applied", st.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if st.Modified {
+				state = "modified"
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", st.Version, st.Name, state, at)
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// seedMarkerMobile is the first generated user; its presence means the
+// database was already seeded.
+const seedMarkerMobile = "13800000000"
+
+// seedYear is the reference year for building ages, fixed so the dataset does
+// not drift over time.
+const seedYear = 2024
+
+// seedDistrict is a district with a typical second-hand unit price (元/㎡).
+type seedDistrict struct {
+	name      string
+	unitPrice int64
+}
+
+var (
+	seedCity      = "上海"
+	seedDistricts = []seedDistrict{
+		{"黄浦", 110000}, {"静安", 100000}, {"徐汇", 90000}, {"长宁", 85000},
+		{"浦东", 65000}, {"普陀", 60000}, {"闵行", 55000}, {"宝山", 45000},
+		{"嘉定", 35000}, {"青浦", 32000}, {"松江", 30000},
+	}
+	seedBrands       = []string{"万科", "保利", "绿地", "中海", "融创", "龙湖", "华润", "金地", "招商", "仁恒"}
+	seedSuffixes     = []string{"花园", "公寓", "城", "苑", "府", "湾", "名邸", "家园", "新村"}
+	seedSurnames     = []string{"王", "李", "张", "刘", "陈", "杨", "黄", "赵", "吴", "周", "徐", "孙"}
+	seedGivenNames   = []string{"伟", "芳", "娜", "敏", "静", "磊", "洋", "婷", "强", "杰", "丽", "军", "涛", "晨"}
+	seedOrientations = []string{"南北", "南", "东南", "西南", "东", "西"}
+	seedStreets      = []string{"中山", "延安", "淮海", "南京", "长寿", "虹桥", "世纪", "共和", "龙华", "漕溪"}
+)
+
+// seedCommunity is a generated community.
+type seedCommunity struct {
+	id        uint
+	name      string
+	district  seedDistrict
+	buildYear int32
+	// premium shifts the community's unit price around the district average.
+	premium float64
+}
+
+// seeder creates a deterministic demo dataset through the biz usecases, so
+// every generated row passes the same validation as real traffic.
+type seeder struct {
+	user        *biz.UserUsecase
+	house       *biz.HouseUsecase
+	transaction *biz.TransactionUsecase
+	points      *biz.PointsUsecase
+	customer    *biz.CustomerUsecase
+	community   *biz.CommunityUsecase
+	region      *biz.RegionUsecase
+	log         *log.Helper
+
+	rnd    *rand.Rand
+	mobile int
+}
+
+func newSeeder(user *biz.UserUsecase, house *biz.HouseUsecase, transaction *biz.TransactionUsecase, points *biz.PointsUsecase, customer *biz.CustomerUsecase, community *biz.CommunityUsecase, region *biz.RegionUsecase, logger log.Logger) *seeder {
+	return &seeder{
+		user:        user,
+		house:       house,
+		transaction: transaction,
+		points:      points,
+		customer:    customer,
+		community:   community,
+		region:      region,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// runSeed implements the `seed` subcommand.
+func runSeed(bc *conf.Bootstrap, logger log.Logger, args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ContinueOnError)
+	seed := fs.Int64("seed", 1, "random seed, the same seed always yields the same dataset")
+	scale := fs.Int("scale", 1, "scale factor, multiplies every entity count")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *scale < 1 {
+		return fmt.Errorf("scale must be >= 1")
+	}
+	s, cleanup, err := wireSeeder(bc.Data, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	return s.run(context.Background(), *seed, *scale)
+}
+
+func (s *seeder) run(ctx context.Context, seed int64, scale int) error {
+	if u, err := s.user.GetUser(ctx, seedMarkerMobile); err != nil {
+		return err
+	} else if u != nil {
+		return fmt.Errorf("数据库中已有种子数据（用户 %s 已存在），请先清空后再执行 seed", seedMarkerMobile)
+	}
+	s.rnd = rand.New(rand.NewSource(seed))
+
+	// 按等级顺序生成，保证手机号与种子一一对应
+	users := map[int32][]*biz.User{}
+	for _, g := range []struct {
+		grade int32
+		n     int
+	}{{biz.GradeAdmin, 1}, {biz.GradeMerchant, 10}, {biz.GradeMember, 40}, {biz.GradeVisitor, 20}} {
+		for i := 0; i < g.n*scale; i++ {
+			u, err := s.user.CreateUser(ctx, &biz.User{
+				Mobile:   s.nextMobile(),
+				NickName: s.personName(),
+				Password: "123456",
+				Birthday: int32((1960+s.rnd.Intn(45))*10000 + (1+s.rnd.Intn(12))*100 + 1 + s.rnd.Intn(28)),
+				Gender:   int32(s.rnd.Intn(2)),
+				Grade:    g.grade,
+			})
+			if err != nil {
+				return err
+			}
+			users[g.grade] = append(users[g.grade], u)
+		}
+	}
+	merchants, members := users[biz.GradeMerchant], users[biz.GradeMember]
+
+	var communities []*seedCommunity
+	names := s.communityNames(len(seedDistricts) * 3 * scale)
+	for _, d := range seedDistricts {
+		for i := 0; i < 3*scale; i++ {
+			communities = append(communities, &seedCommunity{
+				name:      names[len(communities)],
+				district:  d,
+				buildYear: int32(1995 + s.rnd.Intn(28)),
+				premium:   0.85 + s.rnd.Float64()*0.3,
+			})
+		}
+	}
+	for _, c := range communities {
+		if err := s.createCommunity(ctx, c); err != nil {
+			return err
+		}
+	}
+
+	var houses []*biz.House
+	for _, c := range communities {
+		for i := 0; i < 5; i++ {
+			h, err := s.house.CreateHouse(ctx, s.houseFor(c, merchants[s.rnd.Intn(len(merchants))].ID))
+			if err != nil {
+				return err
+			}
+			houses = append(houses, h)
+		}
+	}
+
+	var customers int
+	for _, m := range merchants {
+		for i := 0; i < 5; i++ {
+			d := seedDistricts[s.rnd.Intn(len(seedDistricts))]
+			rooms := int32(1 + s.rnd.Intn(4))
+			budget := d.unitPrice * int64(30+rooms*25)
+			p := biz.Place{City: seedCity, District: d.name}
+			if err := s.region.Locate(ctx, &p); err != nil {
+				return err
+			}
+			if _, err := s.customer.CreateCustomer(ctx, &biz.Customer{
+				AgentID:   m.ID,
+				Name:      seedSurnames[s.rnd.Intn(len(seedSurnames))] + []string{"先生", "女士"}[s.rnd.Intn(2)],
+				Mobile:    s.nextMobile(),
+				Stage:     int32(s.rnd.Intn(int(biz.StageLost) + 1)),
+				District:  d.name,
+				RegionID:  p.RegionID,
+				Rooms:     rooms,
+				BudgetMin: budget * 8 / 10,
+				BudgetMax: budget * 12 / 10,
+			}); err != nil {
+				return err
+			}
+			customers++
+		}
+	}
+
+	// 约五分之一的房源产生交易；成交奖励积分、房源已售和客户阶段由服务启动后的事件订阅方处理
+	var deals int
+	for _, h := range houses {
+		if s.rnd.Intn(5) != 0 {
+			continue
+		}
+		buyer := members[s.rnd.Intn(len(members))]
+		d, err := s.transaction.CreateDeal(ctx, &biz.Deal{
+			HouseID: h.ID,
+			BuyerID: buyer.ID,
+			AgentID: h.OwnerID,
+			Price:   h.Price * int64(95+s.rnd.Intn(5)) / 100 / 10000 * 10000,
+		})
+		if err != nil {
+			return err
+		}
+		deals++
+		if s.rnd.Intn(4) == 0 {
+			continue
+		}
+		if _, err = s.transaction.CompleteDeal(ctx, d.ID); err != nil {
+			return err
+		}
+	}
+
+	// 会员签到和兑换记录
+	for _, m := range members {
+		for i, n := 0, s.rnd.Intn(10); i < n; i++ {
+			if _, err := s.points.Earn(ctx, &biz.PointsRecord{UserID: m.ID, Amount: 10, Reason: "签到"}); err != nil {
+				return err
+			}
+		}
+		if balance, err := s.points.Balance(ctx, m.ID); err != nil {
+			return err
+		} else if balance >= 50 && s.rnd.Intn(2) == 0 {
+			if _, err := s.points.Redeem(ctx, &biz.PointsRecord{UserID: m.ID, Amount: 50, Reason: "兑换"}); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.log.Infof("seed done: users=%d communities=%d houses=%d customers=%d deals=%d",
+		len(users[biz.GradeAdmin])+len(merchants)+len(members)+len(users[biz.GradeVisitor]),
+		len(communities), len(houses), customers, deals)
+	return nil
+}
+
+func (s *seeder) houseFor(c *seedCommunity, ownerID uint) *biz.House {
+	rooms := int32(1 + s.rnd.Intn(4))
+	area := float64(rooms)*28 + 20 + float64(s.rnd.Intn(30))
+	totalFloors := int32(6 + s.rnd.Intn(28))
+	floor := int32(1 + s.rnd.Intn(int(totalFloors)))
+	// 房龄越新单价越高，每年约1%
+	age := float64(seedYear - c.buildYear)
+	unitPrice := float64(c.district.unitPrice) * c.premium * (1.15 - age*0.01) * (0.95 + s.rnd.Float64()*0.1)
+	h := &biz.House{
+		OwnerID:     ownerID,
+		ListingType: biz.ListingSale,
+		CommunityID: c.id,
+		Rooms:       rooms,
+		Halls:       int32(1 + s.rnd.Intn(2)),
+		Baths:       int32(1 + s.rnd.Intn(int(rooms+1)/2+1)),
+		Area:        float64(int(area*100)) / 100,
+		Floor:       floor,
+		TotalFloors: totalFloors,
+		Orientation: seedOrientations[s.rnd.Intn(len(seedOrientations))],
+		BuildYear:   c.buildYear,
+		Price:       int64(unitPrice*area) / 10000 * 10000,
+	}
+	h.Title = fmt.Sprintf("%s %s %.0f㎡ %s", c.name, h.Layout(), h.Area, h.Orientation)
+	h.Description = fmt.Sprintf("%s%s%s，%d年建成，%d/%d层，%s朝向。", seedCity, c.district.name, c.name, c.buildYear, floor, totalFloors, h.Orientation)
+	// 标签由属性推出，不消耗随机数，已有种子生成的数据集不变
+	if h.Orientation == "南北" {
+		h.Tags = append(h.Tags, "南北通透")
+	}
+	if age <= 5 {
+		h.Tags = append(h.Tags, "次新房")
+	}
+	if floor*3 > totalFloors*2 {
+		h.Tags = append(h.Tags, "高楼层")
+	}
+	return h
+}
+
+// createCommunity adds c to the catalog with a few buildings.
+func (s *seeder) createCommunity(ctx context.Context, c *seedCommunity) error {
+	brand := []rune(c.name)[:2]
+	created, err := s.community.CreateCommunity(ctx, &biz.Community{
+		Name:            c.name,
+		City:            seedCity,
+		District:        c.district.name,
+		Address:         fmt.Sprintf("%s区%s路%d号", c.district.name, seedStreets[s.rnd.Intn(len(seedStreets))], 1+s.rnd.Intn(999)),
+		BuildYear:       c.buildYear,
+		PropertyCompany: string(brand) + "物业",
+		PropertyFee:     float64(150+s.rnd.Intn(450)) / 100,
+		GreeningRate:    float64(25 + s.rnd.Intn(20)),
+		PlotRatio:       float64(100+s.rnd.Intn(250)) / 100,
+	})
+	if err != nil {
+		return err
+	}
+	c.id = created.ID
+	for i, n := 0, 2+s.rnd.Intn(5); i < n; i++ {
+		b := &biz.Building{CommunityID: c.id, Name: fmt.Sprintf("%d号楼", i+1), Floors: int32(6 + s.rnd.Intn(28))}
+		for j, units := 0, 1+s.rnd.Intn(3); j < units; j++ {
+			b.Units = append(b.Units, &biz.Unit{Name: fmt.Sprintf("%d单元", j+1), Households: b.Floors * 2})
+		}
+		if _, err := s.community.CreateBuilding(ctx, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *seeder) nextMobile() string {
+	m := fmt.Sprintf("138%08d", s.mobile)
+	s.mobile++
+	return m
+}
+
+func (s *seeder) personName() string {
+	return seedSurnames[s.rnd.Intn(len(seedSurnames))] + seedGivenNames[s.rnd.Intn(len(seedGivenNames))]
+}
+
+// communityNames returns n unique brand+suffix names in a seeded order; once
+// every combination is used the names continue as later phases ("2期"...).
+func (s *seeder) communityNames(n int) []string {
+	var base []string
+	for _, b := range seedBrands {
+		for _, sfx := range seedSuffixes {
+			base = append(base, b+sfx)
+		}
+	}
+	s.rnd.Shuffle(len(base), func(i, j int) { base[i], base[j] = base[j], base[i] })
+	names := make([]string, n)
+	for i := range names {
+		names[i] = base[i%len(base)]
+		if phase := i / len(base); phase > 0 {
+			names[i] += fmt.Sprintf("%d期", phase+1)
+		}
+	}
+	return names
+}
@@ -18,6 +18,16 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, *conf.Dynamic, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
+
+// wireSeeder init the demo data seeder.
+func wireSeeder(*conf.Data, log.Logger) (*seeder, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet, newSeeder))
+}
+
+// wireSearch init the listing search for rebuild-index.
+func wireSearch(*conf.Data, log.Logger) (*biz.SearchUsecase, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet))
+}
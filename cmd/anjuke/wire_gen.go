@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, dynamic *conf.Dynamic, logger log.Logger) (*kratos.App, func(), error) {
 	db,err:=data.MysqlInit(confData,logger)
 	if err != nil {
 		return nil, nil, err
@@ -39,31 +39,164 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
+	//todo:content
+	sensitiveWordRepo := data.NewSensitiveWordRepo(dataData, logger)
+	contentRules := data.NewContentRules(confData)
+	contentUsecase := biz.NewContentUsecase(sensitiveWordRepo, contentRules, logger)
+	contentService := service.NewContentService(contentUsecase)
 	//todo:user
 	userRepo := data.NewUserRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, logger)
-	userService := service.NewUserService(userUsecase)
+	userUsecase := biz.NewUserUsecase(userRepo, contentUsecase, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, logger)
+	userService := service.NewUserService(userUsecase, notificationUsecase)
+	redisEventBus := data.NewRedisEventBus(dataData, rdb, logger)
+	bizTransaction := data.NewTransaction(dataData)
 	//todo:house
 	houseRepo:=data.NewHouseRepo(dataData, logger)
-	houseUsecase := biz.NewHouseUsecase(houseRepo, logger)
-	houseService := service.NewHouseService(houseUsecase)
+	communityRepo := data.NewCommunityRepo(dataData, logger)
+	regionRepo := data.NewRegionRepo(dataData, logger)
+	regionUsecase := biz.NewRegionUsecase(regionRepo, logger)
+	priceRepo := data.NewPriceRepo(dataData, logger)
+	houseUsecase := biz.NewHouseUsecase(houseRepo, communityRepo, regionUsecase, priceRepo, contentUsecase, bizTransaction, redisEventBus, logger)
+	houseSearcher, cleanup2, err := data.NewHouseSearcher(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	keywordRepo := data.NewKeywordRepo(dataData, logger)
+	searchUsecase := biz.NewSearchUsecase(houseRepo, regionUsecase, houseSearcher, keywordRepo, redisEventBus, logger)
+	suggestUsecase := biz.NewSuggestUsecase(communityRepo, regionUsecase, keywordRepo, logger)
+	priceUsecase := biz.NewPriceUsecase(priceRepo, houseRepo, notificationRepo, bizTransaction, redisEventBus, logger)
+	favoriteRepo := data.NewFavoriteRepo(dataData, logger)
+	browseHistoryRepo := data.NewBrowseHistoryRepo(dataData, logger)
+	favoriteUsecase := biz.NewFavoriteUsecase(favoriteRepo, browseHistoryRepo, houseRepo, priceUsecase, bizTransaction, logger)
+	compareBasketRepo := data.NewCompareBasketRepo(dataData, logger)
+	compareUsecase := biz.NewCompareUsecase(houseRepo, communityRepo, regionUsecase, compareBasketRepo, logger)
+	valuationRepo := data.NewValuationRepo(dataData, logger)
+	valuationRules := data.NewValuationRules(confData)
+	valuationUsecase := biz.NewValuationUsecase(valuationRepo, houseRepo, communityRepo, valuationRules, redisEventBus, logger)
+	moderationRepo := data.NewModerationRepo(dataData, logger)
+	imageHasher := data.NewImageHasher(confData)
+	moderationRules := data.NewModerationRules(confData)
+	moderationUsecase := biz.NewModerationUsecase(moderationRepo, houseRepo, imageHasher, contentUsecase, moderationRules, redisEventBus, logger)
+	houseService := service.NewHouseService(houseUsecase, searchUsecase, suggestUsecase, priceUsecase, favoriteUsecase, compareUsecase, valuationUsecase, moderationUsecase)
 	//todo:transaction
 	transaction:=data.NewTransactionRepo(dataData, logger)
-	transactionUsecase := biz.NewTransactionUsecase(transaction, logger)
-	transactionService := service.NewTransactionService(transactionUsecase)
+	transactionUsecase := biz.NewTransactionUsecase(transaction, bizTransaction, logger)
+	mortgageRates := data.NewMortgageRates(confData)
+	mortgageUsecase := biz.NewMortgageUsecase(mortgageRates, logger)
+	leaseRepo := data.NewLeaseRepo(dataData, logger)
+	leaseUsecase := biz.NewLeaseUsecase(leaseRepo, houseRepo, bizTransaction, logger)
+	billRepo := data.NewBillRepo(dataData, logger)
+	billingRules := data.NewBillingRules(confData)
+	redisLocker := data.NewRedisLocker(dataData, logger)
+	billingUsecase := biz.NewBillingUsecase(billRepo, leaseRepo, billingRules, bizTransaction, redisLocker, redisEventBus, logger)
+	transactionService := service.NewTransactionService(transactionUsecase, mortgageUsecase, leaseUsecase, billingUsecase)
 	//todo:points
 	points:=data.NewPointsRepo(dataData, logger)
-	pointsUsecase := biz.NewPointsUsecase(points, logger)
+	pointsUsecase := biz.NewPointsUsecase(points, bizTransaction, redisLocker, redisEventBus, logger)
 	pointsService := service.NewPointsService(pointsUsecase)
 	//todo:Customer
 	customer:=data.NewCustomerRepo(dataData, logger)
-	customerUsecase := biz.NewCustomerUsecase(customer, logger)
+	customerUsecase := biz.NewCustomerUsecase(customer, bizTransaction, regionUsecase, contentUsecase, redisEventBus, logger)
 	customerService := service.NewCustomerService(customerUsecase)
+	//todo:community
+	communityUsecase := biz.NewCommunityUsecase(communityRepo, regionUsecase, logger)
+	communityService := service.NewCommunityService(communityUsecase)
+	//todo:region
+	regionService := service.NewRegionService(regionUsecase)
+	//todo:favorite
+	favoriteService := service.NewFavoriteService(favoriteUsecase)
+	//todo:stats
+	statsRepo := data.NewStatsRepo(dataData, logger)
+	statsUsecase := biz.NewStatsUsecase(statsRepo, houseRepo, valuationRepo, communityRepo, bizTransaction, redisLocker, logger)
+	statsService := service.NewStatsService(statsUsecase)
 
-	grpcServer := server.NewGRPCServer(confServer, greeterService, userService,houseService,transactionService,pointsService,customerService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, userService,houseService,transactionService,pointsService,customerService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	rateLimiter := server.NewRateLimiter(dynamic, rdb, logger)
+	admin := server.NewAdmin(confServer, logger)
+	idempotency := server.NewIdempotency(confServer, dynamic, rdb, logger)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, userService,houseService,transactionService,pointsService,customerService,communityService,regionService,favoriteService,statsService,contentService, rateLimiter, admin, idempotency, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, userService,houseService,transactionService,pointsService,customerService,communityService,regionService,favoriteService,statsService,contentService, rateLimiter, admin, idempotency, logger)
+	outboxRelay := data.NewOutboxRelay(dataData, redisEventBus, logger)
+	browseFlusher := data.NewBrowseFlusher(dataData, logger)
+	statsScheduler := server.NewStatsScheduler(statsUsecase, logger)
+	billingScheduler := server.NewBillingScheduler(billingUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, outboxRelay, redisEventBus, browseFlusher, statsScheduler, billingScheduler)
 	return app, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+// wireSeeder init the demo data seeder.
+func wireSeeder(confData *conf.Data, logger log.Logger) (*seeder, func(), error) {
+	db, err := data.MysqlInit(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	rdb, err := data.ExampleClient(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataData, cleanup, err := data.NewData(confData, logger, db, rdb)
+	if err != nil {
+		return nil, nil, err
+	}
+	userRepo := data.NewUserRepo(dataData, logger)
+	sensitiveWordRepo := data.NewSensitiveWordRepo(dataData, logger)
+	contentRules := data.NewContentRules(confData)
+	contentUsecase := biz.NewContentUsecase(sensitiveWordRepo, contentRules, logger)
+	userUsecase := biz.NewUserUsecase(userRepo, contentUsecase, logger)
+	houseRepo := data.NewHouseRepo(dataData, logger)
+	redisEventBus := data.NewRedisEventBus(dataData, rdb, logger)
+	communityRepo := data.NewCommunityRepo(dataData, logger)
+	regionRepo := data.NewRegionRepo(dataData, logger)
+	regionUsecase := biz.NewRegionUsecase(regionRepo, logger)
+	priceRepo := data.NewPriceRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	houseUsecase := biz.NewHouseUsecase(houseRepo, communityRepo, regionUsecase, priceRepo, contentUsecase, transaction, redisEventBus, logger)
+	transactionRepo := data.NewTransactionRepo(dataData, logger)
+	transactionUsecase := biz.NewTransactionUsecase(transactionRepo, transaction, logger)
+	pointsRepo := data.NewPointsRepo(dataData, logger)
+	redisLocker := data.NewRedisLocker(dataData, logger)
+	pointsUsecase := biz.NewPointsUsecase(pointsRepo, transaction, redisLocker, redisEventBus, logger)
+	customerRepo := data.NewCustomerRepo(dataData, logger)
+	customerUsecase := biz.NewCustomerUsecase(customerRepo, transaction, regionUsecase, contentUsecase, redisEventBus, logger)
+	communityUsecase := biz.NewCommunityUsecase(communityRepo, regionUsecase, logger)
+	mainSeeder := newSeeder(userUsecase, houseUsecase, transactionUsecase, pointsUsecase, customerUsecase, communityUsecase, regionUsecase, logger)
+	return mainSeeder, func() {
+		cleanup()
+	}, nil
+}
+
+// wireSearch init the listing search for rebuild-index.
+func wireSearch(confData *conf.Data, logger log.Logger) (*biz.SearchUsecase, func(), error) {
+	db, err := data.MysqlInit(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	rdb, err := data.ExampleClient(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataData, cleanup, err := data.NewData(confData, logger, db, rdb)
+	if err != nil {
+		return nil, nil, err
+	}
+	houseRepo := data.NewHouseRepo(dataData, logger)
+	regionRepo := data.NewRegionRepo(dataData, logger)
+	regionUsecase := biz.NewRegionUsecase(regionRepo, logger)
+	houseSearcher, cleanup2, err := data.NewHouseSearcher(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	keywordRepo := data.NewKeywordRepo(dataData, logger)
+	redisEventBus := data.NewRedisEventBus(dataData, rdb, logger)
+	searchUsecase := biz.NewSearchUsecase(houseRepo, regionUsecase, houseSearcher, keywordRepo, redisEventBus, logger)
+	return searchUsecase, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,112 @@
+package biz
+
+import "unicode"
+
+// acMatcher is an Aho-Corasick automaton over runes: it finds every word of
+// a list in one pass over the text, however long the list.
+type acMatcher struct {
+	nodes []acNode
+}
+
+type acNode struct {
+	next map[rune]int
+	fail int
+	// out holds the indexes of the words ending here, including those
+	// reached through the fail links.
+	out []int
+}
+
+// acMatch is a word found in a text, in rune offsets of the normalized text.
+type acMatch struct {
+	word       int
+	start, end int
+}
+
+// newACMatcher builds the automaton of words, given in normalized form.
+func newACMatcher(words [][]rune) *acMatcher {
+	m := &acMatcher{nodes: []acNode{{next: map[rune]int{}}}}
+	for i, w := range words {
+		if len(w) == 0 {
+			continue
+		}
+		n := 0
+		for _, r := range w {
+			child, ok := m.nodes[n].next[r]
+			if !ok {
+				child = len(m.nodes)
+				m.nodes = append(m.nodes, acNode{next: map[rune]int{}})
+				m.nodes[n].next[r] = child
+			}
+			n = child
+		}
+		m.nodes[n].out = append(m.nodes[n].out, i)
+	}
+	// 按层次遍历，父节点的失败指针先于子节点算出
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[n].next {
+			f := m.nodes[n].fail
+			for f != 0 && m.nodes[f].next[r] == 0 {
+				f = m.nodes[f].fail
+			}
+			if next, ok := m.nodes[f].next[r]; ok && next != child {
+				m.nodes[child].fail = next
+			}
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[m.nodes[child].fail].out...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// find returns every occurrence of the words in text, overlapping ones
+// included, with lengths taken from words.
+func (m *acMatcher) find(text []rune, words [][]rune) []acMatch {
+	var res []acMatch
+	n := 0
+	for i, r := range text {
+		for n != 0 && m.nodes[n].next[r] == 0 {
+			n = m.nodes[n].fail
+		}
+		n = m.nodes[n].next[r]
+		for _, w := range m.nodes[n].out {
+			res = append(res, acMatch{word: w, start: i + 1 - len(words[w]), end: i + 1})
+		}
+	}
+	return res
+}
+
+// normalizeRune folds a rune for matching: full-width forms to ASCII and
+// letters to lower case. Spaces, punctuation and symbols, which are put
+// between the characters of a word to dodge filters, are dropped.
+func normalizeRune(r rune) (rune, bool) {
+	switch {
+	case r == '　':
+		return 0, false
+	case r >= '！' && r <= '～':
+		r -= 0xfee0
+	}
+	if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+		return 0, false
+	}
+	return unicode.ToLower(r), true
+}
+
+// normalizeText returns the normalized runes of text and, for each, its
+// offset in the runes of text.
+func normalizeText(text []rune) ([]rune, []int) {
+	norm := make([]rune, 0, len(text))
+	pos := make([]int, 0, len(text))
+	for i, r := range text {
+		if n, ok := normalizeRune(r); ok {
+			norm = append(norm, n)
+			pos = append(pos, i)
+		}
+	}
+	return norm, pos
+}
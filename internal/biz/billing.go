@@ -0,0 +1,436 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrBillNotFound is bill not found.
+	ErrBillNotFound = errors.NotFound("BILL_NOT_FOUND", "账单不存在")
+	// ErrBillInvalid is a bill query without a tenant, landlord or lease.
+	ErrBillInvalid = errors.BadRequest("BILL_INVALID", "请指定租客、房东或租约")
+	// ErrBillForbidden is a receipt marked by someone other than the landlord.
+	ErrBillForbidden = errors.Forbidden("BILL_FORBIDDEN", "只有房东可以确认收款")
+	// ErrBillState is a receipt for a bill paid or canceled.
+	ErrBillState = errors.Conflict("BILL_STATE", "账单已支付或已取消")
+	// ErrBillingRunning is a billing run while another instance is running.
+	ErrBillingRunning = errors.Conflict("BILLING_RUNNING", "账单任务正在进行，请稍后再试")
+)
+
+// 账单类型
+const (
+	BillRent    = "rent"    // 租金
+	BillUtility = "utility" // 随租金收取的固定费用
+)
+
+// 账单状态
+const (
+	BillUnpaid   int32 = iota // 待支付
+	BillPaid                  // 已支付
+	BillOverdue               // 已逾期
+	BillCanceled              // 已取消
+)
+
+const (
+	billingScanBatch = 200
+	billingLockName  = "rent_billing"
+)
+
+// RentBill is the rent, or the fixed charges, of one period of a lease's
+// schedule.
+type RentBill struct {
+	gorm.Model
+	LeaseID     uint           // 租约
+	HouseID     uint           // 房源
+	TenantID    uint           // 租客
+	LandlordID  uint           // 房东
+	Kind        string         // rent 或 utility
+	Seq         int32          // 付款计划的第几期
+	PeriodStart time.Time      // 计费开始
+	PeriodEnd   time.Time      // 计费结束，不含当天
+	DueDate     time.Time      // 应付日
+	Amount      int64          // 应付（元）
+	Items       []*LeaseCharge `gorm:"serializer:json"` // 费用明细，utility 账单才有
+	LateFee     int64          // 滞纳金（元）
+	Status      int32          // 0待支付 1已支付 2已逾期 3已取消
+	PaidAmount  int64          // 实收（元）
+	PaidAt      *time.Time     // 收款时间
+	Note        string         // 收款备注
+}
+
+func (b *RentBill) key() string {
+	return b.Kind + "|" + strconv.Itoa(int(b.Seq))
+}
+
+// open reports whether the bill is still to be paid.
+func (b *RentBill) open() bool {
+	return b.Status == BillUnpaid || b.Status == BillOverdue
+}
+
+// BillFilter selects bills; zero fields match all.
+type BillFilter struct {
+	TenantID   uint
+	LandlordID uint
+	LeaseID    uint
+	Status     *int32
+}
+
+// BillingRun is what a billing run did.
+type BillingRun struct {
+	Issued  int // 新出的账单
+	Overdue int // 逾期的账单，含之前已逾期的
+	Expired int // 到期的租约
+}
+
+// BillingRules are the billing and late fee rules.
+type BillingRules struct {
+	AdvanceDays  int     // 应付日前多少天出账
+	GraceDays    int     // 应付日后的宽限天数，过后即逾期
+	DailyLateFee float64 // 宽限期后每天按应付金额的该百分比加收滞纳金
+	MaxLateFee   float64 // 滞纳金上限，占应付金额的百分比
+}
+
+// DefaultBillingRules returns the built-in rules: bills a week ahead, three
+// days of grace, then 0.05% a day up to 20%.
+func DefaultBillingRules() *BillingRules {
+	return &BillingRules{AdvanceDays: 7, GraceDays: 3, DailyLateFee: 0.05, MaxLateFee: 20}
+}
+
+// BillRepo is a rent bill repo.
+type BillRepo interface {
+	// CreateBills stores bills, skipping those already stored for the same
+	// lease, kind and period.
+	CreateBills(ctx context.Context, bills []*RentBill) error
+	// LockBill reads a bill and locks it until the transaction in ctx ends.
+	LockBill(ctx context.Context, id uint) (*RentBill, error)
+	UpdateBill(ctx context.Context, b *RentBill) (*RentBill, error)
+	// UpdateOpenBill saves the amount, period, status and late fee of b
+	// unless the stored bill was paid or canceled meanwhile, and reports
+	// whether it did.
+	UpdateOpenBill(ctx context.Context, b *RentBill) (bool, error)
+	// ListLeaseBills returns the bills of a lease, by ID.
+	ListLeaseBills(ctx context.Context, leaseID uint) ([]*RentBill, error)
+	// ListBills returns the bills matching f, latest due first.
+	ListBills(ctx context.Context, f *BillFilter) ([]*RentBill, error)
+	// ListOpenBills returns up to limit unpaid or overdue bills due before
+	// dueBefore with an ID above afterID, by ID.
+	ListOpenBills(ctx context.Context, dueBefore time.Time, afterID uint, limit int) ([]*RentBill, error)
+}
+
+// BillingUsecase bills leases by their rent schedule and tracks payments.
+type BillingUsecase struct {
+	repo   BillRepo
+	leases LeaseRepo
+	rules  *BillingRules
+	tx     Transaction
+	locker Locker
+	log    *log.Helper
+}
+
+// NewBillingUsecase new a Billing usecase.
+func NewBillingUsecase(repo BillRepo, leases LeaseRepo, rules *BillingRules, tx Transaction, locker Locker, bus EventBus, logger log.Logger) *BillingUsecase {
+	uc := &BillingUsecase{repo: repo, leases: leases, rules: rules, tx: tx, locker: locker, log: log.NewHelper(logger)}
+	Subscribe(bus, "billing", uc.onLeaseSigned)
+	Subscribe(bus, "billing", uc.onLeaseEnded)
+	return uc
+}
+
+// onLeaseSigned issues the bills already due, so a lease starting today is
+// billed before the next run.
+func (uc *BillingUsecase) onLeaseSigned(ctx context.Context, e *LeaseSignedEvent) error {
+	return uc.reissue(ctx, e.LeaseID)
+}
+
+// onLeaseEnded cancels the unpaid bills past an early termination and cuts
+// the last one short.
+func (uc *BillingUsecase) onLeaseEnded(ctx context.Context, e *LeaseEndedEvent) error {
+	return uc.reissue(ctx, e.LeaseID)
+}
+
+func (uc *BillingUsecase) reissue(ctx context.Context, leaseID uint) error {
+	l, err := uc.leases.GetLease(ctx, leaseID)
+	if err != nil {
+		return err
+	}
+	if l == nil {
+		uc.log.WithContext(ctx).Warnf("billing: lease %d not found", leaseID)
+		return nil
+	}
+	_, err = uc.issue(ctx, l, time.Now())
+	return err
+}
+
+// issue brings the bills of a lease in line with its schedule: the bills
+// due within AdvanceDays of now are created, open ones follow a changed
+// period and those past an early termination are canceled. Bills paid
+// meanwhile are left as paid. It returns the number of bills created.
+func (uc *BillingUsecase) issue(ctx context.Context, l *Lease, now time.Time) (int, error) {
+	horizon := dayOf(now).AddDate(0, 0, uc.rules.AdvanceDays)
+	var created int
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		existing, err := uc.repo.ListLeaseBills(ctx, l.ID)
+		if err != nil {
+			return err
+		}
+		stored := map[string]*RentBill{}
+		for _, b := range existing {
+			stored[b.key()] = b
+		}
+		scheduled := map[string]bool{}
+		var bills []*RentBill
+		for _, p := range l.Schedule() {
+			for _, b := range leaseBills(l, p) {
+				scheduled[b.key()] = true
+				old := stored[b.key()]
+				if old == nil {
+					if !p.DueDate.After(horizon) {
+						bills = append(bills, b)
+					}
+					continue
+				}
+				if old.open() && (old.Amount != b.Amount || !old.PeriodEnd.Equal(b.PeriodEnd)) {
+					old.Amount, old.PeriodEnd, old.Items = b.Amount, b.PeriodEnd, b.Items
+					if _, err := uc.repo.UpdateOpenBill(ctx, old); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		for _, b := range existing {
+			if !scheduled[b.key()] && b.open() {
+				b.Status = BillCanceled
+				if _, err := uc.repo.UpdateOpenBill(ctx, b); err != nil {
+					return err
+				}
+			}
+		}
+		if len(bills) == 0 {
+			return nil
+		}
+		created = len(bills)
+		return uc.repo.CreateBills(ctx, bills)
+	})
+	return created, err
+}
+
+// leaseBills returns the bills of a period: the rent, and the fixed charges
+// when the lease has any.
+func leaseBills(l *Lease, p *RentPeriod) []*RentBill {
+	bill := func(kind string, amount int64) *RentBill {
+		return &RentBill{
+			LeaseID: l.ID, HouseID: l.HouseID, TenantID: l.TenantID, LandlordID: l.LandlordID,
+			Kind: kind, Seq: p.Seq, PeriodStart: p.Start, PeriodEnd: p.End, DueDate: p.DueDate, Amount: amount,
+		}
+	}
+	res := []*RentBill{bill(BillRent, p.Amount)}
+	if charges := l.PeriodCharges(p); len(charges) > 0 {
+		b := bill(BillUtility, 0)
+		for _, c := range charges {
+			b.Amount += c.Monthly
+		}
+		b.Items = charges
+		res = append(res, b)
+	}
+	return res
+}
+
+// assess brings the status and late fee of an open bill up to today and
+// reports whether they changed. A bill is overdue after its grace days;
+// the late fee grows daily from then on, up to its cap.
+func (uc *BillingUsecase) assess(b *RentBill, now time.Time) bool {
+	if !b.open() {
+		return false
+	}
+	status, fee := BillUnpaid, int64(0)
+	late := days(b.DueDate.AddDate(0, 0, uc.rules.GraceDays), dayOf(now))
+	if late > 0 {
+		status = BillOverdue
+		fee = int64(math.Round(float64(b.Amount) * uc.rules.DailyLateFee / 100 * late))
+		if limit := int64(math.Round(float64(b.Amount) * uc.rules.MaxLateFee / 100)); fee > limit {
+			fee = limit
+		}
+	}
+	if status == b.Status && fee == b.LateFee {
+		return false
+	}
+	b.Status, b.LateFee = status, fee
+	return true
+}
+
+// dayOf returns the local midnight of t.
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// Run is the daily billing run: it issues the bills coming due, expires
+// the leases past their end, putting the houses of those not renewed back
+// on the market, and marks the open bills overdue with their late fees; a
+// bill paid after it was read stays paid, and a lease renewed or terminated
+// after it was read is billed as it is now. Only one instance runs at a
+// time; the others get ErrBillingRunning.
+func (uc *BillingUsecase) Run(ctx context.Context, now time.Time) (*BillingRun, error) {
+	res := &BillingRun{}
+	ok, err := TryWithLock(ctx, uc.locker, billingLockName, func(ctx context.Context, _ Lock) error {
+		for afterID := uint(0); ; {
+			list, err := uc.leases.ListActiveLeases(ctx, afterID, billingScanBatch)
+			if err != nil {
+				return err
+			}
+			for _, l := range list {
+				n, expired, err := uc.runLease(ctx, l.ID, now)
+				if err != nil {
+					return err
+				}
+				res.Issued += n
+				if expired {
+					res.Expired++
+				}
+			}
+			if len(list) < billingScanBatch {
+				break
+			}
+			afterID = list[len(list)-1].ID
+		}
+
+		dueBefore := dayOf(now).AddDate(0, 0, -uc.rules.GraceDays)
+		for afterID := uint(0); ; {
+			list, err := uc.repo.ListOpenBills(ctx, dueBefore, afterID, billingScanBatch)
+			if err != nil {
+				return err
+			}
+			for _, b := range list {
+				if uc.assess(b, now) {
+					// 读出后房东确认了收款的账单不覆盖
+					saved, err := uc.repo.UpdateOpenBill(ctx, b)
+					if err != nil {
+						return err
+					}
+					if !saved {
+						continue
+					}
+				}
+				if b.Status == BillOverdue {
+					res.Overdue++
+				}
+			}
+			if len(list) < billingScanBatch {
+				break
+			}
+			afterID = list[len(list)-1].ID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrBillingRunning
+	}
+	uc.log.WithContext(ctx).Infof("billing run: %d issued, %d overdue, %d leases expired", res.Issued, res.Overdue, res.Expired)
+	return res, nil
+}
+
+// runLease issues the bills of a lease and expires it when past its end.
+// The lease is re-read under its lock, so a renewal or termination since it
+// was listed is neither overwritten nor billed as before.
+func (uc *BillingUsecase) runLease(ctx context.Context, id uint, now time.Time) (issued int, expired bool, err error) {
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		l, err := uc.leases.LockLease(ctx, id)
+		if err != nil {
+			return err
+		}
+		if l == nil || l.Status != LeaseActive || l.TerminatedAt != nil {
+			return nil
+		}
+		if issued, err = uc.issue(ctx, l, now); err != nil {
+			return err
+		}
+		if now.Before(l.EndDate) {
+			return nil
+		}
+		expired = true
+		return uc.expire(ctx, l)
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return issued, expired, nil
+}
+
+// expire saves a lease past its end as expired. Unless it was renewed, the
+// house goes back on the market.
+func (uc *BillingUsecase) expire(ctx context.Context, l *Lease) error {
+	l.Status = LeaseExpired
+	var events []*Event
+	if l.RenewalID == 0 {
+		e, err := NewEvent(&LeaseEndedEvent{LeaseID: l.ID, HouseID: l.HouseID, EndedAt: l.EndDate})
+		if err != nil {
+			return err
+		}
+		events = append(events, e)
+	}
+	_, err := uc.leases.UpdateLease(ctx, l, events...)
+	return err
+}
+
+// ListBills returns the bills of a tenant, a landlord or a lease, latest
+// due first, with their status and late fees as of now.
+func (uc *BillingUsecase) ListBills(ctx context.Context, f *BillFilter) ([]*RentBill, error) {
+	if f.TenantID == 0 && f.LandlordID == 0 && f.LeaseID == 0 {
+		return nil, ErrBillInvalid
+	}
+	list, err := uc.repo.ListBills(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, b := range list {
+		uc.assess(b, now)
+	}
+	return list, nil
+}
+
+// MarkBillPaid records the landlord's receipt of a bill with its late fee
+// as of now, unless waived. The bill is locked until the receipt is saved.
+func (uc *BillingUsecase) MarkBillPaid(ctx context.Context, id, landlordID uint, waiveLateFee bool, note string) (*RentBill, error) {
+	uc.log.WithContext(ctx).Infof("MarkBillPaid: bill=%d landlord=%d", id, landlordID)
+	var res *RentBill
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		b, err := uc.repo.LockBill(ctx, id)
+		if err != nil {
+			return err
+		}
+		if b == nil {
+			return ErrBillNotFound
+		}
+		if b.LandlordID != landlordID {
+			return ErrBillForbidden
+		}
+		if !b.open() {
+			return ErrBillState
+		}
+		now := time.Now()
+		uc.assess(b, now)
+		if waiveLateFee {
+			b.LateFee = 0
+		}
+		b.Status = BillPaid
+		b.PaidAmount = b.Amount + b.LateFee
+		b.PaidAt = &now
+		b.Note = strings.TrimSpace(note)
+		res, err = uc.repo.UpdateBill(ctx, b)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -1,6 +1,86 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/wire"
+)
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewGreeterUsecase, NewUserUsecase, NewHouseUsecase, NewTransactionUsecase, NewPointsUsecase, NewCustomerUsecase)
+var ProviderSet = wire.NewSet(NewGreeterUsecase, NewUserUsecase, NewHouseUsecase, NewTransactionUsecase, NewPointsUsecase, NewCustomerUsecase, NewCommunityUsecase, NewRegionUsecase, NewSearchUsecase, NewSuggestUsecase, NewPriceUsecase, NewNotificationUsecase, NewFavoriteUsecase, NewCompareUsecase, NewMortgageUsecase, NewValuationUsecase, NewStatsUsecase, NewModerationUsecase, NewContentUsecase, NewLeaseUsecase, NewBillingUsecase)
+
+var (
+	// ErrLockTimeout is a lock not acquired before the context was done.
+	ErrLockTimeout = errors.Conflict("LOCK_TIMEOUT", "资源繁忙，请稍后重试")
+	// ErrLockStale is a write by a lock holder that has been superseded.
+	ErrLockStale = errors.Conflict("LOCK_STALE", "锁已失效")
+)
+
+// Transaction is a unit of work spanning several repos.
+type Transaction interface {
+	// InTx runs fn in a database transaction carried by the ctx passed to fn;
+	// every repo called with that ctx joins it. fn returning an error or
+	// panicking rolls the transaction back. A nested InTx runs in a savepoint,
+	// so its failure undoes only its own changes.
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Locker hands out distributed locks for work that must not run concurrently
+// across instances, such as claiming a lead from the pool.
+type Locker interface {
+	// Lock waits until the lock on name is acquired or ctx is done. The lease
+	// is renewed in the background until the lock is released.
+	Lock(ctx context.Context, name string) (Lock, error)
+	// TryLock acquires the lock on name only if it is free.
+	TryLock(ctx context.Context, name string) (Lock, bool, error)
+}
+
+// Lock is a held distributed lock.
+type Lock interface {
+	// Token is the fencing token, larger than that of every earlier holder.
+	Token() int64
+	// Done is closed when the lease is lost, e.g. because renewal failed;
+	// work still running must stop and not write.
+	Done() <-chan struct{}
+	// Fence rejects the write with ErrLockStale if a later holder has already
+	// written. Call it inside Transaction.InTx before the guarded write so the
+	// check commits with it.
+	Fence(ctx context.Context) error
+	// Release unlocks if the lock is still held by this holder.
+	Release(ctx context.Context) error
+}
+
+// WithLock runs fn holding the lock on name. ctx passed to fn is cancelled if
+// the lease is lost.
+func WithLock(ctx context.Context, locker Locker, name string, fn func(ctx context.Context, l Lock) error) error {
+	l, err := locker.Lock(ctx, name)
+	if err != nil {
+		return err
+	}
+	return runLocked(ctx, l, fn)
+}
+
+// TryWithLock is WithLock without waiting: it reports false and does not run
+// fn when another holder has the lock.
+func TryWithLock(ctx context.Context, locker Locker, name string, fn func(ctx context.Context, l Lock) error) (bool, error) {
+	l, ok, err := locker.TryLock(ctx, name)
+	if err != nil || !ok {
+		return false, err
+	}
+	return true, runLocked(ctx, l, fn)
+}
+
+func runLocked(ctx context.Context, l Lock, fn func(ctx context.Context, l Lock) error) error {
+	defer l.Release(context.WithoutCancel(ctx))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-l.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return fn(ctx, l)
+}
@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrCommunityNotFound is community not found.
+	ErrCommunityNotFound = errors.NotFound("COMMUNITY_NOT_FOUND", "小区不存在")
+	// ErrCommunityInvalid is a community with missing fields.
+	ErrCommunityInvalid = errors.BadRequest("COMMUNITY_INVALID", "小区名称和城市不能为空")
+	// ErrBuildingNotFound is building not found.
+	ErrBuildingNotFound = errors.NotFound("BUILDING_NOT_FOUND", "楼栋不存在")
+	// ErrBuildingInvalid is a building with missing fields.
+	ErrBuildingInvalid = errors.BadRequest("BUILDING_INVALID", "楼栋名称不能为空")
+)
+
+const (
+	// defaultCommunitySearchLimit is the number of matches returned by default.
+	defaultCommunitySearchLimit = 10
+	maxCommunitySearchLimit     = 50
+)
+
+// Community is a residential community (小区).
+type Community struct {
+	gorm.Model
+	Name            string      // 名称
+	Aliases         []string    `gorm:"serializer:json"` // 别名，如俗称、曾用名
+	City            string      // 城市
+	District        string      // 区县
+	BusinessArea    string      // 商圈
+	RegionID        uint        // 区域（区县或商圈）
+	Address         string      // 地址
+	Longitude       float64     // 经度
+	Latitude        float64     // 纬度
+	BuildYear       int32       // 建成年份
+	PropertyCompany string      // 物业公司
+	PropertyFee     float64     // 物业费（元/㎡·月）
+	GreeningRate    float64     // 绿化率（%）
+	PlotRatio       float64     // 容积率
+	Buildings       []*Building // 楼栋，仅 GetCommunity 返回
+}
+
+// Building is a building of a community.
+type Building struct {
+	gorm.Model
+	CommunityID uint    // 小区
+	Name        string  // 名称，如"3号楼"
+	Floors      int32   // 地上层数
+	Units       []*Unit // 单元
+}
+
+// Unit is a unit (单元) of a building.
+type Unit struct {
+	gorm.Model
+	BuildingID uint   // 楼栋
+	Name       string // 名称，如"1单元"
+	Households int32  // 户数
+}
+
+// CommunityRepo is a community repo.
+type CommunityRepo interface {
+	CreateCommunity(context.Context, *Community) (*Community, error)
+	// GetCommunity returns the community without its buildings.
+	GetCommunity(ctx context.Context, id uint) (*Community, error)
+	UpdateCommunity(context.Context, *Community) (*Community, error)
+	// DeleteCommunity deletes the community with its buildings and units.
+	DeleteCommunity(ctx context.Context, id uint) error
+	// SearchCommunities returns communities whose name or an alias contains
+	// keyword, exact and prefix name matches first.
+	SearchCommunities(ctx context.Context, city, keyword string, limit int) ([]*Community, error)
+	// CreateBuilding creates the building with its units.
+	CreateBuilding(context.Context, *Building) (*Building, error)
+	GetBuilding(ctx context.Context, id uint) (*Building, error)
+	// DeleteBuilding deletes the building with its units.
+	DeleteBuilding(ctx context.Context, id uint) error
+	// ListBuildings returns a community's buildings with their units.
+	ListBuildings(ctx context.Context, communityID uint) ([]*Building, error)
+	// ListCommunities returns up to limit communities with an ID above
+	// afterID, by ID, without their buildings.
+	ListCommunities(ctx context.Context, afterID uint, limit int) ([]*Community, error)
+}
+
+// CommunityUsecase is a community usecase.
+type CommunityUsecase struct {
+	repo    CommunityRepo
+	regions *RegionUsecase
+	log     *log.Helper
+}
+
+// NewCommunityUsecase new a Community usecase.
+func NewCommunityUsecase(repo CommunityRepo, regions *RegionUsecase, logger log.Logger) *CommunityUsecase {
+	return &CommunityUsecase{repo: repo, regions: regions, log: log.NewHelper(logger)}
+}
+
+// CreateCommunity adds a community to the catalog.
+func (uc *CommunityUsecase) CreateCommunity(ctx context.Context, c *Community) (*Community, error) {
+	uc.log.WithContext(ctx).Infof("CreateCommunity: %v", c.Name)
+	if err := uc.normalize(ctx, c); err != nil {
+		return nil, err
+	}
+	return uc.repo.CreateCommunity(ctx, c)
+}
+
+// UpdateCommunity replaces the attributes of an existing community.
+func (uc *CommunityUsecase) UpdateCommunity(ctx context.Context, c *Community) (*Community, error) {
+	uc.log.WithContext(ctx).Infof("UpdateCommunity: %d", c.ID)
+	old, err := uc.GetCommunity(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.normalize(ctx, c); err != nil {
+		return nil, err
+	}
+	c.CreatedAt = old.CreatedAt
+	c.Buildings = nil
+	return uc.repo.UpdateCommunity(ctx, c)
+}
+
+// DeleteCommunity removes a community with its buildings. Listings keep the
+// community name they were published with.
+func (uc *CommunityUsecase) DeleteCommunity(ctx context.Context, id uint) error {
+	uc.log.WithContext(ctx).Infof("DeleteCommunity: %d", id)
+	if _, err := uc.GetCommunity(ctx, id); err != nil {
+		return err
+	}
+	return uc.repo.DeleteCommunity(ctx, id)
+}
+
+// GetCommunity returns a community by id.
+func (uc *CommunityUsecase) GetCommunity(ctx context.Context, id uint) (*Community, error) {
+	c, err := uc.repo.GetCommunity(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, ErrCommunityNotFound
+	}
+	return c, nil
+}
+
+// GetCommunityDetail returns a community with its buildings and units.
+func (uc *CommunityUsecase) GetCommunityDetail(ctx context.Context, id uint) (*Community, error) {
+	c, err := uc.GetCommunity(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.Buildings, err = uc.repo.ListBuildings(ctx, id); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SearchCommunities looks communities up by name or alias.
+func (uc *CommunityUsecase) SearchCommunities(ctx context.Context, city, keyword string, limit int) ([]*Community, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return nil, nil
+	}
+	if limit <= 0 {
+		limit = defaultCommunitySearchLimit
+	}
+	if limit > maxCommunitySearchLimit {
+		limit = maxCommunitySearchLimit
+	}
+	return uc.repo.SearchCommunities(ctx, city, keyword, limit)
+}
+
+// CreateBuilding adds a building with its units to a community.
+func (uc *CommunityUsecase) CreateBuilding(ctx context.Context, b *Building) (*Building, error) {
+	uc.log.WithContext(ctx).Infof("CreateBuilding: community=%d %v", b.CommunityID, b.Name)
+	b.Name = strings.TrimSpace(b.Name)
+	if b.Name == "" {
+		return nil, ErrBuildingInvalid
+	}
+	for _, u := range b.Units {
+		if u.Name = strings.TrimSpace(u.Name); u.Name == "" {
+			return nil, ErrBuildingInvalid
+		}
+	}
+	if _, err := uc.GetCommunity(ctx, b.CommunityID); err != nil {
+		return nil, err
+	}
+	return uc.repo.CreateBuilding(ctx, b)
+}
+
+// DeleteBuilding removes a building with its units.
+func (uc *CommunityUsecase) DeleteBuilding(ctx context.Context, id uint) error {
+	uc.log.WithContext(ctx).Infof("DeleteBuilding: %d", id)
+	b, err := uc.repo.GetBuilding(ctx, id)
+	if err != nil {
+		return err
+	}
+	if b == nil {
+		return ErrBuildingNotFound
+	}
+	return uc.repo.DeleteBuilding(ctx, id)
+}
+
+// normalize trims names, drops empty or duplicate aliases and locates the
+// community in the region tree.
+func (uc *CommunityUsecase) normalize(ctx context.Context, c *Community) error {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" || (c.City == "" && c.RegionID == 0) {
+		return ErrCommunityInvalid
+	}
+	p := Place{RegionID: c.RegionID, City: c.City, District: c.District, BusinessArea: c.BusinessArea}
+	if err := uc.regions.Locate(ctx, &p); err != nil {
+		return err
+	}
+	c.RegionID, c.City, c.District, c.BusinessArea = p.RegionID, p.City, p.District, p.BusinessArea
+	aliases := make([]string, 0, len(c.Aliases))
+	seen := map[string]bool{c.Name: true}
+	for _, a := range c.Aliases {
+		if a = strings.TrimSpace(a); a != "" && !seen[a] {
+			seen[a] = true
+			aliases = append(aliases, a)
+		}
+	}
+	c.Aliases = aliases
+	return nil
+}
@@ -0,0 +1,284 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrCompareInvalid is a comparison of too few or too many listings.
+	ErrCompareInvalid = errors.BadRequest("COMPARE_INVALID", "请选择2到4套房源对比")
+	// ErrCompareBasketFull is an addition to a full comparison basket.
+	ErrCompareBasketFull = errors.Conflict("COMPARE_BASKET_FULL", "对比栏最多4套房源")
+)
+
+const (
+	minCompare = 2
+	maxCompare = 4
+	// subwayRadius is the farthest station, in metres, counted as nearby.
+	subwayRadius = 3000
+)
+
+// CompareRow is one attribute of the compared listings, one value per
+// listing in column order. Best holds the columns with the best value;
+// it is empty when the attribute has no better side or all values tie.
+type CompareRow struct {
+	Key    string   // 属性，如 unit_price
+	Label  string   // 展示名称，如"单价"
+	Values []string // 展示值，缺失时为空
+	Best   []int    // 最优的列
+}
+
+// Comparison is a side-by-side table of listings.
+type Comparison struct {
+	Houses []*House
+	Rows   []*CompareRow
+}
+
+// CompareBasketRepo keeps the listings each user shortlisted for comparison.
+type CompareBasketRepo interface {
+	AddCompare(ctx context.Context, userID, houseID uint) error
+	RemoveCompare(ctx context.Context, userID, houseID uint) error
+	// ListCompare returns the listings in the basket, oldest first.
+	ListCompare(ctx context.Context, userID uint) ([]uint, error)
+}
+
+// CompareUsecase compares listings and manages comparison baskets.
+type CompareUsecase struct {
+	houses      HouseRepo
+	communities CommunityRepo
+	regions     *RegionUsecase
+	basket      CompareBasketRepo
+	log         *log.Helper
+}
+
+// NewCompareUsecase new a Compare usecase.
+func NewCompareUsecase(houses HouseRepo, communities CommunityRepo, regions *RegionUsecase, basket CompareBasketRepo, logger log.Logger) *CompareUsecase {
+	return &CompareUsecase{houses: houses, communities: communities, regions: regions, basket: basket, log: log.NewHelper(logger)}
+}
+
+// AddToBasket adds a listing to a user's basket and returns the basket.
+// Adding a listing already there changes nothing.
+func (uc *CompareUsecase) AddToBasket(ctx context.Context, userID, houseID uint) ([]uint, error) {
+	if userID == 0 {
+		return nil, ErrCompareInvalid
+	}
+	h, err := uc.houses.GetHouse(ctx, houseID)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, ErrHouseNotFound
+	}
+	ids, err := uc.basket.ListCompare(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if id == houseID {
+			return ids, nil
+		}
+	}
+	if len(ids) >= maxCompare {
+		return nil, ErrCompareBasketFull
+	}
+	if err := uc.basket.AddCompare(ctx, userID, houseID); err != nil {
+		return nil, err
+	}
+	return append(ids, houseID), nil
+}
+
+// RemoveFromBasket removes a listing from a user's basket and returns the
+// basket.
+func (uc *CompareUsecase) RemoveFromBasket(ctx context.Context, userID, houseID uint) ([]uint, error) {
+	if err := uc.basket.RemoveCompare(ctx, userID, houseID); err != nil {
+		return nil, err
+	}
+	return uc.basket.ListCompare(ctx, userID)
+}
+
+// Basket returns the listings in a user's basket, oldest first.
+func (uc *CompareUsecase) Basket(ctx context.Context, userID uint) ([]uint, error) {
+	return uc.basket.ListCompare(ctx, userID)
+}
+
+// CompareHouses compares 2 to 4 listings, or the user's basket when no
+// listing is given.
+func (uc *CompareUsecase) CompareHouses(ctx context.Context, userID uint, houseIDs []uint) (*Comparison, error) {
+	if len(houseIDs) == 0 && userID != 0 {
+		ids, err := uc.basket.ListCompare(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		houseIDs = ids
+	}
+	houseIDs = dedupIDs(houseIDs)
+	if len(houseIDs) < minCompare || len(houseIDs) > maxCompare {
+		return nil, ErrCompareInvalid
+	}
+	list, err := uc.houses.GetHouses(ctx, houseIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[uint]*House{}
+	for _, h := range list {
+		byID[h.ID] = h
+	}
+	cmp := &Comparison{}
+	for _, id := range houseIDs {
+		h := byID[id]
+		if h == nil {
+			return nil, ErrHouseNotFound
+		}
+		cmp.Houses = append(cmp.Houses, h)
+	}
+
+	communities := make([]*Community, len(cmp.Houses))
+	for i, h := range cmp.Houses {
+		if h.CommunityID == 0 {
+			continue
+		}
+		if communities[i], err = uc.communities.GetCommunity(ctx, h.CommunityID); err != nil {
+			return nil, err
+		}
+	}
+	stations, err := uc.regions.Stations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(cmp.Houses)
+	price, unit, area, age, fee, subway := newCompareCol(n), newCompareCol(n), newCompareCol(n), newCompareCol(n), newCompareCol(n), newCompareCol(n)
+	layout, floor, orientation := make([]string, n), make([]string, n), make([]string, n)
+	year := time.Now().Year()
+	for i, h := range cmp.Houses {
+		price.set(i, float64(h.Price), fmt.Sprintf("%d万", h.Price/10000))
+		unit.set(i, float64(h.UnitPrice), fmt.Sprintf("%d元/㎡", h.UnitPrice))
+		area.set(i, h.Area, strconv.FormatFloat(h.Area, 'f', -1, 64)+"㎡")
+		layout[i] = h.Layout()
+		if h.TotalFloors > 0 {
+			floor[i] = fmt.Sprintf("%d/%d层", h.Floor, h.TotalFloors)
+		}
+		orientation[i] = h.Orientation
+		if h.BuildYear > 0 {
+			a := year - int(h.BuildYear)
+			age.set(i, float64(a), fmt.Sprintf("%d年", a))
+		}
+		c := communities[i]
+		if c == nil {
+			continue
+		}
+		if c.PropertyFee > 0 {
+			fee.set(i, c.PropertyFee, strconv.FormatFloat(c.PropertyFee, 'f', -1, 64)+"元/㎡·月")
+		}
+		if st, d := nearestStation(stations, c.Longitude, c.Latitude); st != nil && d <= subwayRadius {
+			subway.set(i, d, fmt.Sprintf("%s %d米", st.Name, int(d)))
+		}
+	}
+	cmp.Rows = []*CompareRow{
+		price.row("price", "总价", lowerBetter),
+		unit.row("unit_price", "单价", lowerBetter),
+		area.row("area", "面积", higherBetter),
+		{Key: "layout", Label: "户型", Values: layout},
+		{Key: "floor", Label: "楼层", Values: floor},
+		{Key: "orientation", Label: "朝向", Values: orientation},
+		age.row("age", "房龄", lowerBetter),
+		fee.row("property_fee", "物业费", lowerBetter),
+		subway.row("subway", "距地铁", lowerBetter),
+	}
+	return cmp, nil
+}
+
+const (
+	lowerBetter  = -1
+	higherBetter = 1
+)
+
+// compareCol collects a numeric attribute; missing values are skipped when
+// picking the best.
+type compareCol struct {
+	nums   []float64
+	ok     []bool
+	values []string
+}
+
+func newCompareCol(n int) *compareCol {
+	return &compareCol{nums: make([]float64, n), ok: make([]bool, n), values: make([]string, n)}
+}
+
+func (c *compareCol) set(i int, num float64, value string) {
+	c.nums[i], c.ok[i], c.values[i] = num, true, value
+}
+
+// row marks the best values; at least two values are needed and they must
+// not all tie.
+func (c *compareCol) row(key, label string, better int) *CompareRow {
+	r := &CompareRow{Key: key, Label: label, Values: c.values}
+	var best float64
+	known, found := 0, false
+	for i, ok := range c.ok {
+		if !ok {
+			continue
+		}
+		known++
+		if !found || (c.nums[i]-best)*float64(better) > 0 {
+			best, found = c.nums[i], true
+		}
+	}
+	if known < 2 {
+		return r
+	}
+	for i, ok := range c.ok {
+		if ok && c.nums[i] == best {
+			r.Best = append(r.Best, i)
+		}
+	}
+	if len(r.Best) == known {
+		r.Best = nil
+	}
+	return r
+}
+
+// nearestStation returns the station closest to a point and its distance in
+// metres; a point without coordinates has none.
+func nearestStation(stations []*Station, lng, lat float64) (*Station, float64) {
+	if lng == 0 && lat == 0 {
+		return nil, 0
+	}
+	var nearest *Station
+	min := math.Inf(1)
+	for _, st := range stations {
+		if d := distance(lng, lat, st.Longitude, st.Latitude); d < min {
+			nearest, min = st, d
+		}
+	}
+	return nearest, min
+}
+
+// distance returns the great-circle distance in metres between two points.
+func distance(lng1, lat1, lng2, lat2 float64) float64 {
+	const earthRadius = 6371000
+	rad := math.Pi / 180
+	dLat, dLng := (lat2-lat1)*rad, (lng2-lng1)*rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+// dedupIDs drops zero and repeated IDs, keeping the order.
+func dedupIDs(ids []uint) []uint {
+	res := make([]uint, 0, len(ids))
+	seen := map[uint]bool{}
+	for _, id := range ids {
+		if id != 0 && !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+	return res
+}
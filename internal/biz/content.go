@@ -0,0 +1,370 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrContentBlocked is a text with a banned word or contact information
+	// where it is not allowed; the metadata names the field and the hits.
+	ErrContentBlocked = errors.BadRequest("CONTENT_BLOCKED", "内容包含违禁词或联系方式，请修改后再提交")
+	// ErrContentInvalid is a check in an unknown scene.
+	ErrContentInvalid = errors.BadRequest("CONTENT_INVALID", "场景只能是 listing、customer 或 profile")
+	// ErrWordInvalid is an empty word or an unknown action.
+	ErrWordInvalid = errors.BadRequest("WORD_INVALID", "敏感词不能为空，处理方式只能是 block、mask 或 review")
+	// ErrWordNotFound is word not found.
+	ErrWordNotFound = errors.NotFound("WORD_NOT_FOUND", "敏感词不存在")
+)
+
+// 处理方式，按严重程度从低到高
+const (
+	ActionPass   = "pass"   // 放行，仅用于联系方式
+	ActionMask   = "mask"   // 替换为*
+	ActionReview = "review" // 原样保存，转人工审核
+	ActionBlock  = "block"  // 拒绝提交
+)
+
+// 命中类型
+const (
+	HitWord   = "word"   // 敏感词
+	HitPhone  = "phone"  // 手机号
+	HitWechat = "wechat" // 微信号
+)
+
+// 内容场景
+const (
+	SceneListing  = "listing"  // 房源标题和描述，review 进入房源审核队列
+	SceneCustomer = "customer" // 客户称呼和备注
+	SceneProfile  = "profile"  // 用户昵称
+)
+
+// contentReloadInterval is how often each instance reloads the word list,
+// so words changed on one instance reach the others.
+const contentReloadInterval = time.Minute
+
+var actionRank = map[string]int{ActionPass: 0, ActionMask: 1, ActionReview: 2, ActionBlock: 3}
+
+// SensitiveWord is a banned word and what to do with a text containing it.
+type SensitiveWord struct {
+	gorm.Model
+	Word     string
+	Action   string // block、mask 或 review
+	Category string // 分类，如"广告""涉政""辱骂"
+}
+
+// ContentHit is a banned word or a piece of contact information found in a
+// text. Start and End are rune offsets in the original text.
+type ContentHit struct {
+	Kind       string // word、phone 或 wechat
+	Text       string // 原文中命中的部分
+	Category   string
+	Action     string
+	Start, End int
+}
+
+// ContentResult is a filtered text: Text has the masked hits replaced, and
+// Action is the most severe action of the hits, empty when there are none.
+type ContentResult struct {
+	Text   string
+	Action string
+	Hits   []*ContentHit
+}
+
+// ContentField is a field of a request to filter in place.
+type ContentField struct {
+	Name string
+	Text *string
+}
+
+// ContentRules say what to do with contact information in each scene.
+type ContentRules struct {
+	ContactActions map[string]string
+}
+
+// DefaultContentRules returns the built-in rules: listings mask contact
+// information, profiles reject it, and customer records, which are meant
+// to hold it, keep it.
+func DefaultContentRules() *ContentRules {
+	return &ContentRules{ContactActions: map[string]string{
+		SceneListing:  ActionMask,
+		SceneCustomer: ActionPass,
+		SceneProfile:  ActionBlock,
+	}}
+}
+
+// SensitiveWordRepo stores the word list.
+type SensitiveWordRepo interface {
+	ListWords(ctx context.Context) ([]*SensitiveWord, error)
+	// SaveWords creates the words, replacing the action and category of
+	// those already listed.
+	SaveWords(context.Context, []*SensitiveWord) error
+	// DeleteWord reports whether the word was listed.
+	DeleteWord(ctx context.Context, word string) (bool, error)
+}
+
+type wordList struct {
+	words    []*SensitiveWord
+	runes    [][]rune
+	matcher  *acMatcher
+	loadedAt time.Time
+}
+
+// ContentUsecase filters user-written text for banned words and contact
+// information. The word list is cached in memory and reloaded every
+// contentReloadInterval.
+type ContentUsecase struct {
+	repo  SensitiveWordRepo
+	rules *ContentRules
+	log   *log.Helper
+
+	mu   sync.Mutex
+	list *wordList
+}
+
+// NewContentUsecase new a Content usecase.
+func NewContentUsecase(repo SensitiveWordRepo, rules *ContentRules, logger log.Logger) *ContentUsecase {
+	return &ContentUsecase{repo: repo, rules: rules, log: log.NewHelper(logger)}
+}
+
+// Filter finds the banned words and contact information in a text. A
+// review hit in a scene nobody reviews is masked instead.
+func (uc *ContentUsecase) Filter(ctx context.Context, scene, text string) (*ContentResult, error) {
+	contact, ok := uc.rules.ContactActions[scene]
+	if !ok {
+		return nil, ErrContentInvalid
+	}
+	l, err := uc.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	runes := []rune(text)
+	var hits []*ContentHit
+	norm, pos := normalizeText(runes)
+	for _, m := range l.matcher.find(norm, l.runes) {
+		w := l.words[m.word]
+		start, end := pos[m.start], pos[m.end-1]+1
+		hits = append(hits, &ContentHit{Kind: HitWord, Text: string(runes[start:end]), Category: w.Category, Action: w.Action, Start: start, End: end})
+	}
+	if contact != ActionPass {
+		for _, h := range findContacts(runes) {
+			h.Action = contact
+			hits = append(hits, h)
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Start < hits[j].Start })
+
+	res := &ContentResult{Hits: hits}
+	for _, h := range hits {
+		if h.Action == ActionReview && scene != SceneListing {
+			h.Action = ActionMask
+		}
+		if actionRank[h.Action] > actionRank[res.Action] {
+			res.Action = h.Action
+		}
+		if h.Action == ActionMask {
+			for i := h.Start; i < h.End; i++ {
+				runes[i] = '*'
+			}
+		}
+	}
+	res.Text = string(runes)
+	return res, nil
+}
+
+// Clean filters fields in place, masking what is to be masked. A field
+// with a block hit fails the whole request with ErrContentBlocked; review
+// hits are kept and returned for the caller to queue.
+func (uc *ContentUsecase) Clean(ctx context.Context, scene string, fields ...ContentField) ([]*ContentHit, error) {
+	var review []*ContentHit
+	for _, f := range fields {
+		if *f.Text == "" {
+			continue
+		}
+		res, err := uc.Filter(ctx, scene, *f.Text)
+		if err != nil {
+			return nil, err
+		}
+		if res.Action == ActionBlock {
+			var texts []string
+			for _, h := range res.Hits {
+				if h.Action == ActionBlock {
+					texts = append(texts, h.Text)
+				}
+			}
+			return nil, ErrContentBlocked.WithMetadata(map[string]string{"field": f.Name, "hits": strings.Join(texts, ",")})
+		}
+		*f.Text = res.Text
+		for _, h := range res.Hits {
+			if h.Action == ActionReview {
+				review = append(review, h)
+			}
+		}
+	}
+	return review, nil
+}
+
+// ListWords returns the word list.
+func (uc *ContentUsecase) ListWords(ctx context.Context) ([]*SensitiveWord, error) {
+	return uc.repo.ListWords(ctx)
+}
+
+// SaveWords adds words, or changes the action and category of listed ones,
+// and reloads the list of this instance.
+func (uc *ContentUsecase) SaveWords(ctx context.Context, words []*SensitiveWord) (int, error) {
+	uc.log.WithContext(ctx).Infof("SaveWords: %d words", len(words))
+	for _, w := range words {
+		w.Word = strings.TrimSpace(w.Word)
+		if len(wordRunes(w.Word)) == 0 {
+			return 0, ErrWordInvalid
+		}
+		if w.Action == "" {
+			w.Action = ActionBlock
+		}
+		if w.Action != ActionBlock && w.Action != ActionMask && w.Action != ActionReview {
+			return 0, ErrWordInvalid
+		}
+	}
+	if len(words) == 0 {
+		return 0, ErrWordInvalid
+	}
+	if err := uc.repo.SaveWords(ctx, words); err != nil {
+		return 0, err
+	}
+	return uc.Reload(ctx)
+}
+
+// DeleteWord removes a word and reloads the list of this instance.
+func (uc *ContentUsecase) DeleteWord(ctx context.Context, word string) error {
+	uc.log.WithContext(ctx).Infof("DeleteWord: %v", word)
+	ok, err := uc.repo.DeleteWord(ctx, strings.TrimSpace(word))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrWordNotFound
+	}
+	_, err = uc.Reload(ctx)
+	return err
+}
+
+// Reload rebuilds the matcher from the repo and returns the number of
+// words; other instances pick changes up within contentReloadInterval.
+func (uc *ContentUsecase) Reload(ctx context.Context) (int, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	l, err := uc.build(ctx)
+	if err != nil {
+		return 0, err
+	}
+	uc.list = l
+	return len(l.words), nil
+}
+
+// load returns the cached list, building it on first use and after
+// contentReloadInterval. A failed reload keeps serving the old list.
+func (uc *ContentUsecase) load(ctx context.Context) (*wordList, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if uc.list != nil && time.Since(uc.list.loadedAt) < contentReloadInterval {
+		return uc.list, nil
+	}
+	l, err := uc.build(ctx)
+	if err != nil {
+		if uc.list != nil {
+			uc.log.WithContext(ctx).Errorf("reload sensitive words: %v", err)
+			return uc.list, nil
+		}
+		return nil, err
+	}
+	uc.list = l
+	return l, nil
+}
+
+func (uc *ContentUsecase) build(ctx context.Context) (*wordList, error) {
+	words, err := uc.repo.ListWords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l := &wordList{words: words, loadedAt: time.Now()}
+	for _, w := range words {
+		l.runes = append(l.runes, wordRunes(w.Word))
+	}
+	l.matcher = newACMatcher(l.runes)
+	return l, nil
+}
+
+// wordRunes normalizes a word the way texts are normalized.
+func wordRunes(word string) []rune {
+	norm, _ := normalizeText([]rune(word))
+	return norm
+}
+
+// contactSeparators may sit between the digits of a phone number.
+const contactSeparators = " -_.·,，。、*/|~+　—()（）[]【】"
+
+// maxContactSeparators is the most separators allowed in a row between two
+// digits of a phone number.
+const maxContactSeparators = 3
+
+// contactDigits maps the ways a digit gets written to dodge filters.
+var contactDigits = func() map[rune]byte {
+	m := map[rune]byte{}
+	for i, set := range []string{"0０零〇○洞", "1１一壹幺①", "2２二贰②", "3３三叁③", "4４四肆④", "5５五伍⑤", "6６六陆⑥", "7７七柒拐⑦", "8８八捌⑧", "9９九玖勾⑨"} {
+		for _, r := range set {
+			m[r] = byte('0' + i)
+		}
+	}
+	return m
+}()
+
+// wechatPattern is a WeChat ID announced as such, e.g. "加V：abc_123".
+var wechatPattern = regexp.MustCompile(`(?i)(?:微信|薇信|威信|徽信|v信|vx|wx|weixin|wechat|加v|加微)号?\s*[:：]?\s*([a-z][-_a-z0-9]{5,19})`)
+
+// findContacts finds mobile numbers, however their digits are written and
+// spaced, and announced WeChat IDs.
+func findContacts(runes []rune) []*ContentHit {
+	var hits []*ContentHit
+	var digits []byte
+	var at []int
+	seps := 0
+	flush := func() {
+		for i := 0; i+11 <= len(digits); i++ {
+			if digits[i] == '1' && digits[i+1] >= '3' && digits[i+1] <= '9' {
+				start, end := at[i], at[i+10]+1
+				hits = append(hits, &ContentHit{Kind: HitPhone, Text: string(runes[start:end]), Start: start, End: end})
+				i += 10
+			}
+		}
+		digits, at, seps = digits[:0], at[:0], 0
+	}
+	for i, r := range runes {
+		if d, ok := contactDigits[r]; ok {
+			digits, at, seps = append(digits, d), append(at, i), 0
+			continue
+		}
+		if len(digits) > 0 && seps < maxContactSeparators && strings.ContainsRune(contactSeparators, r) {
+			seps++
+			continue
+		}
+		flush()
+	}
+	flush()
+
+	text := string(runes)
+	for _, m := range wechatPattern.FindAllStringSubmatchIndex(text, -1) {
+		start := utf8.RuneCountInString(text[:m[2]])
+		end := start + utf8.RuneCountInString(text[m[2]:m[3]])
+		hits = append(hits, &ContentHit{Kind: HitWechat, Text: text[m[2]:m[3]], Start: start, End: end})
+	}
+	return hits
+}
@@ -1,14 +1,125 @@
 package biz
 
-import "github.com/go-kratos/kratos/v2/log"
+import (
+	"context"
 
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrCustomerNotFound is customer not found.
+	ErrCustomerNotFound = errors.NotFound("CUSTOMER_NOT_FOUND", "客户不存在")
+	// ErrCustomerInvalid is a customer with missing or inconsistent fields.
+	ErrCustomerInvalid = errors.BadRequest("CUSTOMER_INVALID", "客户信息不完整")
+)
+
+// 客户跟进阶段
+const (
+	StageNew       int32 = iota // 新线索
+	StageViewing                // 带看中
+	StageIntention              // 有意向
+	StageDeal                   // 已成交
+	StageLost                   // 已流失
+)
+
+// Customer is a customer (lead) followed up by an agent.
+type Customer struct {
+	gorm.Model
+	AgentID   uint   // 跟进经纪人，0表示在公共池
+	UserID    uint   // 关联的注册用户，可为0
+	Name      string // 称呼
+	Mobile    string // 手机号
+	Stage     int32  // 跟进阶段
+	District  string // 意向区域
+	RegionID  uint   // 意向区域ID
+	Rooms     int32  // 意向居室
+	BudgetMin int64  // 预算下限（元）
+	BudgetMax int64  // 预算上限（元）
+	Remark    string // 备注
+}
+
+// CustomerRepo is a customer repo.
 type CustomerRepo interface {
+	CreateCustomer(context.Context, *Customer) (*Customer, error)
+	GetCustomer(ctx context.Context, id uint) (*Customer, error)
+	UpdateCustomer(context.Context, *Customer) (*Customer, error)
+	// ListCustomersByUser returns the agent's customers linked to a user.
+	ListCustomersByUser(ctx context.Context, agentID, userID uint) ([]*Customer, error)
 }
+
+// CustomerUsecase is a customer usecase.
 type CustomerUsecase struct {
-	repo CustomerRepo
-	log  *log.Helper
+	repo    CustomerRepo
+	tx      Transaction
+	regions *RegionUsecase
+	content *ContentUsecase
+	log     *log.Helper
+}
+
+// NewCustomerUsecase new a Customer usecase.
+func NewCustomerUsecase(repo CustomerRepo, tx Transaction, regions *RegionUsecase, content *ContentUsecase, bus EventBus, logger log.Logger) *CustomerUsecase {
+	uc := &CustomerUsecase{repo: repo, tx: tx, regions: regions, content: content, log: log.NewHelper(logger)}
+	Subscribe(bus, "customer", uc.onDealCompleted)
+	return uc
+}
+
+// CreateCustomer records a new lead. The name and remark go through the
+// content filter, which leaves contact information alone here.
+func (uc *CustomerUsecase) CreateCustomer(ctx context.Context, c *Customer) (*Customer, error) {
+	uc.log.WithContext(ctx).Infof("CreateCustomer: %v", c.Name)
+	if c.Mobile == "" {
+		return nil, ErrCustomerInvalid.WithMetadata(map[string]string{"field": "mobile"})
+	}
+	if _, err := uc.content.Clean(ctx, SceneCustomer, ContentField{"name", &c.Name}, ContentField{"remark", &c.Remark}); err != nil {
+		return nil, err
+	}
+	if c.BudgetMax > 0 && c.BudgetMin > c.BudgetMax {
+		return nil, ErrCustomerInvalid.WithMetadata(map[string]string{"field": "budget"})
+	}
+	if c.RegionID != 0 {
+		p := Place{RegionID: c.RegionID}
+		if err := uc.regions.Locate(ctx, &p); err != nil {
+			return nil, err
+		}
+		c.District = p.District
+	}
+	return uc.repo.CreateCustomer(ctx, c)
+}
+
+// UpdateStage moves a customer to another follow-up stage.
+func (uc *CustomerUsecase) UpdateStage(ctx context.Context, id uint, stage int32) (*Customer, error) {
+	if stage < StageNew || stage > StageLost {
+		return nil, ErrCustomerInvalid.WithMetadata(map[string]string{"field": "stage"})
+	}
+	c, err := uc.repo.GetCustomer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, ErrCustomerNotFound
+	}
+	c.Stage = stage
+	return uc.repo.UpdateCustomer(ctx, c)
 }
 
-func NewCustomerUsecase(repo CustomerRepo, logger log.Logger) *CustomerUsecase {
-	return &CustomerUsecase{repo: repo, log: log.NewHelper(logger)}
+// onDealCompleted moves the agent's leads for the buyer to StageDeal.
+func (uc *CustomerUsecase) onDealCompleted(ctx context.Context, e *DealCompletedEvent) error {
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		list, err := uc.repo.ListCustomersByUser(ctx, e.AgentID, e.BuyerID)
+		if err != nil {
+			return err
+		}
+		for _, c := range list {
+			if c.Stage == StageDeal {
+				continue
+			}
+			c.Stage = StageDeal
+			if _, err := uc.repo.UpdateCustomer(ctx, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
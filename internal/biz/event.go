@@ -0,0 +1,82 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event is a domain event. Repos store it in the outbox in the same database
+// transaction as the change that raised it, and the outbox relay publishes
+// it at least once, so subscribers may see an event more than once.
+type Event struct {
+	ID         string    // 全局唯一，订阅方据此去重
+	Topic      string    // 事件类型，如 deal.completed
+	Key        string    // 聚合ID，便于排查
+	Payload    []byte    // JSON 编码的 DomainEvent
+	OccurredAt time.Time // 发生时间
+}
+
+// DomainEvent is a typed event payload.
+type DomainEvent interface {
+	Topic() string
+	Key() string
+}
+
+// NewEvent wraps a typed payload so a repo can store it in the outbox.
+func NewEvent(e DomainEvent) (*Event, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	return &Event{
+		ID:         hex.EncodeToString(id),
+		Topic:      e.Topic(),
+		Key:        e.Key(),
+		Payload:    payload,
+		OccurredAt: time.Now(),
+	}, nil
+}
+
+// EventHandler handles one delivered event. Returning an error leaves the
+// event to be delivered again later.
+type EventHandler func(context.Context, *Event) error
+
+// EventPublisher hands events to the broker. Only the outbox relay calls it;
+// usecases record events through their repos instead.
+type EventPublisher interface {
+	Publish(context.Context, *Event) error
+}
+
+// EventBus is the broker as seen by subscribers. Every consumer receives each
+// event of the topic; events a consumer has already handled are skipped.
+type EventBus interface {
+	EventPublisher
+	Subscribe(topic, consumer string, h EventHandler)
+	// Position returns where the topic's history currently ends.
+	Position(ctx context.Context, topic string) (string, error)
+	// Seek moves consumer to pos, so it only receives the events published
+	// after Position returned pos. A missing consumer is created there.
+	Seek(ctx context.Context, topic, consumer, pos string) error
+}
+
+// Subscribe registers fn for the events of type T under consumer.
+func Subscribe[T any, PT interface {
+	*T
+	DomainEvent
+}](bus EventBus, consumer string, fn func(context.Context, PT) error) {
+	bus.Subscribe(PT(new(T)).Topic(), consumer, func(ctx context.Context, e *Event) error {
+		v := PT(new(T))
+		if err := json.Unmarshal(e.Payload, v); err != nil {
+			return fmt.Errorf("解析事件 %s(%s) 失败: %v", e.Topic, e.ID, err)
+		}
+		return fn(ctx, v)
+	})
+}
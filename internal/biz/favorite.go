@@ -0,0 +1,224 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// ErrFavoriteInvalid is a favorite with a missing user or an overlong folder
+// or note.
+var ErrFavoriteInvalid = errors.BadRequest("FAVORITE_INVALID", "用户不能为空，收藏夹不超过20字，备注不超过200字")
+
+const (
+	// DefaultFolder holds favorites saved without a folder.
+	DefaultFolder = "默认"
+	// MaxBrowseHistory is how many viewed listings are kept per user.
+	MaxBrowseHistory = 100
+
+	maxFolderLength          = 20
+	maxNoteLength            = 200
+	defaultBrowseHistoryPage = 20
+)
+
+// Favorite is a listing saved by a user.
+type Favorite struct {
+	gorm.Model
+	UserID  uint   // 用户
+	HouseID uint   // 房源
+	Folder  string // 收藏夹
+	Note    string // 备注
+	House   *House `gorm:"-"` // 房源，列表时填充
+}
+
+// FavoriteFolder is a folder of a user with its number of favorites.
+type FavoriteFolder struct {
+	Name  string
+	Count int64
+}
+
+// BrowseRecord is a listing viewed by a user.
+type BrowseRecord struct {
+	HouseID  uint
+	ViewedAt time.Time
+	House    *House // 房源，列表时填充
+}
+
+// FavoriteRepo is a favorite repo.
+type FavoriteRepo interface {
+	// SaveFavorite creates or replaces the favorite of a user on a listing.
+	SaveFavorite(context.Context, *Favorite) (*Favorite, error)
+	DeleteFavorite(ctx context.Context, userID, houseID uint) error
+	// ListFavorites returns the favorites of a user, newest first; an empty
+	// folder lists every folder.
+	ListFavorites(ctx context.Context, userID uint, folder string) ([]*Favorite, error)
+	ListFolders(ctx context.Context, userID uint) ([]*FavoriteFolder, error)
+	// CountFavorites returns how many users saved each listing, in one query;
+	// listings nobody saved are left out.
+	CountFavorites(ctx context.Context, houseIDs []uint) (map[uint]int64, error)
+}
+
+// BrowseHistoryRepo keeps the last MaxBrowseHistory listings each user
+// viewed, a listing viewed again moving to the front.
+type BrowseHistoryRepo interface {
+	RecordView(ctx context.Context, userID, houseID uint, at time.Time) error
+	// ListViews returns up to limit records of a user, newest first.
+	ListViews(ctx context.Context, userID uint, limit int) ([]*BrowseRecord, error)
+}
+
+// FavoriteUsecase manages favorites and browsing history.
+type FavoriteUsecase struct {
+	repo    FavoriteRepo
+	history BrowseHistoryRepo
+	houses  HouseRepo
+	prices  *PriceUsecase
+	tx      Transaction
+	log     *log.Helper
+}
+
+// NewFavoriteUsecase new a Favorite usecase.
+func NewFavoriteUsecase(repo FavoriteRepo, history BrowseHistoryRepo, houses HouseRepo, prices *PriceUsecase, tx Transaction, logger log.Logger) *FavoriteUsecase {
+	return &FavoriteUsecase{repo: repo, history: history, houses: houses, prices: prices, tx: tx, log: log.NewHelper(logger)}
+}
+
+// AddFavorite saves a listing, or updates the folder and note when it is
+// already saved. A non-nil dropPercent also sets its price alert, 0
+// cancelling it; nil leaves the alert as it is.
+func (uc *FavoriteUsecase) AddFavorite(ctx context.Context, f *Favorite, dropPercent *int32) (*Favorite, error) {
+	f.Folder = strings.TrimSpace(f.Folder)
+	if f.Folder == "" {
+		f.Folder = DefaultFolder
+	}
+	if f.UserID == 0 || utf8.RuneCountInString(f.Folder) > maxFolderLength || utf8.RuneCountInString(f.Note) > maxNoteLength {
+		return nil, ErrFavoriteInvalid
+	}
+	h, err := uc.houses.GetHouse(ctx, f.HouseID)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, ErrHouseNotFound
+	}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if dropPercent != nil {
+			if _, err := uc.prices.SetPriceAlert(ctx, f.UserID, f.HouseID, *dropPercent); err != nil {
+				return err
+			}
+		}
+		f, err = uc.repo.SaveFavorite(ctx, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	f.House = h
+	return f, nil
+}
+
+// RemoveFavorite removes a saved listing and its price alert.
+func (uc *FavoriteUsecase) RemoveFavorite(ctx context.Context, userID, houseID uint) error {
+	if userID == 0 {
+		return ErrFavoriteInvalid
+	}
+	return uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.prices.SetPriceAlert(ctx, userID, houseID, 0); err != nil {
+			return err
+		}
+		return uc.repo.DeleteFavorite(ctx, userID, houseID)
+	})
+}
+
+// ListFavorites returns the favorites of a user with their listings, and
+// all of the user's folders.
+func (uc *FavoriteUsecase) ListFavorites(ctx context.Context, userID uint, folder string) ([]*Favorite, []*FavoriteFolder, error) {
+	list, err := uc.repo.ListFavorites(ctx, userID, strings.TrimSpace(folder))
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := make([]uint, 0, len(list))
+	for _, f := range list {
+		ids = append(ids, f.HouseID)
+	}
+	houses, err := uc.loadHouses(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, f := range list {
+		f.House = houses[f.HouseID]
+	}
+	folders, err := uc.repo.ListFolders(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return list, folders, nil
+}
+
+// FavoriteCounts returns how many users saved each listing.
+func (uc *FavoriteUsecase) FavoriteCounts(ctx context.Context, houseIDs ...uint) (map[uint]int64, error) {
+	if len(houseIDs) == 0 {
+		return map[uint]int64{}, nil
+	}
+	return uc.repo.CountFavorites(ctx, houseIDs)
+}
+
+// RecordView adds a listing to a user's browsing history. It is best effort:
+// a failure is logged and never fails the page being viewed.
+func (uc *FavoriteUsecase) RecordView(ctx context.Context, userID, houseID uint) {
+	if userID == 0 {
+		return
+	}
+	if err := uc.history.RecordView(ctx, userID, houseID, time.Now()); err != nil {
+		uc.log.WithContext(ctx).Warnf("record view of house %d by user %d: %v", houseID, userID, err)
+	}
+}
+
+// ListBrowseHistory returns the listings a user viewed, newest first.
+// Listings deleted since are left out.
+func (uc *FavoriteUsecase) ListBrowseHistory(ctx context.Context, userID uint, limit int) ([]*BrowseRecord, error) {
+	if limit <= 0 {
+		limit = defaultBrowseHistoryPage
+	}
+	if limit > MaxBrowseHistory {
+		limit = MaxBrowseHistory
+	}
+	list, err := uc.history.ListViews(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint, 0, len(list))
+	for _, r := range list {
+		ids = append(ids, r.HouseID)
+	}
+	houses, err := uc.loadHouses(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := list[:0]
+	for _, r := range list {
+		if r.House = houses[r.HouseID]; r.House != nil {
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+// loadHouses reads listings in one query, keyed by ID.
+func (uc *FavoriteUsecase) loadHouses(ctx context.Context, ids []uint) (map[uint]*House, error) {
+	houses := map[uint]*House{}
+	if len(ids) == 0 {
+		return houses, nil
+	}
+	list, err := uc.houses.GetHouses(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range list {
+		houses[h.ID] = h
+	}
+	return houses, nil
+}
@@ -1,14 +1,407 @@
 package biz
 
-import "github.com/go-kratos/kratos/v2/log"
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrHouseNotFound is house not found.
+	ErrHouseNotFound = errors.NotFound("HOUSE_NOT_FOUND", "房源不存在")
+	// ErrHouseInvalid is a listing with missing or invalid fields.
+	ErrHouseInvalid = errors.BadRequest("HOUSE_INVALID", "面积和价格必须大于0")
+	// ErrRentInvalid is a rent listing with invalid rental terms, or a sale
+	// listing with rental terms.
+	ErrRentInvalid = errors.BadRequest("RENT_INVALID", "出租信息有误：押金0-3个月，付款1-12个月，合租房间须填写房间名并属于合租房源")
+)
+
+// 房源类型
+const (
+	ListingSale int32 = iota // 出售
+	ListingRent              // 出租
+)
+
+// 房源状态
+const (
+	HouseOnSale  int32 = iota // 在售
+	HouseSold                 // 已售
+	HouseOffline              // 下架
+	HouseRented               // 已出租
+)
+
+// 出租方式
+const (
+	RentWhole  int32 = iota // 整租
+	RentShared              // 合租
+)
+
+const (
+	maxDepositMonths = 3
+	maxPayMonths     = 12
+)
+
+// rentUtilities are the charges a rent may include.
+var rentUtilities = map[string]bool{"水费": true, "电费": true, "燃气费": true, "网费": true, "物业费": true, "取暖费": true}
+
+// House is a house listing model.
+type House struct {
+	gorm.Model
+	OwnerID       uint     // 发布人（商家）
+	ListingType   int32    // 0出售 1出租
+	Title         string   // 标题
+	Description   string   // 描述
+	City          string   // 城市
+	District      string   // 区县
+	RegionID      uint     // 区域（区县或商圈）
+	CommunityID   uint     // 小区
+	CommunityName string   // 小区名称
+	BuildingID    uint     // 楼栋，须属于所在小区
+	Rooms         int32    // 室
+	Halls         int32    // 厅
+	Baths         int32    // 卫
+	Area          float64  // 建筑面积（㎡）
+	Floor         int32    // 所在楼层
+	TotalFloors   int32    // 总楼层
+	Orientation   string   // 朝向，如"南北"
+	BuildYear     int32    // 建成年份
+	Price         int64    // 总价（元）
+	UnitPrice     int64    // 单价（元/㎡）
+	Status        int32    // 0在售 1已售 2下架 3已出租
+	Tags          []string `gorm:"serializer:json"` // 标签，如"满五唯一""近地铁"
+	Images        []string `gorm:"serializer:json"` // 图片地址
+	// 以下为出租房源的信息，出售房源为空
+	MonthlyRent   int64      // 月租金（元），与 Price 相同
+	DepositMonths int32      // 押几个月
+	PayMonths     int32      // 付几个月，如押一付三为1和3
+	RentalMode    int32      // 0整租 1合租
+	ParentID      uint       // 合租房间所在的整套房源
+	RoomName      string     // 合租房间名，如"主卧"
+	Utilities     []string   `gorm:"serializer:json"` // 租金包含的费用，如"水费""网费"
+	AvailableFrom *time.Time // 可入住日期，为空即可入住
+}
+
+// Layout renders the layout as shown on listings, e.g. "2室1厅1卫".
+func (h *House) Layout() string {
+	return fmt.Sprintf("%d室%d厅%d卫", h.Rooms, h.Halls, h.Baths)
+}
+
+// PaymentCycle renders the deposit and payment months of a rent listing as
+// shown on listings, e.g. "押一付三" or "免押付一".
+func (h *House) PaymentCycle() string {
+	if h.ListingType != ListingRent {
+		return ""
+	}
+	if h.DepositMonths == 0 {
+		return "免押付" + chineseCount(h.PayMonths)
+	}
+	return "押" + chineseCount(h.DepositMonths) + "付" + chineseCount(h.PayMonths)
+}
+
+// chineseCount writes 0 to 99 in Chinese numerals.
+func chineseCount(n int32) string {
+	digits := []string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	switch {
+	case n < 10:
+		return digits[n]
+	case n < 20:
+		return "十" + strings.TrimPrefix(digits[n%10], "零")
+	}
+	return digits[n/10] + "十" + strings.TrimPrefix(digits[n%10], "零")
+}
+
+// HouseRepo is a house repo. CreateHouse and UpdateHouse store a
+// HouseChangedEvent in the outbox with the change.
 type HouseRepo interface {
+	CreateHouse(context.Context, *House) (*House, error)
+	GetHouse(ctx context.Context, id uint) (*House, error)
+	// LockHouse reads a listing from the primary, bypassing the cache, and
+	// locks it until the transaction in ctx ends.
+	LockHouse(ctx context.Context, id uint) (*House, error)
+	// GetHouses reads listings by ID in one query; missing IDs are skipped.
+	GetHouses(ctx context.Context, ids []uint) ([]*House, error)
+	UpdateHouse(context.Context, *House) (*House, error)
+	// ListHouses returns up to limit listings with an ID above afterID, by ID.
+	ListHouses(ctx context.Context, afterID uint, limit int) ([]*House, error)
+	// ListOnSale returns up to limit sale listings on sale in the community,
+	// or in the district when communityID is 0, newest first.
+	ListOnSale(ctx context.Context, communityID uint, city, district string, limit int) ([]*House, error)
+	// ListRooms returns the room listings of a shared apartment, by ID.
+	ListRooms(ctx context.Context, parentID uint) ([]*House, error)
 }
+
+// HouseUsecase is a house usecase.
 type HouseUsecase struct {
-	repo HouseRepo
-	log  *log.Helper
+	repo        HouseRepo
+	communities CommunityRepo
+	regions     *RegionUsecase
+	prices      PriceRepo
+	content     *ContentUsecase
+	tx          Transaction
+	log         *log.Helper
+}
+
+// NewHouseUsecase new a House usecase.
+func NewHouseUsecase(repo HouseRepo, communities CommunityRepo, regions *RegionUsecase, prices PriceRepo, content *ContentUsecase, tx Transaction, bus EventBus, logger log.Logger) *HouseUsecase {
+	uc := &HouseUsecase{repo: repo, communities: communities, regions: regions, prices: prices, content: content, tx: tx, log: log.NewHelper(logger)}
+	Subscribe(bus, "house", uc.onDealCompleted)
+	Subscribe(bus, "house", uc.onLeaseSigned)
+	Subscribe(bus, "house", uc.onLeaseEnded)
+	return uc
+}
+
+// CreateHouse publishes a listing; the unit price is derived from price and
+// area, and the location is taken from the community when one is given,
+// otherwise from the region. The title and description go through the
+// content filter; words to review are left for the moderation queue.
+// A room of a shared apartment takes its location from the apartment.
+func (uc *HouseUsecase) CreateHouse(ctx context.Context, h *House) (*House, error) {
+	uc.log.WithContext(ctx).Infof("CreateHouse: %v", h.Title)
+	if err := uc.rentTerms(ctx, h); err != nil {
+		return nil, err
+	}
+	if h.Area <= 0 || h.Price <= 0 {
+		return nil, ErrHouseInvalid
+	}
+	if _, err := uc.content.Clean(ctx, SceneListing, ContentField{"title", &h.Title}, ContentField{"description", &h.Description}); err != nil {
+		return nil, err
+	}
+	if h.CommunityID != 0 {
+		c, err := uc.communities.GetCommunity(ctx, h.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			return nil, ErrCommunityNotFound
+		}
+		h.CommunityName, h.RegionID, h.City, h.District = c.Name, c.RegionID, c.City, c.District
+		if h.BuildYear == 0 {
+			h.BuildYear = c.BuildYear
+		}
+		if h.BuildingID != 0 {
+			b, err := uc.communities.GetBuilding(ctx, h.BuildingID)
+			if err != nil {
+				return nil, err
+			}
+			if b == nil || b.CommunityID != h.CommunityID {
+				return nil, ErrBuildingNotFound
+			}
+		}
+	} else {
+		if h.BuildingID != 0 {
+			return nil, ErrBuildingNotFound
+		}
+		p := Place{RegionID: h.RegionID, City: h.City, District: h.District}
+		if err := uc.regions.Locate(ctx, &p); err != nil {
+			return nil, err
+		}
+		h.RegionID, h.City, h.District = p.RegionID, p.City, p.District
+	}
+	h.UnitPrice = unitPrice(h.Price, h.Area)
+	h.Tags = normalizeTags(h.Tags)
+	h.Status = HouseOnSale
+	return uc.repo.CreateHouse(ctx, h)
+}
+
+// rentTerms checks the rental terms of h and fills in their defaults: the
+// rent and the price are the same, payment is monthly unless given. Rooms
+// copy the location and layout of their apartment.
+func (uc *HouseUsecase) rentTerms(ctx context.Context, h *House) error {
+	if h.ListingType != ListingRent {
+		if h.MonthlyRent != 0 || h.DepositMonths != 0 || h.PayMonths != 0 || h.RentalMode != RentWhole || h.ParentID != 0 ||
+			h.RoomName != "" || len(h.Utilities) > 0 || h.AvailableFrom != nil {
+			return ErrRentInvalid
+		}
+		return nil
+	}
+	if h.MonthlyRent == 0 {
+		h.MonthlyRent = h.Price
+	}
+	h.Price = h.MonthlyRent
+	if h.PayMonths == 0 {
+		h.PayMonths = 1
+	}
+	if h.DepositMonths < 0 || h.DepositMonths > maxDepositMonths || h.PayMonths < 1 || h.PayMonths > maxPayMonths ||
+		h.RentalMode != RentWhole && h.RentalMode != RentShared {
+		return ErrRentInvalid
+	}
+	h.Utilities = normalizeTags(h.Utilities)
+	for _, u := range h.Utilities {
+		if !rentUtilities[u] {
+			return ErrRentInvalid
+		}
+	}
+	h.RoomName = strings.TrimSpace(h.RoomName)
+	if h.ParentID == 0 {
+		if h.RoomName != "" {
+			return ErrRentInvalid
+		}
+		return nil
+	}
+	p, err := uc.repo.GetHouse(ctx, h.ParentID)
+	if err != nil {
+		return err
+	}
+	if p == nil || p.ListingType != ListingRent || p.RentalMode != RentShared || p.ParentID != 0 || h.RoomName == "" {
+		return ErrRentInvalid
+	}
+	h.RentalMode = RentShared
+	if h.OwnerID == 0 {
+		h.OwnerID = p.OwnerID
+	}
+	h.City, h.District, h.RegionID, h.CommunityID, h.BuildingID = p.City, p.District, p.RegionID, p.CommunityID, p.BuildingID
+	h.Rooms, h.Halls, h.Baths, h.Floor, h.TotalFloors, h.BuildYear = p.Rooms, p.Halls, p.Baths, p.Floor, p.TotalFloors, p.BuildYear
+	return nil
+}
+
+// GetHouse returns a listing by id.
+func (uc *HouseUsecase) GetHouse(ctx context.Context, id uint) (*House, error) {
+	h, err := uc.repo.GetHouse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, ErrHouseNotFound
+	}
+	return h, nil
+}
+
+// ChangePrice sets the price of a listing on sale and records the change in
+// its price history; setting the current price again changes nothing. The
+// listing is locked until the change is saved.
+func (uc *HouseUsecase) ChangePrice(ctx context.Context, id uint, price int64) (*House, error) {
+	uc.log.WithContext(ctx).Infof("ChangePrice: house=%d price=%d", id, price)
+	if price <= 0 {
+		return nil, ErrHouseInvalid
+	}
+	var h *House
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if h, err = uc.repo.LockHouse(ctx, id); err != nil {
+			return err
+		}
+		if h == nil {
+			return ErrHouseNotFound
+		}
+		if h.Status != HouseOnSale {
+			return ErrHouseNotOnSale
+		}
+		if h.Price == price {
+			return nil
+		}
+		c := &PriceChange{HouseID: h.ID, OldPrice: h.Price, NewPrice: price}
+		h.Price, h.UnitPrice = price, unitPrice(price, h.Area)
+		if h.ListingType == ListingRent {
+			h.MonthlyRent = price
+		}
+		if h, err = uc.repo.UpdateHouse(ctx, h); err != nil {
+			return err
+		}
+		_, err = uc.prices.CreatePriceChange(ctx, c)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// ListRooms returns the room listings of a shared apartment.
+func (uc *HouseUsecase) ListRooms(ctx context.Context, h *House) ([]*House, error) {
+	if h.ListingType != ListingRent || h.RentalMode != RentShared || h.ParentID != 0 {
+		return nil, nil
+	}
+	return uc.repo.ListRooms(ctx, h.ID)
+}
+
+// updateLocked saves the change of fn to a listing read under its lock, so
+// the event handlers keep a price changed since the listing was cached. fn
+// reports whether there is anything to save.
+func (uc *HouseUsecase) updateLocked(ctx context.Context, id uint, fn func(h *House) bool) (found bool, err error) {
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		h, err := uc.repo.LockHouse(ctx, id)
+		if err != nil || h == nil || !fn(h) {
+			found = h != nil
+			return err
+		}
+		found = true
+		_, err = uc.repo.UpdateHouse(ctx, h)
+		return err
+	})
+	return found, err
+}
+
+// onDealCompleted takes the sold house off the market.
+func (uc *HouseUsecase) onDealCompleted(ctx context.Context, e *DealCompletedEvent) error {
+	found, err := uc.updateLocked(ctx, e.HouseID, func(h *House) bool {
+		if h.Status == HouseSold {
+			return false
+		}
+		h.Status = HouseSold
+		return true
+	})
+	if err == nil && !found {
+		uc.log.WithContext(ctx).Warnf("deal %d: house %d not found", e.DealID, e.HouseID)
+	}
+	return err
+}
+
+// onLeaseSigned marks the leased listing rented.
+func (uc *HouseUsecase) onLeaseSigned(ctx context.Context, e *LeaseSignedEvent) error {
+	found, err := uc.updateLocked(ctx, e.HouseID, func(h *House) bool {
+		if h.Status != HouseOnSale {
+			return false
+		}
+		h.Status = HouseRented
+		return true
+	})
+	if err == nil && !found {
+		uc.log.WithContext(ctx).Warnf("lease %d: house %d not found", e.LeaseID, e.HouseID)
+	}
+	return err
+}
+
+// onLeaseEnded puts the rented listing back on the market, available from
+// the day the lease ends. A listing back already, as after a renewal was
+// cancelled, only gets available earlier.
+func (uc *HouseUsecase) onLeaseEnded(ctx context.Context, e *LeaseEndedEvent) error {
+	found, err := uc.updateLocked(ctx, e.HouseID, func(h *House) bool {
+		if h.Status != HouseRented && (h.Status != HouseOnSale || h.AvailableFrom == nil || !e.EndedAt.Before(*h.AvailableFrom)) {
+			return false
+		}
+		h.Status = HouseOnSale
+		h.AvailableFrom = nil
+		if e.EndedAt.After(time.Now()) {
+			at := e.EndedAt
+			h.AvailableFrom = &at
+		}
+		return true
+	})
+	if err == nil && !found {
+		uc.log.WithContext(ctx).Warnf("lease %d: house %d not found", e.LeaseID, e.HouseID)
+	}
+	return err
+}
+
+// unitPrice returns the price per square metre, rounded down.
+func unitPrice(price int64, area float64) int64 {
+	return int64(float64(price) / area)
 }
 
-func NewHouseUsecase(repo HouseRepo, logger log.Logger) *HouseUsecase {
-	return &HouseUsecase{repo: repo, log: log.NewHelper(logger)}
+// normalizeTags trims tags and drops empty and duplicate ones.
+func normalizeTags(tags []string) []string {
+	res := make([]string, 0, len(tags))
+	seen := map[string]bool{}
+	for _, t := range tags {
+		if t = strings.TrimSpace(t); t != "" && !seen[t] {
+			seen[t] = true
+			res = append(res, t)
+		}
+	}
+	return res
 }
@@ -0,0 +1,459 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrLeaseNotFound is lease not found.
+	ErrLeaseNotFound = errors.NotFound("LEASE_NOT_FOUND", "租约不存在")
+	// ErrLeaseInvalid is a lease with missing or invalid terms.
+	ErrLeaseInvalid = errors.BadRequest("LEASE_INVALID", "租约须指定出租房源、租客、起租日期和1-60个月的租期，日期格式为 2006-01-02")
+	// ErrLeaseState is an operation not allowed in the lease's current status.
+	ErrLeaseState = errors.Conflict("LEASE_STATE", "租约状态不允许该操作")
+	// ErrLeaseOverlap is a lease overlapping an active lease of the house.
+	ErrLeaseOverlap = errors.Conflict("LEASE_OVERLAP", "房源在该租期内已有生效的租约")
+)
+
+// LeaseDateLayout is the format of lease dates in the API.
+const LeaseDateLayout = "2006-01-02"
+
+const maxLeaseMonths = 60
+
+// 租约状态
+const (
+	LeaseActive     int32 = iota // 生效中，含未到起租日的
+	LeaseExpired                 // 已到期
+	LeaseTerminated              // 已解约
+)
+
+// Lease is a rental contract between the landlord of a rent listing and a
+// tenant. Dates are local midnights; EndDate is the day after the last day.
+type Lease struct {
+	gorm.Model
+	HouseID         uint           // 房源
+	LandlordID      uint           // 房东，为房源发布人
+	TenantID        uint           // 租客
+	MonthlyRent     int64          // 月租金（元）
+	DepositMonths   int32          // 押几个月
+	PayMonths       int32          // 付几个月
+	Deposit         int64          // 押金（元）
+	StartDate       time.Time      // 起租日
+	Months          int32          // 租期（月）
+	EndDate         time.Time      // 到期日，不含当天
+	Status          int32          // 0生效中 1已到期 2已解约
+	RenewedFrom     uint           // 续租前的租约
+	RenewalID       uint           // 续租后的租约
+	TerminatedAt    *time.Time     // 提前解约日，租金计到前一天
+	Penalty         int64          // 违约金（元）
+	TerminateReason string         // 解约原因
+	Charges         []*LeaseCharge `gorm:"serializer:json"` // 随租金收取的固定费用
+}
+
+// LeaseCharge is a fixed monthly charge billed with the rent, for a utility
+// the rent does not include.
+type LeaseCharge struct {
+	Name    string `json:"name"`    // 费用名称，如"网费"
+	Monthly int64  `json:"monthly"` // 每月金额（元）
+}
+
+// RentPeriod is one payment of a lease's rent schedule.
+type RentPeriod struct {
+	Seq     int32     // 第几期，从1开始
+	Start   time.Time // 计租开始
+	End     time.Time // 计租结束，不含当天
+	DueDate time.Time // 应付日，为计租开始日
+	Amount  int64     // 租金（元）
+	// months and part are the whole months the period covers and the
+	// fraction of the month cut short by termination.
+	months int
+	part   float64
+}
+
+// Charge returns what a monthly amount comes to over the period.
+func (p *RentPeriod) Charge(monthly int64) int64 {
+	return monthly*int64(p.months) + int64(math.Round(float64(monthly)*p.part))
+}
+
+// PeriodCharges returns the fixed charges of the lease over p.
+func (l *Lease) PeriodCharges(p *RentPeriod) []*LeaseCharge {
+	var res []*LeaseCharge
+	for _, c := range l.Charges {
+		res = append(res, &LeaseCharge{Name: c.Name, Monthly: p.Charge(c.Monthly)})
+	}
+	return res
+}
+
+// LastDay returns the day the lease's rent runs until, the termination
+// date when terminated early.
+func (l *Lease) LastDay() time.Time {
+	if l.TerminatedAt != nil && l.TerminatedAt.Before(l.EndDate) {
+		return *l.TerminatedAt
+	}
+	return l.EndDate
+}
+
+// Schedule splits the lease term, cut at the termination date, into
+// payments of PayMonths months, each due on its first day. Months are
+// counted from the start date; a month cut short by termination is charged
+// by the day.
+func (l *Lease) Schedule() []*RentPeriod {
+	end := l.LastDay()
+	month := func(k int) time.Time { return addMonths(l.StartDate, k) }
+	var res []*RentPeriod
+	for k := 0; month(k).Before(end); k += int(l.PayMonths) {
+		p := &RentPeriod{Seq: int32(len(res) + 1), Start: month(k), DueDate: month(k)}
+		i := k
+		for ; i < k+int(l.PayMonths) && !month(i+1).After(end); i++ {
+			p.months++
+		}
+		p.End = month(i)
+		if i < k+int(l.PayMonths) && month(i).Before(end) {
+			p.part = days(month(i), end) / days(month(i), month(i+1))
+			p.End = end
+		}
+		p.Amount = p.Charge(l.MonthlyRent)
+		res = append(res, p)
+	}
+	return res
+}
+
+// addMonths adds n months to t, keeping the day of month but no later than
+// the month's last day: a lease from January 31 runs months to February 28,
+// then March 31.
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// days returns the number of days from a to b.
+func days(a, b time.Time) float64 {
+	return math.Round(b.Sub(a).Hours() / 24)
+}
+
+// refresh marks an active lease past its end expired for readers; the
+// daily billing run saves it.
+func (l *Lease) refresh(now time.Time) {
+	if l.Status == LeaseActive && !now.Before(l.EndDate) {
+		l.Status = LeaseExpired
+	}
+}
+
+// TopicLeaseSigned is the topic of LeaseSignedEvent.
+const TopicLeaseSigned = "lease.signed"
+
+// LeaseSignedEvent is raised when a lease is signed or renewed; the house
+// module marks the listing rented.
+type LeaseSignedEvent struct {
+	LeaseID   uint      `json:"lease_id"`
+	HouseID   uint      `json:"house_id"`
+	TenantID  uint      `json:"tenant_id"`
+	StartDate time.Time `json:"start_date"`
+}
+
+func (*LeaseSignedEvent) Topic() string { return TopicLeaseSigned }
+
+func (e *LeaseSignedEvent) Key() string { return strconv.FormatUint(uint64(e.LeaseID), 10) }
+
+// TopicLeaseEnded is the topic of LeaseEndedEvent.
+const TopicLeaseEnded = "lease.ended"
+
+// LeaseEndedEvent is raised when a lease is terminated, or expires without
+// a renewal; the house module puts the listing back on the market from
+// EndedAt.
+type LeaseEndedEvent struct {
+	LeaseID uint      `json:"lease_id"`
+	HouseID uint      `json:"house_id"`
+	EndedAt time.Time `json:"ended_at"`
+}
+
+func (*LeaseEndedEvent) Topic() string { return TopicLeaseEnded }
+
+func (e *LeaseEndedEvent) Key() string { return strconv.FormatUint(uint64(e.LeaseID), 10) }
+
+// LeaseRepo is a lease repo. CreateLease stores a LeaseSignedEvent in the
+// outbox with the lease; UpdateLease saves l and events atomically.
+type LeaseRepo interface {
+	CreateLease(ctx context.Context, l *Lease) (*Lease, error)
+	GetLease(ctx context.Context, id uint) (*Lease, error)
+	// LockLease reads a lease from the primary and locks it until the
+	// transaction in ctx ends.
+	LockLease(ctx context.Context, id uint) (*Lease, error)
+	UpdateLease(ctx context.Context, l *Lease, events ...*Event) (*Lease, error)
+	// ListLeases returns the leases matching the non-zero IDs, newest first.
+	ListLeases(ctx context.Context, tenantID, landlordID, houseID uint) ([]*Lease, error)
+	// ListActiveLeases returns up to limit active leases with an ID above
+	// afterID, by ID.
+	ListActiveLeases(ctx context.Context, afterID uint, limit int) ([]*Lease, error)
+}
+
+// LeaseUsecase signs, renews and terminates leases of rent listings.
+type LeaseUsecase struct {
+	repo   LeaseRepo
+	houses HouseRepo
+	tx     Transaction
+	log    *log.Helper
+}
+
+// NewLeaseUsecase new a Lease usecase.
+func NewLeaseUsecase(repo LeaseRepo, houses HouseRepo, tx Transaction, logger log.Logger) *LeaseUsecase {
+	return &LeaseUsecase{repo: repo, houses: houses, tx: tx, log: log.NewHelper(logger)}
+}
+
+// ParseLeaseDate parses a date as 2006-01-02, the zero time when empty.
+func ParseLeaseDate(s string) (time.Time, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.ParseInLocation(LeaseDateLayout, s, time.Local)
+	if err != nil {
+		return time.Time{}, ErrLeaseInvalid
+	}
+	return t, nil
+}
+
+// today returns the local midnight of now.
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// SignLease signs a lease for a rent listing on the market with its owner
+// as landlord. The rent, deposit and payment months default to the
+// listing's; the deposit is that many months of rent. Charges are for the
+// utilities the listing's rent does not include. The listing is locked
+// while signing, so it is never let twice for the same days.
+func (uc *LeaseUsecase) SignLease(ctx context.Context, l *Lease) (*Lease, error) {
+	uc.log.WithContext(ctx).Infof("SignLease: house=%d tenant=%d", l.HouseID, l.TenantID)
+	if l.HouseID == 0 || l.TenantID == 0 || l.StartDate.IsZero() || l.Months < 1 || l.Months > maxLeaseMonths ||
+		l.MonthlyRent < 0 || l.DepositMonths < 0 || l.DepositMonths > maxDepositMonths || l.PayMonths < 0 || l.PayMonths > maxPayMonths {
+		return nil, ErrLeaseInvalid
+	}
+	var res *Lease
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		// 锁住房源行：缓存中的状态可能过期，标记已出租的事件也可能还没处理
+		h, err := uc.houses.LockHouse(ctx, l.HouseID)
+		if err != nil {
+			return err
+		}
+		if h == nil {
+			return ErrHouseNotFound
+		}
+		if h.ListingType != ListingRent {
+			return ErrLeaseInvalid
+		}
+		if h.Status != HouseOnSale {
+			return ErrHouseNotOnSale
+		}
+		l.EndDate = addMonths(l.StartDate, int(l.Months))
+		leases, err := uc.repo.ListLeases(ctx, 0, 0, h.ID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, o := range leases {
+			if o.refresh(now); o.Status == LeaseActive && o.StartDate.Before(l.EndDate) && l.StartDate.Before(o.LastDay()) {
+				return ErrLeaseOverlap
+			}
+		}
+		if l.MonthlyRent == 0 {
+			l.MonthlyRent = h.Price
+		}
+		if l.DepositMonths == 0 {
+			l.DepositMonths = h.DepositMonths
+		}
+		if l.PayMonths == 0 {
+			l.PayMonths = h.PayMonths
+		}
+		// 早于出租信息的出租房源没有付款月数，按月付
+		if l.PayMonths == 0 {
+			l.PayMonths = 1
+		}
+		if !validCharges(l.Charges, h.Utilities) {
+			return ErrLeaseInvalid
+		}
+		l.LandlordID = h.OwnerID
+		l.Deposit = l.MonthlyRent * int64(l.DepositMonths)
+		l.Status = LeaseActive
+		res, err = uc.repo.CreateLease(ctx, l)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// validCharges reports whether charges are utilities not included in the
+// rent, each charged once a positive amount.
+func validCharges(charges []*LeaseCharge, included []string) bool {
+	seen := map[string]bool{}
+	for _, u := range included {
+		seen[u] = true
+	}
+	for _, c := range charges {
+		if c.Name = strings.TrimSpace(c.Name); !rentUtilities[c.Name] || seen[c.Name] || c.Monthly <= 0 {
+			return false
+		}
+		seen[c.Name] = true
+	}
+	return true
+}
+
+// GetLease returns a lease by id.
+func (uc *LeaseUsecase) GetLease(ctx context.Context, id uint) (*Lease, error) {
+	l, err := uc.repo.GetLease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil {
+		return nil, ErrLeaseNotFound
+	}
+	l.refresh(time.Now())
+	return l, nil
+}
+
+// lockLease is GetLease with the lease locked, for the changes that check
+// its state first.
+func (uc *LeaseUsecase) lockLease(ctx context.Context, id uint) (*Lease, error) {
+	l, err := uc.repo.LockLease(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil {
+		return nil, ErrLeaseNotFound
+	}
+	l.refresh(time.Now())
+	return l, nil
+}
+
+// ListLeases returns the leases of a tenant, a landlord or a house; at
+// least one must be given.
+func (uc *LeaseUsecase) ListLeases(ctx context.Context, tenantID, landlordID, houseID uint) ([]*Lease, error) {
+	if tenantID == 0 && landlordID == 0 && houseID == 0 {
+		return nil, ErrLeaseInvalid
+	}
+	list, err := uc.repo.ListLeases(ctx, tenantID, landlordID, houseID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, l := range list {
+		l.refresh(now)
+	}
+	return list, nil
+}
+
+// RenewLease extends an active lease with a new one of months starting on
+// its end date, at rent or the current rent when 0. A lease is renewed
+// once; renew the new one to extend further.
+func (uc *LeaseUsecase) RenewLease(ctx context.Context, id uint, months int32, rent int64) (*Lease, error) {
+	uc.log.WithContext(ctx).Infof("RenewLease: lease=%d months=%d", id, months)
+	if months < 1 || months > maxLeaseMonths || rent < 0 {
+		return nil, ErrLeaseInvalid
+	}
+	var res *Lease
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		l, err := uc.lockLease(ctx, id)
+		if err != nil {
+			return err
+		}
+		if l.Status != LeaseActive || l.TerminatedAt != nil || l.RenewalID != 0 {
+			return ErrLeaseState
+		}
+		if rent == 0 {
+			rent = l.MonthlyRent
+		}
+		n := &Lease{
+			HouseID:       l.HouseID,
+			LandlordID:    l.LandlordID,
+			TenantID:      l.TenantID,
+			MonthlyRent:   rent,
+			DepositMonths: l.DepositMonths,
+			PayMonths:     l.PayMonths,
+			Deposit:       rent * int64(l.DepositMonths),
+			StartDate:     l.EndDate,
+			Months:        months,
+			EndDate:       addMonths(l.EndDate, int(months)),
+			Status:        LeaseActive,
+			RenewedFrom:   l.ID,
+			Charges:       l.Charges,
+		}
+		if res, err = uc.repo.CreateLease(ctx, n); err != nil {
+			return err
+		}
+		l.RenewalID = res.ID
+		_, err = uc.repo.UpdateLease(ctx, l)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TerminateLease ends an active lease early on date, today when zero: rent
+// is charged up to the day before, and penalty is what the breaking party
+// owes. A renewed lease is terminated through its renewal; terminating a
+// renewal on its start date just cancels the renewal.
+func (uc *LeaseUsecase) TerminateLease(ctx context.Context, id uint, date time.Time, penalty int64, reason string) (*Lease, error) {
+	uc.log.WithContext(ctx).Infof("TerminateLease: lease=%d date=%v", id, date)
+	if penalty < 0 {
+		return nil, ErrLeaseInvalid
+	}
+	if date.IsZero() {
+		date = today()
+	}
+	var res *Lease
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		l, err := uc.lockLease(ctx, id)
+		if err != nil {
+			return err
+		}
+		if l.Status != LeaseActive || l.TerminatedAt != nil || l.RenewalID != 0 {
+			return ErrLeaseState
+		}
+		if date.Before(l.StartDate) || !date.Before(l.EndDate) {
+			return ErrLeaseInvalid
+		}
+		if l.RenewedFrom != 0 && date.Equal(l.StartDate) {
+			prev, err := uc.repo.LockLease(ctx, l.RenewedFrom)
+			if err != nil {
+				return err
+			}
+			if prev != nil && prev.RenewalID == l.ID {
+				prev.RenewalID = 0
+				if _, err := uc.repo.UpdateLease(ctx, prev); err != nil {
+					return err
+				}
+			}
+		}
+		l.Status = LeaseTerminated
+		l.TerminatedAt = &date
+		l.Penalty = penalty
+		l.TerminateReason = strings.TrimSpace(reason)
+		e, err := NewEvent(&LeaseEndedEvent{LeaseID: l.ID, HouseID: l.HouseID, EndedAt: date})
+		if err != nil {
+			return err
+		}
+		res, err = uc.repo.UpdateLease(ctx, l, e)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
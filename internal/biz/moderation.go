@@ -0,0 +1,459 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrModerationNotFound is moderation item not found.
+	ErrModerationNotFound = errors.NotFound("MODERATION_NOT_FOUND", "审核记录不存在")
+	// ErrModerationReviewed is a review of an item already reviewed.
+	ErrModerationReviewed = errors.Conflict("MODERATION_REVIEWED", "该房源已审核")
+)
+
+// 可疑原因
+const (
+	ReasonDuplicate = "duplicate" // 同一套房重复发布
+	ReasonPrice     = "price"     // 单价偏离小区中位数
+	ReasonImage     = "image"     // 图片与其他房源相似
+	ReasonContent   = "content"   // 标题或描述含需人工审核的词
+)
+
+// 审核状态
+const (
+	ModerationPending  int32 = iota // 待审核
+	ModerationApproved              // 通过
+	ModerationRejected              // 驳回，房源下架
+)
+
+const (
+	defaultModerationPageSize = 20
+	maxModerationPageSize     = 100
+	// duplicateScore and contentScore are fixed; the price and image scores
+	// grow with how far the price deviates and how alike the images are.
+	duplicateScore = 40
+	contentScore   = 30
+)
+
+// ListingFingerprint is what the detector keeps of a listing on sale to
+// compare new listings with: the key of the apartment and the perceptual
+// hashes of its images.
+type ListingFingerprint struct {
+	gorm.Model
+	HouseID     uint     // 房源
+	CommunityID uint     // 小区
+	Fingerprint string   // 房源类型、小区、楼栋、楼层、面积和户型
+	Images      []string `gorm:"serializer:json"` // 已计算的图片地址
+	ImageHashes []uint64 `gorm:"serializer:json"` // 与 Images 一一对应的 dHash
+}
+
+// ModerationReason is why a listing looks suspicious.
+type ModerationReason struct {
+	Kind     string `json:"kind"`      // duplicate、price、image 或 content
+	Detail   string `json:"detail"`    // 给审核人员看的说明
+	HouseIDs []uint `json:"house_ids"` // 相关房源
+	Score    int32  `json:"score"`
+}
+
+// ModerationItem is a suspicious listing in the moderation queue.
+type ModerationItem struct {
+	gorm.Model
+	HouseID    uint                `gorm:"index"`
+	Status     int32               // 0待审核 1通过 2驳回
+	Score      int32               // 可疑程度 0-100，各原因得分之和
+	Reasons    []*ModerationReason `gorm:"serializer:json"`
+	ReviewerID uint                // 审核人
+	Note       string              // 审核备注
+	ReviewedAt *time.Time
+	House      *House `gorm:"-"`
+}
+
+// ModerationRules are the thresholds of the detector.
+type ModerationRules struct {
+	MaxPriceDeviation float64 // 单价偏离小区中位数超过该百分比时可疑
+	MinPriceSamples   int     // 小区其他在售房源少于该数时不比较价格
+	MaxImageDistance  int     // dHash 汉明距离不超过该值视为相似图片
+}
+
+// DefaultModerationRules returns the built-in thresholds.
+func DefaultModerationRules() *ModerationRules {
+	return &ModerationRules{MaxPriceDeviation: 30, MinPriceSamples: 3, MaxImageDistance: 10}
+}
+
+// ImageHasher computes the perceptual hash of an image by its URL.
+type ImageHasher interface {
+	Hash(ctx context.Context, url string) (uint64, error)
+}
+
+// ModerationRepo keeps the fingerprints and the moderation queue.
+type ModerationRepo interface {
+	GetFingerprint(ctx context.Context, houseID uint) (*ListingFingerprint, error)
+	// SaveFingerprint creates the fingerprint of a listing or replaces it.
+	SaveFingerprint(context.Context, *ListingFingerprint) error
+	DeleteFingerprint(ctx context.Context, houseID uint) error
+	// ListFingerprints returns the fingerprints of a community.
+	ListFingerprints(ctx context.Context, communityID uint) ([]*ListingFingerprint, error)
+	CreateModeration(context.Context, *ModerationItem) (*ModerationItem, error)
+	UpdateModeration(context.Context, *ModerationItem) (*ModerationItem, error)
+	GetModeration(ctx context.Context, id uint) (*ModerationItem, error)
+	// LatestModeration returns the newest item of a listing, nil when it was
+	// never queued.
+	LatestModeration(ctx context.Context, houseID uint) (*ModerationItem, error)
+	// ListModeration returns a page of the items in a status, oldest first,
+	// and how many there are.
+	ListModeration(ctx context.Context, status int32, offset, limit int) ([]*ModerationItem, int64, error)
+}
+
+// ModerationUsecase detects duplicate and fake listings and keeps the
+// moderation queue.
+type ModerationUsecase struct {
+	repo    ModerationRepo
+	houses  HouseRepo
+	hasher  ImageHasher
+	content *ContentUsecase
+	rules   *ModerationRules
+	log     *log.Helper
+}
+
+// NewModerationUsecase new a Moderation usecase.
+func NewModerationUsecase(repo ModerationRepo, houses HouseRepo, hasher ImageHasher, content *ContentUsecase, rules *ModerationRules, bus EventBus, logger log.Logger) *ModerationUsecase {
+	uc := &ModerationUsecase{repo: repo, houses: houses, hasher: hasher, content: content, rules: rules, log: log.NewHelper(logger)}
+	Subscribe(bus, "moderation", uc.onHouseChanged)
+	return uc
+}
+
+// Fingerprint is the key of the apartment a listing is for: listings of
+// the same type, community, building, floor, layout and area rounded to
+// the square metre are taken as the same apartment. Rooms of a shared
+// apartment are told apart by name.
+func (h *House) Fingerprint() string {
+	fp := fmt.Sprintf("%d|%d|%d|%d|%s|%d", h.ListingType, h.CommunityID, h.BuildingID, h.Floor, h.Layout(), int64(math.Round(h.Area)))
+	if h.ParentID != 0 {
+		fp += "|" + h.RoomName
+	}
+	return fp
+}
+
+// Check fingerprints a listing on sale and compares it with the others of
+// its community. A suspicious listing is queued, or its pending item
+// updated, and the item returned; nil means nothing was queued. A listing
+// approved for the same kinds of reasons is not queued again.
+func (uc *ModerationUsecase) Check(ctx context.Context, houseID uint) (*ModerationItem, error) {
+	h, err := uc.houses.GetHouse(ctx, houseID)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil || h.Status != HouseOnSale || h.CommunityID == 0 {
+		return nil, uc.repo.DeleteFingerprint(ctx, houseID)
+	}
+	fp, err := uc.fingerprint(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	others, err := uc.repo.ListFingerprints(ctx, h.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []*ModerationReason
+	duplicates := map[uint]bool{}
+	var similar []uint
+	best := 64
+	for _, o := range others {
+		if o.HouseID == h.ID {
+			continue
+		}
+		if o.Fingerprint == fp.Fingerprint {
+			duplicates[o.HouseID] = true
+			continue
+		}
+		// 同一套房的重复房源共用图片是正常的，只看不同房子之间的相似图片
+		if d := minDistance(fp.ImageHashes, o.ImageHashes); d <= uc.rules.MaxImageDistance {
+			similar = append(similar, o.HouseID)
+			if d < best {
+				best = d
+			}
+		}
+	}
+	if len(duplicates) > 0 {
+		ids := sortedIDs(duplicates)
+		reasons = append(reasons, &ModerationReason{
+			Kind:     ReasonDuplicate,
+			Detail:   fmt.Sprintf("与 %d 套在售房源的小区、楼栋、楼层、户型和面积相同", len(ids)),
+			HouseIDs: ids,
+			Score:    duplicateScore,
+		})
+	}
+	if r, err := uc.checkPrice(ctx, h, duplicates); err != nil {
+		return nil, err
+	} else if r != nil {
+		reasons = append(reasons, r)
+	}
+	if len(similar) > 0 {
+		sort.Slice(similar, func(i, j int) bool { return similar[i] < similar[j] })
+		similarity := 100 - best*100/64
+		reasons = append(reasons, &ModerationReason{
+			Kind:     ReasonImage,
+			Detail:   fmt.Sprintf("图片与 %d 套其他房源相似，相似度 %d%%", len(similar), similarity),
+			HouseIDs: similar,
+			Score:    int32(similarity / 2),
+		})
+	}
+	if r, err := uc.checkContent(ctx, h); err != nil {
+		return nil, err
+	} else if r != nil {
+		reasons = append(reasons, r)
+	}
+	if len(reasons) == 0 {
+		return nil, nil
+	}
+	return uc.enqueue(ctx, h.ID, reasons)
+}
+
+// fingerprint saves the fingerprint of a listing, hashing only the images
+// not hashed before. An image that cannot be read is left out.
+func (uc *ModerationUsecase) fingerprint(ctx context.Context, h *House) (*ListingFingerprint, error) {
+	fp, err := uc.repo.GetFingerprint(ctx, h.ID)
+	if err != nil {
+		return nil, err
+	}
+	if fp == nil {
+		fp = &ListingFingerprint{HouseID: h.ID}
+	}
+	known := map[string]uint64{}
+	for i, url := range fp.Images {
+		known[url] = fp.ImageHashes[i]
+	}
+	fp.CommunityID, fp.Fingerprint = h.CommunityID, h.Fingerprint()
+	fp.Images, fp.ImageHashes = nil, nil
+	for _, url := range h.Images {
+		hash, ok := known[url]
+		if !ok {
+			if hash, err = uc.hasher.Hash(ctx, url); err != nil {
+				uc.log.WithContext(ctx).Warnf("house %d: hash image %s: %v", h.ID, url, err)
+				continue
+			}
+		}
+		fp.Images = append(fp.Images, url)
+		fp.ImageHashes = append(fp.ImageHashes, hash)
+	}
+	if err := uc.repo.SaveFingerprint(ctx, fp); err != nil {
+		return nil, err
+	}
+	return fp, nil
+}
+
+// checkPrice compares the unit price of a sale listing with the median of
+// the other listings of its community, leaving out its duplicates so one
+// apartment posted many times cannot move the median.
+func (uc *ModerationUsecase) checkPrice(ctx context.Context, h *House, duplicates map[uint]bool) (*ModerationReason, error) {
+	if h.ListingType != ListingSale {
+		return nil, nil
+	}
+	list, err := uc.houses.ListOnSale(ctx, h.CommunityID, "", "", maxCandidates)
+	if err != nil {
+		return nil, err
+	}
+	var prices []int64
+	for _, o := range list {
+		if o.ID != h.ID && !duplicates[o.ID] {
+			prices = append(prices, o.UnitPrice)
+		}
+	}
+	if len(prices) < uc.rules.MinPriceSamples {
+		return nil, nil
+	}
+	median := medianOf(prices)
+	deviation := float64(h.UnitPrice-median) / float64(median) * 100
+	if math.Abs(deviation) <= uc.rules.MaxPriceDeviation {
+		return nil, nil
+	}
+	dir := "高"
+	if deviation < 0 {
+		dir = "低"
+	}
+	return &ModerationReason{
+		Kind:   ReasonPrice,
+		Detail: fmt.Sprintf("单价 %d 元/㎡，比小区 %d 套在售房源的中位数 %d 元/㎡%s %.0f%%", h.UnitPrice, len(prices), median, dir, math.Abs(deviation)),
+		Score:  int32(math.Min(60, 40+math.Abs(deviation)-uc.rules.MaxPriceDeviation)),
+	}, nil
+}
+
+// checkContent looks for the words of the title and description that were
+// let through for review when the listing was published.
+func (uc *ModerationUsecase) checkContent(ctx context.Context, h *House) (*ModerationReason, error) {
+	var words []string
+	seen := map[string]bool{}
+	for _, text := range []string{h.Title, h.Description} {
+		res, err := uc.content.Filter(ctx, SceneListing, text)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range res.Hits {
+			if hit.Action == ActionReview && !seen[hit.Text] {
+				seen[hit.Text] = true
+				words = append(words, hit.Text)
+			}
+		}
+	}
+	if len(words) == 0 {
+		return nil, nil
+	}
+	return &ModerationReason{
+		Kind:   ReasonContent,
+		Detail: fmt.Sprintf("标题或描述含需审核的词：%s", strings.Join(words, "、")),
+		Score:  contentScore,
+	}, nil
+}
+
+// enqueue updates the pending item of a listing, or queues a new one unless
+// the last review approved the same kinds of reasons.
+func (uc *ModerationUsecase) enqueue(ctx context.Context, houseID uint, reasons []*ModerationReason) (*ModerationItem, error) {
+	var score int32
+	for _, r := range reasons {
+		score += r.Score
+	}
+	if score > 100 {
+		score = 100
+	}
+	last, err := uc.repo.LatestModeration(ctx, houseID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case last != nil && last.Status == ModerationPending:
+		last.Reasons, last.Score = reasons, score
+		return uc.repo.UpdateModeration(ctx, last)
+	case last != nil && last.Status == ModerationApproved && sameKinds(last.Reasons, reasons):
+		return nil, nil
+	}
+	uc.log.WithContext(ctx).Infof("house %d queued for moderation, score %d", houseID, score)
+	return uc.repo.CreateModeration(ctx, &ModerationItem{HouseID: houseID, Status: ModerationPending, Score: score, Reasons: reasons})
+}
+
+// ListQueue returns a page of the items in a status with their listings.
+func (uc *ModerationUsecase) ListQueue(ctx context.Context, status int32, page, pageSize int) ([]*ModerationItem, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultModerationPageSize
+	}
+	if pageSize > maxModerationPageSize {
+		pageSize = maxModerationPageSize
+	}
+	list, total, err := uc.repo.ListModeration(ctx, status, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	ids := make([]uint, 0, len(list))
+	for _, m := range list {
+		ids = append(ids, m.HouseID)
+	}
+	houses, err := uc.houses.GetHouses(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[uint]*House, len(houses))
+	for _, h := range houses {
+		byID[h.ID] = h
+	}
+	for _, m := range list {
+		m.House = byID[m.HouseID]
+	}
+	return list, total, nil
+}
+
+// Review approves or rejects a pending item; a rejected listing is taken
+// offline.
+func (uc *ModerationUsecase) Review(ctx context.Context, id, reviewerID uint, approve bool, note string) (*ModerationItem, error) {
+	uc.log.WithContext(ctx).Infof("Review: moderation=%d reviewer=%d approve=%v", id, reviewerID, approve)
+	m, err := uc.repo.GetModeration(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, ErrModerationNotFound
+	}
+	if m.Status != ModerationPending {
+		return nil, ErrModerationReviewed
+	}
+	now := time.Now()
+	m.Status, m.ReviewerID, m.Note, m.ReviewedAt = ModerationApproved, reviewerID, note, &now
+	if !approve {
+		m.Status = ModerationRejected
+		h, err := uc.houses.GetHouse(ctx, m.HouseID)
+		if err != nil {
+			return nil, err
+		}
+		if h != nil && h.Status == HouseOnSale {
+			h.Status = HouseOffline
+			if _, err := uc.houses.UpdateHouse(ctx, h); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return uc.repo.UpdateModeration(ctx, m)
+}
+
+// onHouseChanged checks every listing created or updated.
+func (uc *ModerationUsecase) onHouseChanged(ctx context.Context, e *HouseChangedEvent) error {
+	_, err := uc.Check(ctx, e.HouseID)
+	return err
+}
+
+// minDistance returns the smallest Hamming distance between two sets of
+// image hashes, 64 when either is empty.
+func minDistance(a, b []uint64) int {
+	best := 64
+	for _, x := range a {
+		for _, y := range b {
+			if d := bits.OnesCount64(x ^ y); d < best {
+				best = d
+			}
+		}
+	}
+	return best
+}
+
+func medianOf(v []int64) int64 {
+	s := append([]int64(nil), v...)
+	sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+	n := len(s)
+	if n%2 == 1 {
+		return s[n/2]
+	}
+	return (s[n/2-1] + s[n/2]) / 2
+}
+
+func sortedIDs(set map[uint]bool) []uint {
+	ids := make([]uint, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func sameKinds(a, b []*ModerationReason) bool {
+	kinds := map[string]bool{}
+	for _, r := range a {
+		kinds[r.Kind] = true
+	}
+	for _, r := range b {
+		if !kinds[r.Kind] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,328 @@
+package biz
+
+import (
+	"context"
+	"math"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrMortgageInvalid is a calculation with a missing price or area, or an
+	// unknown home, loan type, term or repayment method.
+	ErrMortgageInvalid = errors.BadRequest("MORTGAGE_INVALID", "请检查总价、面积、套数、贷款类型、年限和还款方式")
+	// ErrDownPaymentTooLow is a down payment below the minimum for the home.
+	ErrDownPaymentTooLow = errors.BadRequest("DOWN_PAYMENT_TOO_LOW", "首付比例低于最低要求")
+	// ErrFundLoanExceeded is a provident-fund loan above the limit or above
+	// the whole loan.
+	ErrFundLoanExceeded = errors.BadRequest("FUND_LOAN_EXCEEDED", "公积金贷款超过可贷额度")
+)
+
+// 贷款类型
+const (
+	LoanCommercial int32 = iota + 1 // 商业贷款
+	LoanFund                        // 公积金贷款
+	LoanCombined                    // 组合贷款
+)
+
+// 还款方式
+const (
+	RepayEqualInstallment int32 = iota + 1 // 等额本息
+	RepayEqualPrincipal                    // 等额本金
+)
+
+// 第几套住房
+const (
+	FirstHome  int32 = 1
+	SecondHome int32 = 2
+)
+
+// DeedTaxBracket is one row of the deed tax table.
+type DeedTaxBracket struct {
+	Home    int32   // 第几套住房
+	MaxArea float64 // 面积上限（㎡，含），0 表示不限
+	Rate    float64 // 税率（%）
+}
+
+// MortgageRates are the rate tables of the calculator. Rates and ratios are
+// in percent.
+type MortgageRates struct {
+	CommercialFirst   float64 // 首套商贷年利率
+	CommercialSecond  float64 // 二套商贷年利率
+	FundFirst         float64 // 首套公积金年利率（5年以上）
+	FundSecond        float64 // 二套公积金年利率（5年以上）
+	DownPaymentFirst  float64 // 首套最低首付比例
+	DownPaymentSecond float64 // 二套最低首付比例
+	FundLimit         int64   // 公积金最高贷款额（元）
+	MaxYears          int32   // 最长贷款年限
+	// DeedTax is matched in order: the first bracket of the home whose
+	// MaxArea is 0 or not below the area applies.
+	DeedTax        []DeedTaxBracket
+	VATRate        float64 // 增值税及附加税率，按不含税价计
+	VATExemptYears int32   // 卖方持有满该年数免征增值税
+	AgencyFeeRate  float64 // 中介费率
+}
+
+// DefaultMortgageRates returns the built-in rate tables, based on the
+// five-year LPR of 3.5% and the national deed tax rules of December 2024.
+func DefaultMortgageRates() *MortgageRates {
+	return &MortgageRates{
+		CommercialFirst:   3.5,
+		CommercialSecond:  3.9,
+		FundFirst:         2.6,
+		FundSecond:        3.075,
+		DownPaymentFirst:  15,
+		DownPaymentSecond: 25,
+		FundLimit:         1600000,
+		MaxYears:          30,
+		DeedTax: []DeedTaxBracket{
+			{Home: FirstHome, MaxArea: 140, Rate: 1},
+			{Home: FirstHome, Rate: 1.5},
+			{Home: SecondHome, MaxArea: 140, Rate: 1},
+			{Home: SecondHome, Rate: 2},
+		},
+		VATRate:        5.3,
+		VATExemptYears: 2,
+		AgencyFeeRate:  2,
+	}
+}
+
+// MortgageRequest is a purchase to calculate. Zero rates and down payment
+// take the rate tables.
+type MortgageRequest struct {
+	Price              int64   // 总价（元）
+	Area               float64 // 建筑面积（㎡）
+	Home               int32   // 第几套住房
+	DownPaymentPercent float64 // 首付比例（%）
+	LoanType           int32   // 贷款类型
+	FundAmount         int64   // 组合贷款中的公积金部分（元），0 取可贷上限
+	Years              int32   // 贷款年限
+	Method             int32   // 还款方式
+	CommercialRate     float64 // 商贷年利率（%）
+	FundRate           float64 // 公积金年利率（%）
+	YearsHeld          int32   // 卖方持有年数
+}
+
+// MortgagePayment is one month of an amortization schedule. Amounts are in
+// yuan, rounded to the fen.
+type MortgagePayment struct {
+	Month     int32
+	Payment   float64 // 月供
+	Principal float64 // 其中本金
+	Interest  float64 // 其中利息
+	Balance   float64 // 剩余本金
+}
+
+// PurchaseFee is a tax or fee paid on top of the price.
+type PurchaseFee struct {
+	Key    string // deed_tax、vat 或 agency_fee
+	Label  string
+	Amount float64
+}
+
+// MortgageQuote is the result of a calculation.
+type MortgageQuote struct {
+	DownPayment    int64 // 首付（元）
+	CommercialLoan int64 // 商业贷款（元）
+	FundLoan       int64 // 公积金贷款（元）
+	CommercialRate float64
+	FundRate       float64
+	// MonthlyPayment is the first month's payment; it stays the same under
+	// equal installments and goes down under equal principal.
+	MonthlyPayment float64
+	TotalInterest  float64
+	TotalPayment   float64 // 还款总额，本金加利息
+	Fees           []*PurchaseFee
+	TotalFees      float64
+	UpfrontCost    float64 // 首付加税费
+	Schedule       []*MortgagePayment
+}
+
+// MortgageUsecase calculates mortgages and purchase costs. It is pure
+// arithmetic over the rate tables and touches no repo.
+type MortgageUsecase struct {
+	rates *MortgageRates
+	log   *log.Helper
+}
+
+// NewMortgageUsecase new a Mortgage usecase.
+func NewMortgageUsecase(rates *MortgageRates, logger log.Logger) *MortgageUsecase {
+	return &MortgageUsecase{rates: rates, log: log.NewHelper(logger)}
+}
+
+// Calculate splits the price into the down payment and loans, amortizes the
+// loans month by month and adds the taxes and fees of the purchase.
+func (uc *MortgageUsecase) Calculate(ctx context.Context, req *MortgageRequest) (*MortgageQuote, error) {
+	r := uc.rates
+	if req.Price <= 0 || req.Area <= 0 || req.Years < 1 || req.Years > r.MaxYears ||
+		req.CommercialRate < 0 || req.FundRate < 0 || req.FundAmount < 0 || req.YearsHeld < 0 {
+		return nil, ErrMortgageInvalid
+	}
+	if req.LoanType < LoanCommercial || req.LoanType > LoanCombined || req.Method < RepayEqualInstallment || req.Method > RepayEqualPrincipal {
+		return nil, ErrMortgageInvalid
+	}
+	q := &MortgageQuote{CommercialRate: req.CommercialRate, FundRate: req.FundRate}
+	var minDown float64
+	switch req.Home {
+	case FirstHome:
+		minDown = r.DownPaymentFirst
+		q.CommercialRate = orDefault(q.CommercialRate, r.CommercialFirst)
+		q.FundRate = orDefault(q.FundRate, r.FundFirst)
+	case SecondHome:
+		minDown = r.DownPaymentSecond
+		q.CommercialRate = orDefault(q.CommercialRate, r.CommercialSecond)
+		q.FundRate = orDefault(q.FundRate, r.FundSecond)
+	default:
+		return nil, ErrMortgageInvalid
+	}
+	down := orDefault(req.DownPaymentPercent, minDown)
+	if down < minDown {
+		return nil, ErrDownPaymentTooLow
+	}
+	if down > 100 {
+		return nil, ErrMortgageInvalid
+	}
+
+	// 贷款取整到元，零头计入首付
+	loan := int64(float64(req.Price) * (100 - down) / 100)
+	q.DownPayment = req.Price - loan
+	switch req.LoanType {
+	case LoanCommercial:
+		q.CommercialLoan = loan
+	case LoanFund:
+		q.FundLoan = loan
+	case LoanCombined:
+		q.FundLoan = req.FundAmount
+		if q.FundLoan == 0 {
+			q.FundLoan = min64(loan, r.FundLimit)
+		}
+		q.CommercialLoan = loan - q.FundLoan
+	}
+	if q.FundLoan > r.FundLimit || q.CommercialLoan < 0 {
+		return nil, ErrFundLoanExceeded
+	}
+	if q.CommercialLoan == 0 {
+		q.CommercialRate = 0
+	}
+	if q.FundLoan == 0 {
+		q.FundRate = 0
+	}
+
+	months := int(req.Years) * 12
+	schedule := mergeSchedules(
+		amortize(q.CommercialLoan, q.CommercialRate, months, req.Method),
+		amortize(q.FundLoan, q.FundRate, months, req.Method),
+	)
+	var interest int64
+	for i, row := range schedule {
+		interest += row.interest
+		q.Schedule = append(q.Schedule, &MortgagePayment{
+			Month:     int32(i + 1),
+			Payment:   yuan(row.payment),
+			Principal: yuan(row.principal),
+			Interest:  yuan(row.interest),
+			Balance:   yuan(row.balance),
+		})
+	}
+	if len(q.Schedule) > 0 {
+		q.MonthlyPayment = q.Schedule[0].Payment
+	}
+	q.TotalInterest = yuan(interest)
+	q.TotalPayment = yuan(loan*100 + interest)
+
+	price := float64(req.Price)
+	for _, b := range r.DeedTax {
+		if b.Home == req.Home && (b.MaxArea == 0 || req.Area <= b.MaxArea) {
+			q.Fees = append(q.Fees, &PurchaseFee{Key: "deed_tax", Label: "契税", Amount: round2(price * b.Rate / 100)})
+			break
+		}
+	}
+	if req.YearsHeld < r.VATExemptYears {
+		// 成交价含5%增值税，按不含税价计征
+		q.Fees = append(q.Fees, &PurchaseFee{Key: "vat", Label: "增值税及附加", Amount: round2(price / 1.05 * r.VATRate / 100)})
+	}
+	q.Fees = append(q.Fees, &PurchaseFee{Key: "agency_fee", Label: "中介费", Amount: round2(price * r.AgencyFeeRate / 100)})
+	for _, f := range q.Fees {
+		q.TotalFees += f.Amount
+	}
+	q.TotalFees = round2(q.TotalFees)
+	q.UpfrontCost = round2(float64(q.DownPayment) + q.TotalFees)
+	return q, nil
+}
+
+// amortRow is a month of a schedule in fen, so rounding never drifts: each
+// month's interest is rounded once and the last month clears the balance.
+type amortRow struct {
+	payment, principal, interest, balance int64
+}
+
+// amortize returns the monthly schedule of a loan in fen; a zero loan has
+// none.
+func amortize(loan int64, annualRate float64, months int, method int32) []amortRow {
+	if loan <= 0 {
+		return nil
+	}
+	r := annualRate / 100 / 12
+	balance := loan * 100
+	var installment int64
+	if method == RepayEqualInstallment {
+		if r == 0 {
+			installment = int64(math.Round(float64(balance) / float64(months)))
+		} else {
+			f := math.Pow(1+r, float64(months))
+			installment = int64(math.Round(float64(balance) * r * f / (f - 1)))
+		}
+	}
+	principalPart := int64(math.Round(float64(balance) / float64(months)))
+	rows := make([]amortRow, months)
+	for i := range rows {
+		interest := int64(math.Round(float64(balance) * r))
+		principal := principalPart
+		if method == RepayEqualInstallment {
+			principal = installment - interest
+		}
+		if i == months-1 || principal > balance {
+			principal = balance
+		}
+		balance -= principal
+		rows[i] = amortRow{payment: principal + interest, principal: principal, interest: interest, balance: balance}
+	}
+	return rows
+}
+
+// mergeSchedules adds up the schedules of the loans of a combined loan,
+// month by month.
+func mergeSchedules(schedules ...[]amortRow) []amortRow {
+	var res []amortRow
+	for _, s := range schedules {
+		for i, row := range s {
+			if i == len(res) {
+				res = append(res, amortRow{})
+			}
+			res[i].payment += row.payment
+			res[i].principal += row.principal
+			res[i].interest += row.interest
+			res[i].balance += row.balance
+		}
+	}
+	return res
+}
+
+func yuan(fen int64) float64 { return float64(fen) / 100 }
+
+func round2(v float64) float64 { return math.Round(v*100) / 100 }
+
+func orDefault(v, def float64) float64 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,46 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// maxNotifications is the most notifications listed at a time.
+const maxNotifications = 50
+
+// Notification is a message in a user's in-app inbox.
+type Notification struct {
+	gorm.Model
+	UserID  uint   // 接收用户
+	Kind    string // 类型，如 price_drop
+	Title   string // 标题
+	Content string // 内容
+	BizType string // 关联业务类型
+	BizID   uint   // 关联业务ID
+}
+
+// NotificationRepo is a notification repo.
+type NotificationRepo interface {
+	CreateNotification(context.Context, *Notification) (*Notification, error)
+	// ListNotifications returns up to limit notifications of a user, newest
+	// first.
+	ListNotifications(ctx context.Context, userID uint, limit int) ([]*Notification, error)
+}
+
+// NotificationUsecase is a notification usecase.
+type NotificationUsecase struct {
+	repo NotificationRepo
+	log  *log.Helper
+}
+
+// NewNotificationUsecase new a Notification usecase.
+func NewNotificationUsecase(repo NotificationRepo, logger log.Logger) *NotificationUsecase {
+	return &NotificationUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// ListNotifications returns the latest notifications of a user, newest first.
+func (uc *NotificationUsecase) ListNotifications(ctx context.Context, userID uint) ([]*Notification, error) {
+	return uc.repo.ListNotifications(ctx, userID, maxNotifications)
+}
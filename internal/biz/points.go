@@ -1,14 +1,119 @@
 package biz
 
-import "github.com/go-kratos/kratos/v2/log"
+import (
+	"context"
+	"fmt"
 
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrPointsAmount is a non-positive points amount.
+	ErrPointsAmount = errors.BadRequest("POINTS_AMOUNT", "积分数量必须大于0")
+	// ErrPointsInsufficient is a redemption exceeding the balance.
+	ErrPointsInsufficient = errors.BadRequest("POINTS_INSUFFICIENT", "积分不足")
+)
+
+// DealRewardPoints is awarded to the buyer of a completed deal.
+const DealRewardPoints = 1000
+
+// PointsRecord is one change of a user's points balance.
+type PointsRecord struct {
+	gorm.Model
+	UserID  uint   // 用户
+	Amount  int64  // 变动积分，正数为获得，负数为消耗
+	Balance int64  // 变动后余额
+	Reason  string // 原因，如"签到"、"成交奖励"、"兑换"
+	BizType string // 关联业务类型
+	BizID   uint   // 关联业务ID
+}
+
+// PointsRepo is a points repo.
 type PointsRepo interface {
+	CreateRecord(context.Context, *PointsRecord) (*PointsRecord, error)
+	Balance(ctx context.Context, userID uint) (int64, error)
+	ListRecords(ctx context.Context, userID uint) ([]*PointsRecord, error)
 }
+
+// PointsUsecase is a points usecase.
 type PointsUsecase struct {
-	repo PointsRepo
-	log  *log.Helper
+	repo   PointsRepo
+	tx     Transaction
+	locker Locker
+	log    *log.Helper
+}
+
+// NewPointsUsecase new a Points usecase.
+func NewPointsUsecase(repo PointsRepo, tx Transaction, locker Locker, bus EventBus, logger log.Logger) *PointsUsecase {
+	uc := &PointsUsecase{repo: repo, tx: tx, locker: locker, log: log.NewHelper(logger)}
+	Subscribe(bus, "points", uc.onDealCompleted)
+	return uc
+}
+
+// Earn adds points to a user.
+func (uc *PointsUsecase) Earn(ctx context.Context, r *PointsRecord) (*PointsRecord, error) {
+	if r.Amount <= 0 {
+		return nil, ErrPointsAmount
+	}
+	return uc.change(ctx, r)
+}
+
+// Redeem spends points, failing when the balance is insufficient.
+func (uc *PointsUsecase) Redeem(ctx context.Context, r *PointsRecord) (*PointsRecord, error) {
+	if r.Amount <= 0 {
+		return nil, ErrPointsAmount
+	}
+	r.Amount = -r.Amount
+	return uc.change(ctx, r)
+}
+
+// Balance returns a user's current points.
+func (uc *PointsUsecase) Balance(ctx context.Context, userID uint) (int64, error) {
+	return uc.repo.Balance(ctx, userID)
+}
+
+// ListRecords returns a user's points history, newest first.
+func (uc *PointsUsecase) ListRecords(ctx context.Context, userID uint) ([]*PointsRecord, error) {
+	return uc.repo.ListRecords(ctx, userID)
+}
+
+// change serializes a user's balance changes with a lock, since the new
+// balance is computed from the current one.
+func (uc *PointsUsecase) change(ctx context.Context, r *PointsRecord) (*PointsRecord, error) {
+	uc.log.WithContext(ctx).Infof("PointsChange: user=%d amount=%d reason=%s", r.UserID, r.Amount, r.Reason)
+	err := WithLock(ctx, uc.locker, fmt.Sprintf("points:%d", r.UserID), func(ctx context.Context, l Lock) error {
+		return uc.tx.InTx(ctx, func(ctx context.Context) error {
+			if err := l.Fence(ctx); err != nil {
+				return err
+			}
+			balance, err := uc.repo.Balance(ctx, r.UserID)
+			if err != nil {
+				return err
+			}
+			if balance+r.Amount < 0 {
+				return ErrPointsInsufficient
+			}
+			r.Balance = balance + r.Amount
+			r, err = uc.repo.CreateRecord(ctx, r)
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
 }
 
-func NewPointsUsecase(repo PointsRepo, logger log.Logger) *PointsUsecase {
-	return &PointsUsecase{repo: repo, log: log.NewHelper(logger)}
+// onDealCompleted rewards the buyer of a completed deal.
+func (uc *PointsUsecase) onDealCompleted(ctx context.Context, e *DealCompletedEvent) error {
+	_, err := uc.Earn(ctx, &PointsRecord{
+		UserID:  e.BuyerID,
+		Amount:  DealRewardPoints,
+		Reason:  "成交奖励",
+		BizType: "deal",
+		BizID:   e.DealID,
+	})
+	return err
 }
@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrHouseNotOnSale is a change to a listing that is sold or offline.
+	ErrHouseNotOnSale = errors.Conflict("HOUSE_NOT_ON_SALE", "房源不在售")
+	// ErrPriceAlertInvalid is a drop threshold out of range.
+	ErrPriceAlertInvalid = errors.BadRequest("PRICE_ALERT_INVALID", "降价幅度须在1到99之间，0为取消提醒")
+)
+
+// NotifyPriceDrop is the notification kind of a price alert.
+const NotifyPriceDrop = "price_drop"
+
+// PriceChange is one change of a listing's price.
+type PriceChange struct {
+	gorm.Model
+	HouseID  uint  // 房源
+	OldPrice int64 // 调整前总价（元）
+	NewPrice int64 // 调整后总价（元）
+}
+
+// TopicHousePriceChanged is the topic of HousePriceChangedEvent.
+const TopicHousePriceChanged = "house.price_changed"
+
+// HousePriceChangedEvent is raised when a listing's price changes. Price
+// alerts subscribe to it to notify users of drops.
+type HousePriceChangedEvent struct {
+	HouseID  uint  `json:"house_id"`
+	OldPrice int64 `json:"old_price"`
+	NewPrice int64 `json:"new_price"`
+}
+
+func (*HousePriceChangedEvent) Topic() string { return TopicHousePriceChanged }
+
+func (e *HousePriceChangedEvent) Key() string { return strconv.FormatUint(uint64(e.HouseID), 10) }
+
+// PriceAlert asks for a notification when a listing's price drops by at
+// least DropPercent from BasePrice. BasePrice starts at the price when the
+// alert is set, follows increases and moves down to the new price after
+// each notification, so every alert reports a fresh drop.
+type PriceAlert struct {
+	gorm.Model
+	UserID      uint  // 用户
+	HouseID     uint  // 房源
+	DropPercent int32 // 降价幅度（%），1-99
+	BasePrice   int64 // 计算降幅的基准总价（元）
+}
+
+// PriceRepo stores price history and price alerts.
+type PriceRepo interface {
+	// CreatePriceChange stores c with a HousePriceChangedEvent in the outbox.
+	CreatePriceChange(context.Context, *PriceChange) (*PriceChange, error)
+	// ListPriceChanges returns the changes of a listing, oldest first.
+	ListPriceChanges(ctx context.Context, houseID uint) ([]*PriceChange, error)
+	// SavePriceAlert creates or replaces the alert of a user on a listing.
+	SavePriceAlert(context.Context, *PriceAlert) (*PriceAlert, error)
+	DeletePriceAlert(ctx context.Context, userID, houseID uint) error
+	ListPriceAlerts(ctx context.Context, houseID uint) ([]*PriceAlert, error)
+}
+
+// PriceUsecase serves price history and notifies users of price drops.
+type PriceUsecase struct {
+	repo          PriceRepo
+	houses        HouseRepo
+	notifications NotificationRepo
+	tx            Transaction
+	log           *log.Helper
+}
+
+// NewPriceUsecase new a Price usecase.
+func NewPriceUsecase(repo PriceRepo, houses HouseRepo, notifications NotificationRepo, tx Transaction, bus EventBus, logger log.Logger) *PriceUsecase {
+	uc := &PriceUsecase{repo: repo, houses: houses, notifications: notifications, tx: tx, log: log.NewHelper(logger)}
+	Subscribe(bus, "price_alert", uc.onPriceChanged)
+	return uc
+}
+
+// GetPriceHistory returns the price changes of a listing, oldest first.
+func (uc *PriceUsecase) GetPriceHistory(ctx context.Context, houseID uint) ([]*PriceChange, error) {
+	h, err := uc.houses.GetHouse(ctx, houseID)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, ErrHouseNotFound
+	}
+	return uc.repo.ListPriceChanges(ctx, houseID)
+}
+
+// SetPriceAlert sets the drop, in percent, that notifies a user about a
+// listing, measured from its current price; 0 removes the alert.
+func (uc *PriceUsecase) SetPriceAlert(ctx context.Context, userID, houseID uint, dropPercent int32) (*PriceAlert, error) {
+	if userID == 0 || dropPercent < 0 || dropPercent > 99 {
+		return nil, ErrPriceAlertInvalid
+	}
+	if dropPercent == 0 {
+		return nil, uc.repo.DeletePriceAlert(ctx, userID, houseID)
+	}
+	h, err := uc.houses.GetHouse(ctx, houseID)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, ErrHouseNotFound
+	}
+	return uc.repo.SavePriceAlert(ctx, &PriceAlert{UserID: userID, HouseID: houseID, DropPercent: dropPercent, BasePrice: h.Price})
+}
+
+// onPriceChanged checks the alerts of the listing. An alert that fired has
+// its base moved to the new price in the same transaction as the
+// notification, so a redelivered event does not notify twice.
+func (uc *PriceUsecase) onPriceChanged(ctx context.Context, e *HousePriceChangedEvent) error {
+	alerts, err := uc.repo.ListPriceAlerts(ctx, e.HouseID)
+	if err != nil || len(alerts) == 0 {
+		return err
+	}
+	h, err := uc.houses.GetHouse(ctx, e.HouseID)
+	if err != nil {
+		return err
+	}
+	if h == nil {
+		uc.log.WithContext(ctx).Warnf("price change: house %d not found", e.HouseID)
+		return nil
+	}
+	for _, a := range alerts {
+		if e.NewPrice > a.BasePrice {
+			a.BasePrice = e.NewPrice
+			if _, err := uc.repo.SavePriceAlert(ctx, a); err != nil {
+				return err
+			}
+			continue
+		}
+		drop := float64(a.BasePrice-e.NewPrice) * 100 / float64(a.BasePrice)
+		if drop < float64(a.DropPercent) {
+			continue
+		}
+		n := &Notification{
+			UserID:  a.UserID,
+			Kind:    NotifyPriceDrop,
+			Title:   "关注的房源降价了",
+			Content: fmt.Sprintf("%s 从%d元降到%d元，降幅%.1f%%", h.Title, a.BasePrice, e.NewPrice, drop),
+			BizType: "house",
+			BizID:   h.ID,
+		}
+		a.BasePrice = e.NewPrice
+		err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+			if _, err := uc.notifications.CreateNotification(ctx, n); err != nil {
+				return err
+			}
+			_, err := uc.repo.SavePriceAlert(ctx, a)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrRegionNotFound is region not found.
+var ErrRegionNotFound = errors.NotFound("REGION_NOT_FOUND", "区域不存在")
+
+// 区域层级
+const (
+	RegionProvince     int32 = iota + 1 // 省
+	RegionCity                          // 市
+	RegionDistrict                      // 区县
+	RegionBusinessArea                  // 商圈
+)
+
+// regionTreeTTL is how long an instance serves its cached tree before
+// reloading it, so a reload on one instance reaches the others.
+const regionTreeTTL = 10 * time.Minute
+
+// Region is a node of the administrative region tree. Provinces, cities and
+// districts use their GB/T 2260 code as ID.
+type Region struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement:false"`
+	ParentID  uint   // 上级，省为0
+	Level     int32  // 1省 2市 3区县 4商圈
+	Name      string // 全称，如"浦东新区"
+	ShortName string // 简称，如"浦东"，房源等记录上保存的是简称
+}
+
+// Station is a subway station.
+type Station struct {
+	Name      string   // 站名，如"陆家嘴"
+	Lines     []string // 线路，如"2号线"
+	RegionID  uint     // 所在区县
+	Longitude float64  // 经度
+	Latitude  float64  // 纬度
+}
+
+// RegionRepo is a region repo.
+type RegionRepo interface {
+	ListRegions(context.Context) ([]*Region, error)
+	// SeedRegions imports the bundled dataset, keeping existing regions, and
+	// returns how many regions were added.
+	SeedRegions(context.Context) (int, error)
+	ListStations(context.Context) ([]*Station, error)
+}
+
+// Place is a location given by region ID, by names, or both.
+type Place struct {
+	RegionID     uint
+	City         string
+	District     string
+	BusinessArea string
+}
+
+type regionTree struct {
+	byID     map[uint]*Region
+	children map[uint][]*Region
+	loadedAt time.Time
+}
+
+// RegionUsecase is a region usecase. The whole tree is cached in memory.
+type RegionUsecase struct {
+	repo RegionRepo
+	log  *log.Helper
+
+	mu   sync.Mutex
+	tree *regionTree
+	// seeded is set once the bundled dataset has been imported by this
+	// process, so regions added to the CSV reach existing databases.
+	seeded bool
+}
+
+// NewRegionUsecase new a Region usecase.
+func NewRegionUsecase(repo RegionRepo, logger log.Logger) *RegionUsecase {
+	return &RegionUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// Children returns the children of a region; parentID 0 returns the provinces.
+func (uc *RegionUsecase) Children(ctx context.Context, parentID uint) ([]*Region, error) {
+	t, err := uc.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if parentID != 0 && t.byID[parentID] == nil {
+		return nil, ErrRegionNotFound
+	}
+	return t.children[parentID], nil
+}
+
+// Path returns the regions from the province down to id.
+func (uc *RegionUsecase) Path(ctx context.Context, id uint) ([]*Region, error) {
+	t, err := uc.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return t.path(id)
+}
+
+// Regions returns every region of the tree, parents before children.
+func (uc *RegionUsecase) Regions(ctx context.Context) ([]*Region, error) {
+	t, err := uc.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var list []*Region
+	var walk func(parentID uint)
+	walk = func(parentID uint) {
+		for _, r := range t.children[parentID] {
+			list = append(list, r)
+			walk(r.ID)
+		}
+	}
+	walk(0)
+	return list, nil
+}
+
+// Stations returns the subway stations.
+func (uc *RegionUsecase) Stations(ctx context.Context) ([]*Station, error) {
+	return uc.repo.ListStations(ctx)
+}
+
+// Reload imports regions added to the bundled dataset, rebuilds the cached
+// tree from the repo and returns its size.
+func (uc *RegionUsecase) Reload(ctx context.Context) (int, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.seeded = false
+	t, err := uc.build(ctx)
+	if err != nil {
+		return 0, err
+	}
+	uc.tree = t
+	return len(t.byID), nil
+}
+
+// Locate fills in a place: the names from the region when RegionID is set,
+// otherwise the most specific region matching the names, whose canonical
+// short names replace the matched ones. Names outside the region tree are
+// kept as given.
+func (uc *RegionUsecase) Locate(ctx context.Context, p *Place) error {
+	t, err := uc.load(ctx)
+	if err != nil {
+		return err
+	}
+	if p.RegionID == 0 {
+		if p.RegionID = t.match(p); p.RegionID == 0 {
+			return nil
+		}
+	} else {
+		p.City, p.District, p.BusinessArea = "", "", ""
+	}
+	path, err := t.path(p.RegionID)
+	if err != nil {
+		return err
+	}
+	for _, r := range path {
+		switch r.Level {
+		case RegionCity:
+			p.City = r.ShortName
+		case RegionDistrict:
+			p.District = r.ShortName
+		case RegionBusinessArea:
+			p.BusinessArea = r.ShortName
+		}
+	}
+	return nil
+}
+
+// load returns the cached tree, building it on first use and after
+// regionTreeTTL. A failed refresh keeps serving the old tree.
+func (uc *RegionUsecase) load(ctx context.Context) (*regionTree, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if uc.tree != nil && time.Since(uc.tree.loadedAt) < regionTreeTTL {
+		return uc.tree, nil
+	}
+	t, err := uc.build(ctx)
+	if err != nil {
+		if uc.tree != nil {
+			uc.log.WithContext(ctx).Errorf("reload regions: %v", err)
+			return uc.tree, nil
+		}
+		return nil, err
+	}
+	uc.tree = t
+	return t, nil
+}
+
+func (uc *RegionUsecase) build(ctx context.Context) (*regionTree, error) {
+	// 每次启动和手动刷新时导入内置数据，已有的区域不变，CSV 新增的区域补进已有的库
+	if !uc.seeded {
+		n, err := uc.repo.SeedRegions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			uc.log.WithContext(ctx).Infof("seeded %d regions", n)
+		}
+		uc.seeded = true
+	}
+	list, err := uc.repo.ListRegions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t := &regionTree{byID: map[uint]*Region{}, children: map[uint][]*Region{}, loadedAt: time.Now()}
+	for _, r := range list {
+		t.byID[r.ID] = r
+		t.children[r.ParentID] = append(t.children[r.ParentID], r)
+	}
+	return t, nil
+}
+
+func (t *regionTree) path(id uint) ([]*Region, error) {
+	var path []*Region
+	for r := t.byID[id]; r != nil; r = t.byID[r.ParentID] {
+		path = append([]*Region{r}, path...)
+	}
+	if len(path) == 0 {
+		return nil, ErrRegionNotFound
+	}
+	return path, nil
+}
+
+// match finds the city by name, then the district and business area within
+// it; it returns 0 when the city is unknown.
+func (t *regionTree) match(p *Place) uint {
+	if p.City == "" {
+		return 0
+	}
+	var id uint
+	for _, province := range t.children[0] {
+		if id = matchRegion(t.children[province.ID], p.City); id != 0 {
+			break
+		}
+	}
+	if id == 0 || p.District == "" {
+		return id
+	}
+	district := matchRegion(t.children[id], p.District)
+	if district == 0 {
+		return id
+	}
+	if area := matchRegion(t.children[district], p.BusinessArea); area != 0 {
+		return area
+	}
+	return district
+}
+
+func matchRegion(list []*Region, name string) uint {
+	if name == "" {
+		return 0
+	}
+	for _, r := range list {
+		if r.Name == name || r.ShortName == name {
+			return r.ID
+		}
+	}
+	return 0
+}
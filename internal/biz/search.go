@@ -0,0 +1,260 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 50
+	// rebuildBatch is the number of listings read and indexed at a time.
+	rebuildBatch = 500
+	// searchAttempts bounds how often a search is repeated after dropping
+	// stale hits from the index.
+	searchAttempts = 3
+)
+
+// TopicHouseChanged is the topic of HouseChangedEvent.
+const TopicHouseChanged = "house.changed"
+
+// HouseChangedEvent is raised whenever a listing is created or updated. The
+// search index subscribes to it and re-reads the listing, so the event only
+// carries the ID.
+type HouseChangedEvent struct {
+	HouseID uint `json:"house_id"`
+}
+
+func (*HouseChangedEvent) Topic() string { return TopicHouseChanged }
+
+func (e *HouseChangedEvent) Key() string { return strconv.FormatUint(uint64(e.HouseID), 10) }
+
+// HouseDocument is a listing as indexed for search.
+type HouseDocument struct {
+	*House
+	// RegionIDs is the region path of the listing, from the province down,
+	// so a query can filter on a region at any level.
+	RegionIDs []uint
+}
+
+// HouseQuery is a full-text listing search. Zero values leave a filter out.
+type HouseQuery struct {
+	Keyword     string // 关键词，匹配标题、描述、小区名称和标签
+	RegionID    uint   // 区域，任意层级
+	ListingType *int32 // 房源类型，为空时不限
+	Rooms       int32  // 室
+	MinPrice    int64  // 总价下限（元）
+	MaxPrice    int64  // 总价上限（元）
+	Page        int    // 从1开始
+	PageSize    int
+}
+
+// HouseHit is a matched listing ID with the highlighted fragments of the
+// fields that matched, keyed by field: title, description or community.
+type HouseHit struct {
+	ID         uint
+	Score      float64
+	Highlights map[string]string
+}
+
+// HouseSearchResult is a page of hits, best first.
+type HouseSearchResult struct {
+	Total uint64
+	Hits  []*HouseHit
+}
+
+// HouseSearcher is a full-text index of the listings on sale. The index is
+// derived data: it can always be rebuilt from the HouseRepo.
+type HouseSearcher interface {
+	// Consumer names the event consumer that keeps this index up to date. An
+	// index embedded in each instance needs a consumer per instance so every
+	// copy sees every change; a shared index uses a single one.
+	Consumer() string
+	// Index adds or replaces the documents.
+	Index(ctx context.Context, docs ...*HouseDocument) error
+	// Delete removes listings; unknown IDs are ignored.
+	Delete(ctx context.Context, ids ...uint) error
+	// Search returns the matching listings on sale.
+	Search(ctx context.Context, q *HouseQuery) (*HouseSearchResult, error)
+}
+
+// SearchResult is a page of listings with their highlights.
+type SearchResult struct {
+	Total  uint64
+	Houses []*House
+	Hits   []*HouseHit // 与 Houses 一一对应
+}
+
+// SearchUsecase keeps the listing index in step with the HouseRepo and
+// serves searches from it.
+type SearchUsecase struct {
+	houses   HouseRepo
+	regions  *RegionUsecase
+	searcher HouseSearcher
+	keywords KeywordRepo
+	bus      EventBus
+	log      *log.Helper
+}
+
+// NewSearchUsecase new a Search usecase.
+func NewSearchUsecase(houses HouseRepo, regions *RegionUsecase, searcher HouseSearcher, keywords KeywordRepo, bus EventBus, logger log.Logger) *SearchUsecase {
+	uc := &SearchUsecase{houses: houses, regions: regions, searcher: searcher, keywords: keywords, bus: bus, log: log.NewHelper(logger)}
+	Subscribe(bus, searcher.Consumer(), uc.onHouseChanged)
+	return uc
+}
+
+// SearchHouses runs a full-text search and loads the matched listings in one
+// query. A hit whose listing was sold or deleted after it was indexed, before
+// the change event reached the index, is removed from the index and the
+// search repeated, so pages stay full and Total counts listings on sale. The
+// keyword of a first page is counted towards the trending keywords.
+func (uc *SearchUsecase) SearchHouses(ctx context.Context, q *HouseQuery) (*SearchResult, error) {
+	q.Keyword = strings.TrimSpace(q.Keyword)
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.PageSize <= 0 {
+		q.PageSize = defaultSearchPageSize
+	}
+	if q.PageSize > maxSearchPageSize {
+		q.PageSize = maxSearchPageSize
+	}
+	var res *SearchResult
+	for attempt := 1; ; attempt++ {
+		found, err := uc.searcher.Search(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		var stale []uint
+		if res, stale, err = uc.load(ctx, found); err != nil {
+			return nil, err
+		}
+		if len(stale) == 0 || attempt == searchAttempts {
+			break
+		}
+		if err := uc.searcher.Delete(ctx, stale...); err != nil {
+			return nil, err
+		}
+	}
+	if q.Page == 1 {
+		if err := recordKeyword(ctx, uc.keywords, q.Keyword); err != nil {
+			uc.log.WithContext(ctx).Warnf("record keyword %q: %v", q.Keyword, err)
+		}
+	}
+	return res, nil
+}
+
+// load reads the listings of the hits in their order and returns the IDs of
+// hits that are no longer on sale.
+func (uc *SearchUsecase) load(ctx context.Context, found *HouseSearchResult) (*SearchResult, []uint, error) {
+	ids := make([]uint, 0, len(found.Hits))
+	for _, hit := range found.Hits {
+		ids = append(ids, hit.ID)
+	}
+	list, err := uc.houses.GetHouses(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[uint]*House, len(list))
+	for _, h := range list {
+		byID[h.ID] = h
+	}
+	res := &SearchResult{Total: found.Total}
+	var stale []uint
+	for _, hit := range found.Hits {
+		h := byID[hit.ID]
+		if h == nil || h.Status != HouseOnSale {
+			stale = append(stale, hit.ID)
+			res.Total--
+			continue
+		}
+		res.Houses = append(res.Houses, h)
+		res.Hits = append(res.Hits, hit)
+	}
+	return res, stale, nil
+}
+
+// Rebuild indexes every listing on sale and returns how many were indexed,
+// along with the event position the index is current as of; pass it to
+// Resume once the index is in use. It only adds and replaces documents, so
+// it is meant for an empty index.
+func (uc *SearchUsecase) Rebuild(ctx context.Context) (int, string, error) {
+	// 先记下位置再读库，期间的变更事件会重放一遍，重放是幂等的
+	pos, err := uc.bus.Position(ctx, TopicHouseChanged)
+	if err != nil {
+		return 0, "", err
+	}
+	var n int
+	var after uint
+	for {
+		list, err := uc.houses.ListHouses(ctx, after, rebuildBatch)
+		if err != nil {
+			return n, "", err
+		}
+		if len(list) == 0 {
+			return n, pos, nil
+		}
+		docs := make([]*HouseDocument, 0, len(list))
+		for _, h := range list {
+			after = h.ID
+			if h.Status != HouseOnSale {
+				continue
+			}
+			doc, err := uc.document(ctx, h)
+			if err != nil {
+				return n, "", err
+			}
+			docs = append(docs, doc)
+		}
+		if err := uc.searcher.Index(ctx, docs...); err != nil {
+			return n, "", err
+		}
+		n += len(docs)
+		uc.log.WithContext(ctx).Infof("indexed %d houses, up to id %d", n, after)
+	}
+}
+
+// Resume makes the index's consumer skip the listing changes up to pos,
+// which a rebuilt index already contains, instead of replaying the stream.
+func (uc *SearchUsecase) Resume(ctx context.Context, pos string) error {
+	return uc.bus.Seek(ctx, TopicHouseChanged, uc.searcher.Consumer(), pos)
+}
+
+// onHouseChanged re-reads the listing, so redelivered or out-of-order events
+// still leave the index matching the database.
+func (uc *SearchUsecase) onHouseChanged(ctx context.Context, e *HouseChangedEvent) error {
+	h, err := uc.houses.GetHouse(ctx, e.HouseID)
+	if err != nil {
+		return err
+	}
+	if h == nil || h.Status != HouseOnSale {
+		return uc.searcher.Delete(ctx, e.HouseID)
+	}
+	doc, err := uc.document(ctx, h)
+	if err != nil {
+		return err
+	}
+	return uc.searcher.Index(ctx, doc)
+}
+
+func (uc *SearchUsecase) document(ctx context.Context, h *House) (*HouseDocument, error) {
+	doc := &HouseDocument{House: h}
+	if h.RegionID == 0 {
+		return doc, nil
+	}
+	path, err := uc.regions.Path(ctx, h.RegionID)
+	if errors.Is(err, ErrRegionNotFound) {
+		return doc, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range path {
+		doc.RegionIDs = append(doc.RegionIDs, r.ID)
+	}
+	return doc, nil
+}
@@ -0,0 +1,303 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrStatsInvalid is a statistics query without a location or with a
+	// malformed month.
+	ErrStatsInvalid = errors.BadRequest("STATS_INVALID", "请指定小区或城市和区县，月份格式为 2006-01")
+	// ErrStatsRunning is a refresh while another instance is aggregating.
+	ErrStatsRunning = errors.Conflict("STATS_RUNNING", "行情统计正在进行，请稍后再试")
+)
+
+// StatsMonthLayout is the format of MarketStats.Month.
+const StatsMonthLayout = "2006-01"
+
+const (
+	defaultTrendMonths = 12
+	maxTrendMonths     = 36
+	statsScanBatch     = 500
+	statsLockName      = "market_stats"
+)
+
+// MarketStats is the monthly rollup of a community or a district, written
+// by StatsUsecase.Aggregate.
+type MarketStats struct {
+	gorm.Model
+	Scope               string  // community 或 district
+	CommunityID         uint    // 小区，district 统计为0
+	City                string  // 城市
+	District            string  // 区县
+	Month               string  // 月份，如 2025-06
+	ListingCount        int64   // 月末（当月为统计时）在售挂牌数
+	NewListings         int64   // 当月新增挂牌数
+	AvgListingUnitPrice int64   // 在售挂牌均价（元/㎡）
+	DealCount           int64   // 当月成交数
+	AvgDealUnitPrice    int64   // 当月成交均价（元/㎡）
+	AvgDaysOnMarket     float64 // 当月成交房源的平均成交周期（天）
+	// ListingPriceChange and DealPriceChange are the month-over-month changes
+	// of the average prices in percent, 0 when either month has none.
+	ListingPriceChange float64 `gorm:"-"`
+	DealPriceChange    float64 `gorm:"-"`
+}
+
+// StatsRepo stores the monthly rollups.
+type StatsRepo interface {
+	// ReplaceMonth replaces every rollup of a month with rows.
+	ReplaceMonth(ctx context.Context, month string, rows []*MarketStats) error
+	// ListStats returns the rollups of a community, or of a district when
+	// communityID is 0, for the months from and to inclusive, oldest first.
+	ListStats(ctx context.Context, communityID uint, city, district, from, to string) ([]*MarketStats, error)
+}
+
+// StatsUsecase aggregates listings and sales into monthly market statistics
+// and serves them.
+type StatsUsecase struct {
+	repo        StatsRepo
+	houses      HouseRepo
+	sales       ValuationRepo
+	communities CommunityRepo
+	tx          Transaction
+	locker      Locker
+	log         *log.Helper
+}
+
+// NewStatsUsecase new a Stats usecase.
+func NewStatsUsecase(repo StatsRepo, houses HouseRepo, sales ValuationRepo, communities CommunityRepo, tx Transaction, locker Locker, logger log.Logger) *StatsUsecase {
+	return &StatsUsecase{repo: repo, houses: houses, sales: sales, communities: communities, tx: tx, locker: locker, log: log.NewHelper(logger)}
+}
+
+// Aggregate recomputes the rollups of the month containing t from the
+// listings and the recorded sales, replacing the month's rows. Listings are
+// counted at their current price; a month already over counts what was on
+// sale at its end. Only one instance aggregates at a time; the others get
+// ErrStatsRunning.
+func (uc *StatsUsecase) Aggregate(ctx context.Context, t time.Time) error {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.Local)
+	month := start.Format(StatsMonthLayout)
+	ok, err := TryWithLock(ctx, uc.locker, statsLockName, func(ctx context.Context, l Lock) error {
+		rows, err := uc.rollup(ctx, start)
+		if err != nil {
+			return err
+		}
+		return uc.tx.InTx(ctx, func(ctx context.Context) error {
+			if err := l.Fence(ctx); err != nil {
+				return err
+			}
+			return uc.repo.ReplaceMonth(ctx, month, rows)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrStatsRunning
+	}
+	uc.log.WithContext(ctx).Infof("market stats of %s aggregated", month)
+	return nil
+}
+
+// statsAcc accumulates the rollup of one community or district.
+type statsAcc struct {
+	row                 *MarketStats
+	listingSum, dealSum int64
+	daysSum             float64
+	daysCount           int64
+}
+
+// rollup scans every listing and the sales since start into the rollups of
+// the month starting at start.
+func (uc *StatsUsecase) rollup(ctx context.Context, start time.Time) ([]*MarketStats, error) {
+	end := start.AddDate(0, 1, 0)
+	now := time.Now()
+	snapshot := end
+	if now.Before(end) {
+		snapshot = now
+	}
+	month := start.Format(StatsMonthLayout)
+
+	// 包括之后的成交，用于判断月末时已售房源是否仍在售
+	sales, err := uc.sales.ListSalesBetween(ctx, start, now)
+	if err != nil {
+		return nil, err
+	}
+	soldAt := map[uint]time.Time{}
+	for _, s := range sales {
+		soldAt[s.HouseID] = s.SoldAt
+	}
+
+	accs := map[string]*statsAcc{}
+	get := func(key string, row *MarketStats) *statsAcc {
+		if accs[key] == nil {
+			row.Month = month
+			accs[key] = &statsAcc{row: row}
+		}
+		return accs[key]
+	}
+	// 每套房源或成交同时计入所在区县和小区
+	scopes := func(communityID uint, city, district string) []*statsAcc {
+		res := []*statsAcc{get(ScopeDistrict+"|"+city+"|"+district, &MarketStats{Scope: ScopeDistrict, City: city, District: district})}
+		if communityID != 0 {
+			res = append(res, get(ScopeCommunity+"|"+strconv.FormatUint(uint64(communityID), 10),
+				&MarketStats{Scope: ScopeCommunity, CommunityID: communityID, City: city, District: district}))
+		}
+		return res
+	}
+
+	listedAt := map[uint]time.Time{}
+	for afterID := uint(0); ; {
+		list, err := uc.houses.ListHouses(ctx, afterID, statsScanBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range list {
+			listedAt[h.ID] = h.CreatedAt
+			if h.ListingType != ListingSale || h.City == "" || !h.CreatedAt.Before(snapshot) {
+				continue
+			}
+			sold, ok := soldAt[h.ID]
+			onSale := h.Status == HouseOnSale || h.Status == HouseSold && ok && !sold.Before(snapshot)
+			for _, a := range scopes(h.CommunityID, h.City, h.District) {
+				if !h.CreatedAt.Before(start) {
+					a.row.NewListings++
+				}
+				if onSale {
+					a.row.ListingCount++
+					a.listingSum += h.UnitPrice
+				}
+			}
+		}
+		if len(list) < statsScanBatch {
+			break
+		}
+		afterID = list[len(list)-1].ID
+	}
+
+	for _, s := range sales {
+		if !s.SoldAt.Before(end) || s.City == "" {
+			continue
+		}
+		for _, a := range scopes(s.CommunityID, s.City, s.District) {
+			a.row.DealCount++
+			a.dealSum += s.UnitPrice
+			if at, ok := listedAt[s.HouseID]; ok && s.SoldAt.After(at) {
+				a.daysSum += s.SoldAt.Sub(at).Hours() / 24
+				a.daysCount++
+			}
+		}
+	}
+
+	rows := make([]*MarketStats, 0, len(accs))
+	for _, a := range accs {
+		if a.row.ListingCount > 0 {
+			a.row.AvgListingUnitPrice = a.listingSum / a.row.ListingCount
+		}
+		if a.row.DealCount > 0 {
+			a.row.AvgDealUnitPrice = a.dealSum / a.row.DealCount
+		}
+		if a.daysCount > 0 {
+			a.row.AvgDaysOnMarket = math.Round(a.daysSum/float64(a.daysCount)*10) / 10
+		}
+		rows = append(rows, a.row)
+	}
+	return rows, nil
+}
+
+// RefreshMonth aggregates a month given as 2006-01, the current month when
+// empty.
+func (uc *StatsUsecase) RefreshMonth(ctx context.Context, month string) error {
+	t := time.Now()
+	if month != "" {
+		var err error
+		if t, err = time.ParseInLocation(StatsMonthLayout, month, time.Local); err != nil {
+			return ErrStatsInvalid
+		}
+	}
+	return uc.Aggregate(ctx, t)
+}
+
+// GetMarketStats returns the current month of a community, or of a district
+// when communityID is 0, with its changes from the last month. A month not
+// aggregated yet is all zeros.
+func (uc *StatsUsecase) GetMarketStats(ctx context.Context, communityID uint, city, district string) (*MarketStats, error) {
+	series, err := uc.series(ctx, communityID, city, district, 1)
+	if err != nil {
+		return nil, err
+	}
+	return series[0], nil
+}
+
+// GetPriceTrend returns the last months of a community or district, oldest
+// first, one entry per month for charts: 12 months by default, at most 36.
+func (uc *StatsUsecase) GetPriceTrend(ctx context.Context, communityID uint, city, district string, months int) ([]*MarketStats, error) {
+	if months <= 0 {
+		months = defaultTrendMonths
+	}
+	if months > maxTrendMonths {
+		months = maxTrendMonths
+	}
+	return uc.series(ctx, communityID, city, district, months)
+}
+
+// series returns the last n months ending with the current one, reading one
+// month more for the first month's changes.
+func (uc *StatsUsecase) series(ctx context.Context, communityID uint, city, district string, n int) ([]*MarketStats, error) {
+	scope := ScopeDistrict
+	if communityID != 0 {
+		c, err := uc.communities.GetCommunity(ctx, communityID)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			return nil, ErrCommunityNotFound
+		}
+		scope, city, district = ScopeCommunity, c.City, c.District
+	} else if city == "" || district == "" {
+		return nil, ErrStatsInvalid
+	}
+	now := time.Now()
+	cur := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	first := cur.AddDate(0, -n, 0)
+	list, err := uc.repo.ListStats(ctx, communityID, city, district, first.Format(StatsMonthLayout), cur.Format(StatsMonthLayout))
+	if err != nil {
+		return nil, err
+	}
+	byMonth := map[string]*MarketStats{}
+	for _, s := range list {
+		byMonth[s.Month] = s
+	}
+	res := make([]*MarketStats, 0, n)
+	prev := byMonth[first.Format(StatsMonthLayout)]
+	for i := 1; i <= n; i++ {
+		month := first.AddDate(0, i, 0).Format(StatsMonthLayout)
+		s := byMonth[month]
+		if s == nil {
+			s = &MarketStats{Scope: scope, CommunityID: communityID, City: city, District: district, Month: month}
+		}
+		if prev != nil {
+			s.ListingPriceChange = priceChange(prev.AvgListingUnitPrice, s.AvgListingUnitPrice)
+			s.DealPriceChange = priceChange(prev.AvgDealUnitPrice, s.AvgDealUnitPrice)
+		}
+		res = append(res, s)
+		prev = s
+	}
+	return res, nil
+}
+
+// priceChange returns the change from prev to cur in percent, rounded to
+// two decimals, or 0 when either is missing.
+func priceChange(prev, cur int64) float64 {
+	if prev == 0 || cur == 0 {
+		return 0
+	}
+	return round2(float64(cur-prev) / float64(prev) * 100)
+}
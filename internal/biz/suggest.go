@@ -0,0 +1,348 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/mozillazg/go-pinyin"
+	"golang.org/x/sync/singleflight"
+)
+
+// 联想词类型
+const (
+	SuggestCommunity    = "community"     // 小区
+	SuggestDistrict     = "district"      // 区县
+	SuggestBusinessArea = "business_area" // 商圈
+	SuggestStation      = "station"       // 地铁站
+	SuggestKeyword      = "keyword"       // 热搜词
+)
+
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 20
+	// suggestIndexTTL is how long an instance serves its prefix index before
+	// rebuilding it, so new communities show up without a restart.
+	suggestIndexTTL = 10 * time.Minute
+	// suggestCandidates caps the matches ranked per request; short prefixes
+	// such as a single letter match far more entries than are shown.
+	suggestCandidates = 200
+	// maxKeywordLength is the longest keyword, in characters, that is
+	// counted; longer input is rarely repeated verbatim.
+	maxKeywordLength = 20
+)
+
+// Suggestion is an autocomplete entry for the search box.
+type Suggestion struct {
+	Kind     string // community、district、business_area、station 或 keyword
+	Text     string // 展示文字，如"万科城市花园"
+	ID       uint   // 小区ID或区域ID，地铁站和热搜词为0
+	RegionID uint   // 所在区域
+	City     string // 城市简称
+	Hint     string // 补充说明：小区和商圈为区县，区县为城市，地铁站为线路
+}
+
+// KeywordCount is a search keyword with its number of searches.
+type KeywordCount struct {
+	Keyword string
+	Count   int64
+}
+
+// KeywordRepo counts search keywords over a sliding window, so trending
+// keywords fade out on their own.
+type KeywordRepo interface {
+	RecordKeyword(ctx context.Context, keyword string) error
+	// HotKeywords returns the most searched keywords of the window, most
+	// searched first.
+	HotKeywords(ctx context.Context, limit int) ([]*KeywordCount, error)
+	// KeywordCounts returns the window counts of keywords; keywords never
+	// searched are left out.
+	KeywordCounts(ctx context.Context, keywords []string) (map[string]int64, error)
+}
+
+// suggestKinds orders the kinds when popularity ties: broad areas first.
+var suggestKinds = map[string]int{SuggestDistrict: 0, SuggestBusinessArea: 1, SuggestStation: 2, SuggestCommunity: 3}
+
+// suggestKey is one spelling of an entry: its name, an alias, or their full
+// or initial-letter pinyin.
+type suggestKey struct {
+	key string
+	s   *Suggestion
+}
+
+type suggestIndex struct {
+	keys    []suggestKey // 按 key 排序
+	builtAt time.Time
+}
+
+// SuggestUsecase serves search box suggestions from an in-memory prefix
+// index over communities, districts, business areas and subway stations,
+// ranked by how often each was searched recently.
+type SuggestUsecase struct {
+	communities CommunityRepo
+	regions     *RegionUsecase
+	keywords    KeywordRepo
+	log         *log.Helper
+
+	index atomic.Pointer[suggestIndex]
+	// group shares the first build among concurrent requests; refreshing
+	// keeps later rebuilds to one background goroutine at a time.
+	group      singleflight.Group
+	refreshing atomic.Bool
+}
+
+// NewSuggestUsecase new a Suggest usecase.
+func NewSuggestUsecase(communities CommunityRepo, regions *RegionUsecase, keywords KeywordRepo, logger log.Logger) *SuggestUsecase {
+	return &SuggestUsecase{communities: communities, regions: regions, keywords: keywords, log: log.NewHelper(logger)}
+}
+
+// Suggest returns the entries whose name, pinyin or pinyin initials start
+// with prefix, such as 万科, wanke or wk, optionally within a city. Trending
+// keywords with the prefix fill the remaining places; an empty prefix
+// returns the trending keywords alone.
+func (uc *SuggestUsecase) Suggest(ctx context.Context, prefix, city string, limit int) ([]*Suggestion, error) {
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+	prefix = suggestNormalize(prefix)
+	var res []*Suggestion
+	if prefix != "" {
+		idx, err := uc.load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res = uc.rank(ctx, idx.lookup(prefix, city))
+		if len(res) > limit {
+			res = res[:limit]
+		}
+	}
+	if len(res) < limit {
+		res = append(res, uc.hotKeywords(ctx, prefix, limit-len(res), res)...)
+	}
+	return res, nil
+}
+
+// rank orders matches by recent searches of their text, then kind, then
+// shorter text. Counting failures only cost the popularity order.
+func (uc *SuggestUsecase) rank(ctx context.Context, list []*Suggestion) []*Suggestion {
+	texts := make([]string, 0, len(list))
+	for _, s := range list {
+		texts = append(texts, suggestNormalize(s.Text))
+	}
+	counts, err := uc.keywords.KeywordCounts(ctx, texts)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("keyword counts: %v", err)
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if ca, cb := counts[suggestNormalize(a.Text)], counts[suggestNormalize(b.Text)]; ca != cb {
+			return ca > cb
+		}
+		if ka, kb := suggestKinds[a.Kind], suggestKinds[b.Kind]; ka != kb {
+			return ka < kb
+		}
+		if la, lb := utf8.RuneCountInString(a.Text), utf8.RuneCountInString(b.Text); la != lb {
+			return la < lb
+		}
+		return a.Text < b.Text
+	})
+	return list
+}
+
+// hotKeywords returns up to n trending keywords starting with prefix that
+// are not among shown yet.
+func (uc *SuggestUsecase) hotKeywords(ctx context.Context, prefix string, n int, shown []*Suggestion) []*Suggestion {
+	hot, err := uc.keywords.HotKeywords(ctx, maxSuggestLimit*5)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("hot keywords: %v", err)
+		return nil
+	}
+	seen := map[string]bool{}
+	for _, s := range shown {
+		seen[suggestNormalize(s.Text)] = true
+	}
+	var res []*Suggestion
+	for _, k := range hot {
+		if len(res) == n {
+			break
+		}
+		if !seen[k.Keyword] && strings.HasPrefix(suggestNormalize(k.Keyword), prefix) {
+			res = append(res, &Suggestion{Kind: SuggestKeyword, Text: k.Keyword})
+		}
+	}
+	return res
+}
+
+// load returns the prefix index. The first request builds it; once it is
+// older than suggestIndexTTL it is rebuilt in the background while requests
+// keep using the old one, which also stays in place if the rebuild fails.
+func (uc *SuggestUsecase) load(ctx context.Context) (*suggestIndex, error) {
+	if idx := uc.index.Load(); idx != nil {
+		if time.Since(idx.builtAt) >= suggestIndexTTL && uc.refreshing.CompareAndSwap(false, true) {
+			go uc.refresh(context.WithoutCancel(ctx))
+		}
+		return idx, nil
+	}
+	v, err, _ := uc.group.Do("", func() (interface{}, error) {
+		if idx := uc.index.Load(); idx != nil {
+			return idx, nil
+		}
+		idx, err := uc.buildIndex(ctx)
+		if err != nil {
+			return nil, err
+		}
+		uc.index.Store(idx)
+		return idx, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*suggestIndex), nil
+}
+
+// refresh rebuilds the index in the background.
+func (uc *SuggestUsecase) refresh(ctx context.Context) {
+	defer uc.refreshing.Store(false)
+	idx, err := uc.buildIndex(ctx)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("rebuild suggest index: %v", err)
+		return
+	}
+	uc.index.Store(idx)
+}
+
+func (uc *SuggestUsecase) buildIndex(ctx context.Context) (*suggestIndex, error) {
+	idx := &suggestIndex{builtAt: time.Now()}
+	regions, err := uc.regions.Regions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[uint]*Region{}
+	for _, r := range regions {
+		byID[r.ID] = r
+	}
+	// cityOf returns the short name of the city containing the region.
+	cityOf := func(id uint) string {
+		for r := byID[id]; r != nil; r = byID[r.ParentID] {
+			if r.Level == RegionCity {
+				return r.ShortName
+			}
+		}
+		return ""
+	}
+	for _, r := range regions {
+		switch r.Level {
+		case RegionDistrict:
+			idx.add(&Suggestion{Kind: SuggestDistrict, Text: r.ShortName, ID: r.ID, RegionID: r.ID, City: cityOf(r.ID), Hint: cityOf(r.ID)}, r.Name)
+		case RegionBusinessArea:
+			idx.add(&Suggestion{Kind: SuggestBusinessArea, Text: r.ShortName, ID: r.ID, RegionID: r.ID, City: cityOf(r.ID), Hint: byID[r.ParentID].ShortName}, r.Name)
+		}
+	}
+
+	stations, err := uc.regions.Stations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range stations {
+		idx.add(&Suggestion{Kind: SuggestStation, Text: st.Name, RegionID: st.RegionID, City: cityOf(st.RegionID), Hint: strings.Join(st.Lines, "/")})
+	}
+
+	var after uint
+	for {
+		list, err := uc.communities.ListCommunities(ctx, after, 500)
+		if err != nil {
+			return nil, err
+		}
+		if len(list) == 0 {
+			break
+		}
+		for _, c := range list {
+			after = c.ID
+			idx.add(&Suggestion{Kind: SuggestCommunity, Text: c.Name, ID: c.ID, RegionID: c.RegionID, City: c.City, Hint: c.District}, c.Aliases...)
+		}
+	}
+	sort.Slice(idx.keys, func(i, j int) bool { return idx.keys[i].key < idx.keys[j].key })
+	return idx, nil
+}
+
+// add indexes s under its text and the given aliases, each also by full and
+// initial-letter pinyin.
+func (idx *suggestIndex) add(s *Suggestion, aliases ...string) {
+	seen := map[string]bool{}
+	for _, name := range append([]string{s.Text}, aliases...) {
+		full, initials := pinyinOf(name)
+		for _, key := range []string{suggestNormalize(name), full, initials} {
+			if key != "" && !seen[key] {
+				seen[key] = true
+				idx.keys = append(idx.keys, suggestKey{key: key, s: s})
+			}
+		}
+	}
+}
+
+// lookup returns the distinct entries with a key starting with prefix.
+func (idx *suggestIndex) lookup(prefix, city string) []*Suggestion {
+	var res []*Suggestion
+	seen := map[*Suggestion]bool{}
+	i := sort.Search(len(idx.keys), func(i int) bool { return idx.keys[i].key >= prefix })
+	for ; i < len(idx.keys) && strings.HasPrefix(idx.keys[i].key, prefix) && len(res) < suggestCandidates; i++ {
+		s := idx.keys[i].s
+		if seen[s] || (city != "" && s.City != city) {
+			continue
+		}
+		seen[s] = true
+		// 返回副本，调用方可以随意修改
+		cp := *s
+		res = append(res, &cp)
+	}
+	return res
+}
+
+var pinyinArgs = func() pinyin.Args {
+	a := pinyin.NewArgs()
+	// 字母和数字原样保留，如"M50创意园"
+	a.Fallback = func(r rune, _ pinyin.Args) []string {
+		if r < utf8.RuneSelf && (r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return []string{strings.ToLower(string(r))}
+		}
+		return nil
+	}
+	return a
+}()
+
+// pinyinOf returns the toneless pinyin of s and its initial letters, e.g.
+// "wanke" and "wk" for 万科. Polyphonic characters take their most common
+// reading.
+func pinyinOf(s string) (full, initials string) {
+	var b, ib strings.Builder
+	for _, syllables := range pinyin.Pinyin(s, pinyinArgs) {
+		if len(syllables) == 0 || syllables[0] == "" {
+			continue
+		}
+		b.WriteString(syllables[0])
+		ib.WriteByte(syllables[0][0])
+	}
+	return b.String(), ib.String()
+}
+
+// recordKeyword counts a search in keywords, normalized the way rank looks
+// them up; blank and overlong keywords are ignored.
+func recordKeyword(ctx context.Context, keywords KeywordRepo, keyword string) error {
+	keyword = suggestNormalize(keyword)
+	if keyword == "" || utf8.RuneCountInString(keyword) > maxKeywordLength {
+		return nil
+	}
+	return keywords.RecordKeyword(ctx, keyword)
+}
+
+// suggestNormalize lowercases s and drops whitespace.
+func suggestNormalize(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), ""))
+}
@@ -1,14 +1,124 @@
 package biz
 
-import "github.com/go-kratos/kratos/v2/log"
+import (
+	"context"
+	"strconv"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrDealNotFound is deal not found.
+	ErrDealNotFound = errors.NotFound("DEAL_NOT_FOUND", "交易不存在")
+	// ErrDealInvalid is a deal with missing fields.
+	ErrDealInvalid = errors.BadRequest("DEAL_INVALID", "房源、买方和成交价不能为空")
+	// ErrDealState is an operation not allowed in the deal's current status.
+	ErrDealState = errors.Conflict("DEAL_STATE", "交易状态不允许该操作")
+)
+
+// 交易状态
+const (
+	DealPending   int32 = iota // 进行中
+	DealCompleted              // 已成交
+	DealCanceled               // 已取消
+)
+
+// Deal is a house deal between a buyer and the listing agent.
+type Deal struct {
+	gorm.Model
+	HouseID     uint       // 房源
+	BuyerID     uint       // 买方
+	AgentID     uint       // 经纪人（商家）
+	Price       int64      // 成交价（元）
+	Status      int32      // 0进行中 1已成交 2已取消
+	CompletedAt *time.Time // 成交时间
+}
+
+// TopicDealCompleted is the topic of DealCompletedEvent.
+const TopicDealCompleted = "deal.completed"
+
+// DealCompletedEvent is raised when a deal is completed. Points, house and customer
+// subscribe to it to award the buyer, mark the house sold and close the lead.
+type DealCompletedEvent struct {
+	DealID      uint      `json:"deal_id"`
+	HouseID     uint      `json:"house_id"`
+	BuyerID     uint      `json:"buyer_id"`
+	AgentID     uint      `json:"agent_id"`
+	Price       int64     `json:"price"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+func (*DealCompletedEvent) Topic() string { return TopicDealCompleted }
+
+func (e *DealCompletedEvent) Key() string { return strconv.FormatUint(uint64(e.DealID), 10) }
+
+// TransactionRepo is a transaction repo.
 type TransactionRepo interface {
+	CreateDeal(context.Context, *Deal) (*Deal, error)
+	GetDeal(ctx context.Context, id uint) (*Deal, error)
+	// UpdateDeal saves d and stores events in the outbox atomically.
+	UpdateDeal(ctx context.Context, d *Deal, events ...*Event) (*Deal, error)
 }
+
+// TransactionUsecase is a transaction usecase.
 type TransactionUsecase struct {
 	repo TransactionRepo
+	tx   Transaction
 	log  *log.Helper
 }
 
-func NewTransactionUsecase(repo TransactionRepo, logger log.Logger) *TransactionUsecase {
-	return &TransactionUsecase{repo: repo, log: log.NewHelper(logger)}
+// NewTransactionUsecase new a Transaction usecase.
+func NewTransactionUsecase(repo TransactionRepo, tx Transaction, logger log.Logger) *TransactionUsecase {
+	return &TransactionUsecase{repo: repo, tx: tx, log: log.NewHelper(logger)}
+}
+
+// CreateDeal opens a pending deal.
+func (uc *TransactionUsecase) CreateDeal(ctx context.Context, d *Deal) (*Deal, error) {
+	uc.log.WithContext(ctx).Infof("CreateDeal: house=%d buyer=%d", d.HouseID, d.BuyerID)
+	if d.HouseID == 0 || d.BuyerID == 0 || d.Price <= 0 {
+		return nil, ErrDealInvalid
+	}
+	d.Status = DealPending
+	return uc.repo.CreateDeal(ctx, d)
+}
+
+// CompleteDeal marks a pending deal as completed.
+func (uc *TransactionUsecase) CompleteDeal(ctx context.Context, id uint) (*Deal, error) {
+	var res *Deal
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		d, err := uc.repo.GetDeal(ctx, id)
+		if err != nil {
+			return err
+		}
+		if d == nil {
+			return ErrDealNotFound
+		}
+		if d.Status != DealPending {
+			return ErrDealState
+		}
+		res, err = uc.complete(ctx, d)
+		return err
+	})
+	return res, err
+}
+
+func (uc *TransactionUsecase) complete(ctx context.Context, d *Deal) (*Deal, error) {
+	now := time.Now()
+	d.Status = DealCompleted
+	d.CompletedAt = &now
+	e, err := NewEvent(&DealCompletedEvent{
+		DealID:      d.ID,
+		HouseID:     d.HouseID,
+		BuyerID:     d.BuyerID,
+		AgentID:     d.AgentID,
+		Price:       d.Price,
+		CompletedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.UpdateDeal(ctx, d, e)
 }
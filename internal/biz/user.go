@@ -1,45 +1,72 @@
 package biz
 
 import (
+	"context"
+
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 用户等级
+const (
+	GradeVisitor  int32 = iota // 普通游客
+	GradeMember                // 会员
+	GradeMerchant              // 商家
+	GradeAdmin                 // 管理
 )
 
 // todo:这个结构体就是数据库的结构体
-//type User struct {
-//	gorm.Model
-//	Mobile   string // 账号或手机号
-//	NickName string // 昵称
-//	Password string // 密码
-//	Birthday int32  // 生日
-//	Gender   int32  // 性别（0男 1女）
-//	Grade    int32  // 等级（0普通游客 1会员 2商家 3管理）
-//}
-
-// UserRepo  is a user repo.
+type User struct {
+	gorm.Model
+	Mobile   string // 账号或手机号
+	NickName string // 昵称
+	Password string // 密码
+	Birthday int32  // 生日
+	Gender   int32  // 性别（0男 1女）
+	Grade    int32  // 等级（0普通游客 1会员 2商家 3管理）
+}
+
+// UserRepo  is a user repo. GetUser may be served from a cache and leaves
+// Password empty; GetPassword always reads the store.
 type UserRepo interface {
-	//CreateUser(context.Context, *User) (*User, error)
-	//GetUser(ctx context.Context, phone string) (*User, error)
+	CreateUser(context.Context, *User) (*User, error)
+	GetUser(ctx context.Context, phone string) (*User, error)
+	GetPassword(ctx context.Context, phone string) (string, error)
 }
 
 // UserUsecase is a user usecase.
 type UserUsecase struct {
-	repo UserRepo
-	log  *log.Helper
+	repo    UserRepo
+	content *ContentUsecase
+	log     *log.Helper
 }
 
 // NewUserUsecase new a User usecase.
-func NewUserUsecase(repo UserRepo, logger log.Logger) *UserUsecase {
-	return &UserUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewUserUsecase(repo UserRepo, content *ContentUsecase, logger log.Logger) *UserUsecase {
+	return &UserUsecase{repo: repo, content: content, log: log.NewHelper(logger)}
 }
 
-// todo:用户添加
-//func (uc *UserUsecase) CreateUser(ctx context.Context, g *User) (*User, error) {
-//	uc.log.WithContext(ctx).Infof("CreateUser: %v", g.NickName)
-//	return uc.repo.CreateUser(ctx, g)
-//}
+// todo:用户添加，昵称经过内容过滤
+func (uc *UserUsecase) CreateUser(ctx context.Context, g *User) (*User, error) {
+	uc.log.WithContext(ctx).Infof("CreateUser: %v", g.NickName)
+	if _, err := uc.content.Clean(ctx, SceneProfile, ContentField{"nick_name", &g.NickName}); err != nil {
+		return nil, err
+	}
+	return uc.repo.CreateUser(ctx, g)
+}
 
 // todo:根据手机号查询用户
-//func (uc *UserUsecase) GetUser(ctx context.Context, phone string) (*User, error) {
-//	uc.log.WithContext(ctx).Infof("GetUser: %v", phone)
-//	return uc.repo.GetUser(ctx, phone)
-//}
+func (uc *UserUsecase) GetUser(ctx context.Context, phone string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("GetUser: %v", phone)
+	return uc.repo.GetUser(ctx, phone)
+}
+
+// CheckPassword reports whether password matches the user's; the returned
+// User never carries it.
+func (uc *UserUsecase) CheckPassword(ctx context.Context, phone, password string) (bool, error) {
+	stored, err := uc.repo.GetPassword(ctx, phone)
+	if err != nil {
+		return false, err
+	}
+	return stored != "" && stored == password, nil
+}
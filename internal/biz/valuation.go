@@ -0,0 +1,355 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrValuationInvalid is a valuation without an area or a location.
+	ErrValuationInvalid = errors.BadRequest("VALUATION_INVALID", "面积必须大于0，并指定房源、小区或城市和区县")
+	// ErrNoComparables is a valuation with no comparable sale or listing
+	// nearby.
+	ErrNoComparables = errors.NotFound("NO_COMPARABLES", "附近没有可比成交和挂牌，无法估价")
+)
+
+// 可比案例来源
+const (
+	ComparableSale    = "sale"    // 成交
+	ComparableListing = "listing" // 挂牌
+)
+
+// 估价范围
+const (
+	ScopeCommunity = "community" // 同小区
+	ScopeDistrict  = "district"  // 同区县
+)
+
+// 楼层段，按所在楼层占总楼层的比例三等分
+const (
+	FloorLow    = "low"
+	FloorMiddle = "middle"
+	FloorHigh   = "high"
+)
+
+// SaleRecord is a completed deal with the house as it was sold, kept as a
+// comparable for valuations.
+type SaleRecord struct {
+	gorm.Model
+	DealID      uint      // 交易
+	HouseID     uint      // 房源
+	CommunityID uint      // 小区
+	City        string    // 城市
+	District    string    // 区县
+	Area        float64   // 建筑面积（㎡）
+	Floor       int32     // 所在楼层
+	TotalFloors int32     // 总楼层
+	Orientation string    // 朝向
+	BuildYear   int32     // 建成年份
+	Price       int64     // 成交价（元）
+	UnitPrice   int64     // 成交单价（元/㎡）
+	SoldAt      time.Time // 成交时间
+}
+
+// ValuationRules are the coefficients of the comparable-sales valuation.
+// Percentages are in percent.
+type ValuationRules struct {
+	SaleMonths      int32   // 成交案例回溯月数
+	MinComparables  int     // 同小区案例少于该数时扩大到同区县
+	MaxComparables  int     // 最多采用的案例数
+	ListingWeight   float64 // 挂牌案例相对成交案例的权重
+	ListingDiscount float64 // 挂牌价相对成交价的溢价，挂牌案例先按此折算
+	AreaStep        float64 // 面积每大10㎡，单价的变化
+	AgeStep         float64 // 房龄每大1年，单价的变化
+	// FloorAdjust and OrientationAdjust are the unit price premiums of a
+	// floor band or an orientation; unlisted ones have none.
+	FloorAdjust       map[string]float64
+	OrientationAdjust map[string]float64
+}
+
+// DefaultValuationRules returns the built-in coefficients.
+func DefaultValuationRules() *ValuationRules {
+	return &ValuationRules{
+		SaleMonths:      12,
+		MinComparables:  3,
+		MaxComparables:  10,
+		ListingWeight:   0.6,
+		ListingDiscount: 3,
+		AreaStep:        -1,
+		AgeStep:         -0.8,
+		FloorAdjust:     map[string]float64{FloorLow: -2, FloorMiddle: 0, FloorHigh: 1},
+		OrientationAdjust: map[string]float64{
+			"南北": 3, "南": 2, "东南": 1, "西南": 1, "东": 0, "西": -1, "东北": -2, "西北": -2, "北": -3,
+		},
+	}
+}
+
+// ValuationRequest is the house to value: an existing listing, or a house
+// described by its attributes.
+type ValuationRequest struct {
+	HouseID     uint
+	CommunityID uint
+	City        string
+	District    string
+	Area        float64
+	Floor       int32
+	TotalFloors int32
+	Orientation string
+	BuildYear   int32
+}
+
+// Comparable is a sale or listing used by a valuation.
+type Comparable struct {
+	Source      string // sale 或 listing
+	HouseID     uint
+	Area        float64
+	Floor       int32
+	TotalFloors int32
+	Orientation string
+	BuildYear   int32
+	Price       int64
+	UnitPrice   int64
+	// AdjustedUnitPrice is the unit price adjusted to the valued house.
+	AdjustedUnitPrice int64
+	Weight            float64
+	Date              time.Time // 成交或挂牌时间
+}
+
+// Valuation is an estimate with its range and the comparables behind it.
+type Valuation struct {
+	Price       int64  // 估价（元），取整到千元
+	UnitPrice   int64  // 估算单价（元/㎡）
+	Low         int64  // 区间下限（元）
+	High        int64  // 区间上限（元）
+	Confidence  int32  // 置信度 0-100，案例越多越集中越高
+	Scope       string // community 或 district
+	Comparables []*Comparable
+}
+
+// ValuationRepo keeps the sales used as comparables.
+type ValuationRepo interface {
+	// SaveSale creates the record of a deal or replaces it when the deal is
+	// already recorded.
+	SaveSale(context.Context, *SaleRecord) error
+	// ListSales returns up to limit sales since the given time in the
+	// community, or in the district when communityID is 0, newest first.
+	ListSales(ctx context.Context, communityID uint, city, district string, since time.Time, limit int) ([]*SaleRecord, error)
+	// ListSalesBetween returns every sale in [from, to), oldest first.
+	ListSalesBetween(ctx context.Context, from, to time.Time) ([]*SaleRecord, error)
+}
+
+// maxCandidates bounds the sales and listings read for one valuation.
+const maxCandidates = 200
+
+// ValuationUsecase estimates house prices from comparable sales and
+// listings.
+type ValuationUsecase struct {
+	repo        ValuationRepo
+	houses      HouseRepo
+	communities CommunityRepo
+	rules       *ValuationRules
+	log         *log.Helper
+}
+
+// NewValuationUsecase new a Valuation usecase.
+func NewValuationUsecase(repo ValuationRepo, houses HouseRepo, communities CommunityRepo, rules *ValuationRules, bus EventBus, logger log.Logger) *ValuationUsecase {
+	uc := &ValuationUsecase{repo: repo, houses: houses, communities: communities, rules: rules, log: log.NewHelper(logger)}
+	Subscribe(bus, "valuation", uc.onDealCompleted)
+	return uc
+}
+
+// EstimatePrice values a house from the sales and listings of its
+// community, widening to its district when the community has too few. Each
+// comparable's unit price is adjusted for area, floor, orientation and age,
+// and weighted by its source, recency and how much it had to be adjusted.
+func (uc *ValuationUsecase) EstimatePrice(ctx context.Context, req *ValuationRequest) (*Valuation, error) {
+	subject := *req
+	if subject.HouseID != 0 {
+		h, err := uc.houses.GetHouse(ctx, subject.HouseID)
+		if err != nil {
+			return nil, err
+		}
+		if h == nil {
+			return nil, ErrHouseNotFound
+		}
+		subject = ValuationRequest{
+			HouseID: h.ID, CommunityID: h.CommunityID, City: h.City, District: h.District, Area: h.Area,
+			Floor: h.Floor, TotalFloors: h.TotalFloors, Orientation: h.Orientation, BuildYear: h.BuildYear,
+		}
+	}
+	if subject.CommunityID != 0 {
+		c, err := uc.communities.GetCommunity(ctx, subject.CommunityID)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			return nil, ErrCommunityNotFound
+		}
+		subject.City, subject.District = c.City, c.District
+		if subject.BuildYear == 0 {
+			subject.BuildYear = c.BuildYear
+		}
+	}
+	if subject.Area <= 0 || subject.CommunityID == 0 && (subject.City == "" || subject.District == "") {
+		return nil, ErrValuationInvalid
+	}
+
+	var comps []*Comparable
+	scope := ScopeCommunity
+	if subject.CommunityID != 0 {
+		var err error
+		if comps, err = uc.comparables(ctx, &subject, subject.CommunityID); err != nil {
+			return nil, err
+		}
+	}
+	if len(comps) < uc.rules.MinComparables {
+		more, err := uc.comparables(ctx, &subject, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(more) > len(comps) {
+			comps, scope = more, ScopeDistrict
+		}
+	}
+	if len(comps) == 0 {
+		return nil, ErrNoComparables
+	}
+	sort.SliceStable(comps, func(i, j int) bool { return comps[i].Weight > comps[j].Weight })
+	if len(comps) > uc.rules.MaxComparables {
+		comps = comps[:uc.rules.MaxComparables]
+	}
+
+	var sum, weights float64
+	for _, c := range comps {
+		sum += float64(c.AdjustedUnitPrice) * c.Weight
+		weights += c.Weight
+	}
+	mean := sum / weights
+	var variance float64
+	for _, c := range comps {
+		d := float64(c.AdjustedUnitPrice) - mean
+		variance += d * d * c.Weight
+	}
+	cv := math.Sqrt(variance/weights) / mean
+	// 案例少时区间至少放宽到 ±3%
+	spread := math.Max(cv, 0.03)
+	enough := math.Min(1, float64(len(comps))/float64(2*uc.rules.MinComparables))
+	return &Valuation{
+		Price:       roundThousand(mean * subject.Area),
+		UnitPrice:   int64(math.Round(mean)),
+		Low:         roundThousand(mean * (1 - spread) * subject.Area),
+		High:        roundThousand(mean * (1 + spread) * subject.Area),
+		Confidence:  int32(math.Round(100 * enough * math.Max(0, 1-5*cv))),
+		Scope:       scope,
+		Comparables: comps,
+	}, nil
+}
+
+// comparables collects and adjusts the sales and listings of a community,
+// or of the subject's district when communityID is 0.
+func (uc *ValuationUsecase) comparables(ctx context.Context, subject *ValuationRequest, communityID uint) ([]*Comparable, error) {
+	now := time.Now()
+	since := now.AddDate(0, -int(uc.rules.SaleMonths), 0)
+	sales, err := uc.repo.ListSales(ctx, communityID, subject.City, subject.District, since, maxCandidates)
+	if err != nil {
+		return nil, err
+	}
+	listings, err := uc.houses.ListOnSale(ctx, communityID, subject.City, subject.District, maxCandidates)
+	if err != nil {
+		return nil, err
+	}
+	var comps []*Comparable
+	for _, s := range sales {
+		if s.HouseID == subject.HouseID {
+			continue
+		}
+		c := &Comparable{
+			Source: ComparableSale, HouseID: s.HouseID, Area: s.Area, Floor: s.Floor, TotalFloors: s.TotalFloors,
+			Orientation: s.Orientation, BuildYear: s.BuildYear, Price: s.Price, UnitPrice: s.UnitPrice, Date: s.SoldAt,
+		}
+		// 越早的成交权重越低，回溯期末降到一半
+		age := now.Sub(s.SoldAt).Hours() / 24 / 30 / float64(uc.rules.SaleMonths)
+		comps = append(comps, uc.adjust(subject, c, float64(s.UnitPrice), 1-0.5*math.Min(1, age)))
+	}
+	for _, h := range listings {
+		if h.ID == subject.HouseID {
+			continue
+		}
+		c := &Comparable{
+			Source: ComparableListing, HouseID: h.ID, Area: h.Area, Floor: h.Floor, TotalFloors: h.TotalFloors,
+			Orientation: h.Orientation, BuildYear: h.BuildYear, Price: h.Price, UnitPrice: h.UnitPrice, Date: h.CreatedAt,
+		}
+		base := float64(h.UnitPrice) / (1 + uc.rules.ListingDiscount/100)
+		comps = append(comps, uc.adjust(subject, c, base, uc.rules.ListingWeight))
+	}
+	return comps, nil
+}
+
+// adjust sets the adjusted unit price and weight of c. A comparable that
+// needs a large adjustment is less alike, so it weighs less.
+func (uc *ValuationUsecase) adjust(subject *ValuationRequest, c *Comparable, base, weight float64) *Comparable {
+	r := uc.rules
+	f := 1 + r.AreaStep/100*(subject.Area-c.Area)/10
+	if subject.BuildYear > 0 && c.BuildYear > 0 {
+		f *= 1 + r.AgeStep/100*float64(c.BuildYear-subject.BuildYear)
+	}
+	sb, cb := floorBand(subject.Floor, subject.TotalFloors), floorBand(c.Floor, c.TotalFloors)
+	if sb != "" && cb != "" {
+		f *= (1 + r.FloorAdjust[sb]/100) / (1 + r.FloorAdjust[cb]/100)
+	}
+	if subject.Orientation != "" && c.Orientation != "" {
+		f *= (1 + r.OrientationAdjust[subject.Orientation]/100) / (1 + r.OrientationAdjust[c.Orientation]/100)
+	}
+	// 差异过大的案例修正有限，靠权重降低影响
+	f = math.Min(1.3, math.Max(0.7, f))
+	c.AdjustedUnitPrice = int64(math.Round(base * f))
+	c.Weight = math.Round(weight/(1+10*math.Abs(f-1))*1000) / 1000
+	return c
+}
+
+// onDealCompleted records the sold house as a comparable. Rentals are left
+// out, their prices being rents.
+func (uc *ValuationUsecase) onDealCompleted(ctx context.Context, e *DealCompletedEvent) error {
+	h, err := uc.houses.GetHouse(ctx, e.HouseID)
+	if err != nil {
+		return err
+	}
+	if h == nil {
+		uc.log.WithContext(ctx).Warnf("deal %d: house %d not found", e.DealID, e.HouseID)
+		return nil
+	}
+	if h.ListingType != ListingSale || h.Area <= 0 {
+		return nil
+	}
+	return uc.repo.SaveSale(ctx, &SaleRecord{
+		DealID: e.DealID, HouseID: h.ID, CommunityID: h.CommunityID, City: h.City, District: h.District,
+		Area: h.Area, Floor: h.Floor, TotalFloors: h.TotalFloors, Orientation: h.Orientation, BuildYear: h.BuildYear,
+		Price: e.Price, UnitPrice: unitPrice(e.Price, h.Area), SoldAt: e.CompletedAt,
+	})
+}
+
+// floorBand returns the third of the building a floor is in, or "" when
+// the floor is unknown.
+func floorBand(floor, total int32) string {
+	if floor <= 0 || total <= 0 || floor > total {
+		return ""
+	}
+	switch r := float64(floor) / float64(total); {
+	case r <= 1.0/3:
+		return FloorLow
+	case r <= 2.0/3:
+		return FloorMiddle
+	default:
+		return FloorHigh
+	}
+}
+
+func roundThousand(v float64) int64 {
+	return int64(math.Round(v/1000)) * 1000
+}
@@ -0,0 +1,63 @@
+package conf
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Dynamic holds the part of Bootstrap that is safe to change while the
+// server is running (log level, feature flags). Components keep
+// a *Dynamic and read the current value on every use; the config watcher in
+// main calls Update when the source changes.
+type Dynamic struct {
+	current atomic.Pointer[Bootstrap]
+
+	mu        sync.Mutex
+	listeners []func(*Bootstrap)
+}
+
+// NewDynamic new a Dynamic seeded with the startup config.
+func NewDynamic(bc *Bootstrap) *Dynamic {
+	d := &Dynamic{}
+	d.current.Store(bc)
+	return d
+}
+
+// Update swaps in a freshly loaded (and already validated) Bootstrap and
+// notifies subscribers.
+func (d *Dynamic) Update(bc *Bootstrap) {
+	d.current.Store(bc)
+	d.mu.Lock()
+	listeners := append([]func(*Bootstrap){}, d.listeners...)
+	d.mu.Unlock()
+	for _, fn := range listeners {
+		fn(bc)
+	}
+}
+
+// Subscribe registers fn to be called after every Update.
+func (d *Dynamic) Subscribe(fn func(*Bootstrap)) {
+	d.mu.Lock()
+	d.listeners = append(d.listeners, fn)
+	d.mu.Unlock()
+}
+
+// Current returns the latest Bootstrap snapshot.
+func (d *Dynamic) Current() *Bootstrap {
+	return d.current.Load()
+}
+
+// Level returns the configured log level, defaulting to info.
+func (d *Dynamic) Level() log.Level {
+	if lv := d.Current().GetLog().GetLevel(); lv != "" {
+		return log.ParseLevel(lv)
+	}
+	return log.LevelInfo
+}
+
+// Enabled reports whether the named feature flag is switched on.
+func (d *Dynamic) Enabled(feature string) bool {
+	return d.Current().GetFeatures()[feature]
+}
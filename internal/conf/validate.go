@@ -0,0 +1,121 @@
+package conf
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Validate checks the loaded Bootstrap and reports every problem at once,
+// so a misconfigured deployment fails at startup instead of on first use.
+func (x *Bootstrap) Validate() error {
+	return x.validate(true)
+}
+
+// ValidateData checks the sections the offline subcommands use, leaving out
+// the server section, such as the admin token, which they never serve.
+func (x *Bootstrap) ValidateData() error {
+	return x.validate(false)
+}
+
+// ValidSearchInstance reports whether the search index instance name is
+// set; serving and rebuild-index need it, the other subcommands do not.
+func ValidSearchInstance(name string) bool {
+	return name != "" && !strings.Contains(name, "${")
+}
+
+func (x *Bootstrap) validate(server bool) error {
+	var errs []string
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Sprintf(format, args...))
+		}
+	}
+
+	s := x.GetServer()
+	if server {
+		check(s.GetHttp().GetAddr() != "", "server.http.addr 不能为空")
+		check(s.GetGrpc().GetAddr() != "", "server.grpc.addr 不能为空")
+
+		for i, p := range s.GetRateLimit().GetPolicies() {
+			check(p.GetOperation() != "", "server.rate_limit.policies[%d].operation 不能为空", i)
+			check(p.GetKey() == "ip" || p.GetKey() == "user" || p.GetKey() == "mobile",
+				"server.rate_limit.policies[%d].key 只能是 ip、user 或 mobile: %q", i, p.GetKey())
+			check(p.GetLimit() > 0 && p.GetWindow().AsDuration() > 0, "server.rate_limit.policies[%d] 的 limit 和 window 必须大于 0", i)
+		}
+		for i, cidr := range s.GetRateLimit().GetTrustedProxies() {
+			_, _, err := net.ParseCIDR(cidr)
+			check(err == nil, "server.rate_limit.trusted_proxies[%d] 不是合法的网段，如 10.0.0.0/8: %q", i, cidr)
+		}
+		for i, op := range s.GetIdempotency().GetOperations() {
+			check(strings.HasPrefix(op, "/"), "server.idempotency.operations[%d] 必须是完整的 operation，如 /api.points.v5.Points/RedeemPoints: %q", i, op)
+		}
+		for i, op := range s.GetAdmin().GetOperations() {
+			check(strings.HasPrefix(op, "/"), "server.admin.operations[%d] 必须是完整的 operation，如 /api.community.v7.Community/CreateCommunity: %q", i, op)
+		}
+		check(len(s.GetAdmin().GetOperations()) == 0 || len(s.GetAdmin().GetToken()) >= 16,
+			"server.admin.token 至少 16 位（设置环境变量 ANJUKE_ADMIN_TOKEN 或 -secrets 文件）")
+		check(s.GetIdempotency().GetTtl().AsDuration() >= 0 && s.GetIdempotency().GetProcessingTtl().AsDuration() >= 0, "server.idempotency 的有效期不能为负数")
+		check(!strings.Contains(s.GetAdmin().GetToken(), "${"), "存在未解析的占位符: %s", s.GetAdmin().GetToken())
+		check(ValidSearchInstance(x.GetData().GetSearch().GetInstance()), "data.search.instance 不能为空（设置环境变量 ANJUKE_SEARCH_INSTANCE）")
+	}
+
+	d := x.GetData()
+	db := d.GetDatabase()
+	check(db.GetDriver() != "", "data.database.driver 不能为空")
+	check(db.GetSource() != "", "data.database.source 不能为空（设置环境变量 ANJUKE_MYSQL_DSN 或 -secrets 文件）")
+	check(d.GetRedis().GetAddr() != "", "data.redis.addr 不能为空（设置环境变量 ANJUKE_REDIS_ADDR 或 -secrets 文件）")
+	check(db.GetMaxOpenConns() >= 0 && db.GetMaxIdleConns() >= 0, "data.database 连接数不能为负数")
+	check(db.GetMaxOpenConns() == 0 || db.GetMaxIdleConns() <= db.GetMaxOpenConns(),
+		"data.database.max_idle_conns(%d) 不能大于 max_open_conns(%d)", db.GetMaxIdleConns(), db.GetMaxOpenConns())
+	check(d.GetSearch().GetPath() != "", "data.search.path 不能为空")
+	check(d.GetCache().GetTtl().AsDuration() >= 0 && d.GetCache().GetNegativeTtl().AsDuration() >= 0, "data.cache 的有效期不能为负数")
+	m := d.GetMortgage()
+	for _, v := range []float64{m.GetCommercialFirst(), m.GetCommercialSecond(), m.GetFundFirst(), m.GetFundSecond(), m.GetVatRate(), m.GetAgencyFeeRate()} {
+		check(v >= 0 && v < 100, "data.mortgage 的利率和费率必须在 0 到 100 之间: %v", v)
+	}
+	check(m.GetDownPaymentFirst() >= 0 && m.GetDownPaymentFirst() <= 100 && m.GetDownPaymentSecond() >= 0 && m.GetDownPaymentSecond() <= 100,
+		"data.mortgage 的首付比例必须在 0 到 100 之间")
+	check(m.GetFundLimit() >= 0 && m.GetMaxYears() >= 0 && m.GetVatExemptYears() >= 0, "data.mortgage 的额度和年限不能为负数")
+	for i, b := range m.GetDeedTax() {
+		check(b.GetHome() == 1 || b.GetHome() == 2, "data.mortgage.deed_tax[%d].home 只能是 1 或 2: %d", i, b.GetHome())
+		check(b.GetMaxArea() >= 0 && b.GetRate() >= 0 && b.GetRate() < 100, "data.mortgage.deed_tax[%d] 的面积和税率无效", i)
+	}
+	v := d.GetValuation()
+	check(v.GetSaleMonths() >= 0 && v.GetMinComparables() >= 0 && v.GetMaxComparables() >= 0 && v.GetListingWeight() >= 0,
+		"data.valuation 的月数、案例数和权重不能为负数")
+	check(v.GetMaxComparables() == 0 || v.GetMinComparables() <= v.GetMaxComparables(),
+		"data.valuation.min_comparables(%d) 不能大于 max_comparables(%d)", v.GetMinComparables(), v.GetMaxComparables())
+	for k := range v.GetFloorAdjust() {
+		check(k == "low" || k == "middle" || k == "high", "data.valuation.floor_adjust 的楼层段只能是 low、middle 或 high: %q", k)
+	}
+	mod := d.GetModeration()
+	check(mod.GetMaxPriceDeviation() >= 0 && mod.GetMinPriceSamples() >= 0 && mod.GetImageTimeout().AsDuration() >= 0,
+		"data.moderation 的偏离比例、样本数和超时不能为负数")
+	check(mod.GetMaxImageDistance() >= 0 && mod.GetMaxImageDistance() <= 64, "data.moderation.max_image_distance 必须在 0 到 64 之间: %d", mod.GetMaxImageDistance())
+	for scene, action := range d.GetContent().GetContactActions() {
+		check(scene == "listing" || scene == "customer" || scene == "profile", "data.content.contact_actions 的场景只能是 listing、customer 或 profile: %q", scene)
+		check(action == "pass" || action == "mask" || action == "review" || action == "block",
+			"data.content.contact_actions.%s 只能是 pass、mask、review 或 block: %q", scene, action)
+	}
+	bill := d.GetBilling()
+	check(bill.GetAdvanceDays() >= 0 && bill.GetGraceDays() >= 0 && bill.GetDailyLateFee() >= 0 && bill.GetMaxLateFee() >= 0,
+		"data.billing 的出账天数、宽限天数和滞纳金比例不能为负数")
+	for i, r := range db.GetReplicas() {
+		check(r != "", "data.database.replicas[%d] 不能为空", i)
+	}
+	for _, v := range append([]string{db.GetSource(), d.GetRedis().GetAddr(), d.GetRedis().GetPassword()}, db.GetReplicas()...) {
+		check(!strings.Contains(v, "${"), "存在未解析的占位符: %s", v)
+	}
+
+	if lv := x.GetLog().GetLevel(); lv != "" {
+		check(log.ParseLevel(lv).String() == strings.ToUpper(lv), "log.level 无效: %q", lv)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("配置校验失败:\n  - %s", strings.Join(errs, "\n  - "))
+	}
+	return nil
+}
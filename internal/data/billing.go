@@ -0,0 +1,125 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BillRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewBillRepo(data *Data, logger log.Logger) biz.BillRepo {
+	return &BillRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *BillRepo) CreateBills(ctx context.Context, bills []*biz.RentBill) error {
+	// uk_rent_bills_period 去重，重复出账时跳过
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&bills).Error
+	if err != nil {
+		return fmt.Errorf("创建账单失败: %v", err)
+	}
+	return nil
+}
+
+func (r *BillRepo) LockBill(ctx context.Context, id uint) (*biz.RentBill, error) {
+	var b biz.RentBill
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Take(&b, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询账单失败: %v", err)
+	}
+	return &b, nil
+}
+
+func (r *BillRepo) UpdateBill(ctx context.Context, b *biz.RentBill) (*biz.RentBill, error) {
+	if err := r.data.DB(ctx).Save(b).Error; err != nil {
+		return nil, fmt.Errorf("更新账单失败: %v", err)
+	}
+	return b, nil
+}
+
+// UpdateOpenBill is a conditional UPDATE on the status, so a receipt
+// committed after b was read is never overwritten.
+func (r *BillRepo) UpdateOpenBill(ctx context.Context, b *biz.RentBill) (bool, error) {
+	res := r.data.DB(ctx).Model(b).Where("status IN ?", []int32{biz.BillUnpaid, biz.BillOverdue}).
+		Select("amount", "period_end", "items", "status", "late_fee").Updates(b)
+	if res.Error != nil {
+		return false, fmt.Errorf("更新账单失败: %v", res.Error)
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *BillRepo) ListLeaseBills(ctx context.Context, leaseID uint) ([]*biz.RentBill, error) {
+	var list []*biz.RentBill
+	if err := r.data.DB(ctx).Where("lease_id = ?", leaseID).Order("id").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询账单失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *BillRepo) ListBills(ctx context.Context, f *biz.BillFilter) ([]*biz.RentBill, error) {
+	db := r.data.DB(ctx)
+	if f.TenantID != 0 {
+		db = db.Where("tenant_id = ?", f.TenantID)
+	}
+	if f.LandlordID != 0 {
+		db = db.Where("landlord_id = ?", f.LandlordID)
+	}
+	if f.LeaseID != 0 {
+		db = db.Where("lease_id = ?", f.LeaseID)
+	}
+	if f.Status != nil {
+		db = db.Where("status = ?", *f.Status)
+	}
+	var list []*biz.RentBill
+	if err := db.Order("due_date DESC, id DESC").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询账单失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *BillRepo) ListOpenBills(ctx context.Context, dueBefore time.Time, afterID uint, limit int) ([]*biz.RentBill, error) {
+	var list []*biz.RentBill
+	err := r.data.DB(ctx).Where("status IN ? AND due_date < ? AND id > ?", []int32{biz.BillUnpaid, biz.BillOverdue}, dueBefore, afterID).
+		Order("id").Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询账单失败: %v", err)
+	}
+	return list, nil
+}
+
+// NewBillingRules returns the billing rules: the built-in defaults
+// overridden by every item set in data.billing.
+func NewBillingRules(c *conf.Data) *biz.BillingRules {
+	r := biz.DefaultBillingRules()
+	b := c.GetBilling()
+	// 出账和宽限天数可以配置为0
+	if b.AdvanceDays != nil {
+		r.AdvanceDays = int(b.GetAdvanceDays())
+	}
+	if b.GraceDays != nil {
+		r.GraceDays = int(b.GetGraceDays())
+	}
+	if b.DailyLateFee != nil {
+		r.DailyLateFee = b.GetDailyLateFee()
+	}
+	if b.MaxLateFee != nil {
+		r.MaxLateFee = b.GetMaxLateFee()
+	}
+	return r
+}
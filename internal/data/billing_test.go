@@ -0,0 +1,139 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// paidWhileRunning marks a bill paid between the billing run reading the
+// open bills and saving them.
+type paidWhileRunning struct {
+	biz.BillRepo
+	between func()
+}
+
+func (r *paidWhileRunning) ListOpenBills(ctx context.Context, dueBefore time.Time, afterID uint, limit int) ([]*biz.RentBill, error) {
+	list, err := r.BillRepo.ListOpenBills(ctx, dueBefore, afterID, limit)
+	if r.between != nil {
+		r.between()
+		r.between = nil
+	}
+	return list, err
+}
+
+func TestBillingUsecase_PaidDuringRun(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&biz.RentBill{}, &biz.Lease{}, &lockFence{}); err != nil {
+		t.Fatal(err)
+	}
+	_, rdb := newTestRedis(t)
+	d := &Data{db: db, rdb: rdb}
+	bills := &paidWhileRunning{BillRepo: NewBillRepo(d, log.DefaultLogger)}
+	uc := biz.NewBillingUsecase(bills, NewLeaseRepo(d, log.DefaultLogger), biz.DefaultBillingRules(), NewTransaction(d),
+		NewRedisLocker(d, log.DefaultLogger), NewRedisEventBus(d, rdb, log.DefaultLogger), log.DefaultLogger)
+	ctx := context.Background()
+
+	due := time.Now().AddDate(0, -1, 0)
+	if err := bills.CreateBills(ctx, []*biz.RentBill{{LeaseID: 1, LandlordID: 1, Kind: biz.BillRent, Seq: 1, DueDate: due, Amount: 5000}}); err != nil {
+		t.Fatal(err)
+	}
+	var paid *biz.RentBill
+	bills.between = func() {
+		var err error
+		if paid, err = uc.MarkBillPaid(ctx, 1, 1, true, "现金"); err != nil {
+			t.Errorf("MarkBillPaid() error = %v", err)
+		}
+	}
+	res, err := uc.Run(ctx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if paid == nil || res.Overdue != 0 {
+		t.Errorf("Run() = %+v, want the bill paid meanwhile not counted overdue", res)
+	}
+	var got biz.RentBill
+	if err := db.Take(&got, 1).Error; err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != biz.BillPaid || got.PaidAmount != 5000 || got.LateFee != 0 || got.Note != "现金" {
+		t.Errorf("bill = status %d, paid %d, late fee %d, note %q, want the receipt kept", got.Status, got.PaidAmount, got.LateFee, got.Note)
+	}
+
+	// 之后的运行不再碰已支付的账单
+	if res, err := uc.Run(ctx, time.Now()); err != nil || res.Overdue != 0 {
+		t.Errorf("second Run() = %+v, %v", res, err)
+	}
+}
+
+// changedWhileRunning renews or terminates leases between the billing run
+// listing the active leases and expiring them.
+type changedWhileRunning struct {
+	biz.LeaseRepo
+	between func()
+}
+
+func (r *changedWhileRunning) ListActiveLeases(ctx context.Context, afterID uint, limit int) ([]*biz.Lease, error) {
+	list, err := r.LeaseRepo.ListActiveLeases(ctx, afterID, limit)
+	if r.between != nil {
+		r.between()
+		r.between = nil
+	}
+	return list, err
+}
+
+func TestBillingUsecase_LeaseChangedDuringRun(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&biz.RentBill{}, &biz.Lease{}, &lockFence{}, &outboxEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	_, rdb := newTestRedis(t)
+	d := &Data{db: db, rdb: rdb}
+	repo := NewLeaseRepo(d, log.DefaultLogger)
+	leases := &changedWhileRunning{LeaseRepo: repo}
+	tx := NewTransaction(d)
+	uc := biz.NewBillingUsecase(NewBillRepo(d, log.DefaultLogger), leases, biz.DefaultBillingRules(), tx,
+		NewRedisLocker(d, log.DefaultLogger), NewRedisEventBus(d, rdb, log.DefaultLogger), log.DefaultLogger)
+	lc := biz.NewLeaseUsecase(repo, NewHouseRepo(d, log.DefaultLogger), tx, log.DefaultLogger)
+	ctx := context.Background()
+
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local).AddDate(0, -1, 0)
+	var ids []uint
+	for house := uint(1); house <= 2; house++ {
+		l, err := repo.CreateLease(ctx, &biz.Lease{HouseID: house, LandlordID: 1, TenantID: 2, MonthlyRent: 3000, DepositMonths: 1, PayMonths: 1,
+			StartDate: start, Months: 2, EndDate: start.AddDate(0, 2, 0), Status: biz.LeaseActive})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, l.ID)
+	}
+	leases.between = func() {
+		if _, err := lc.RenewLease(ctx, ids[0], 12, 0); err != nil {
+			t.Errorf("RenewLease() error = %v", err)
+		}
+		if _, err := lc.TerminateLease(ctx, ids[1], time.Time{}, 0, "提前退租"); err != nil {
+			t.Errorf("TerminateLease() error = %v", err)
+		}
+	}
+	// 运行日期在两份租约到期之后
+	res, err := uc.Run(ctx, start.AddDate(0, 2, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Expired != 1 {
+		t.Errorf("Run() expired %d leases, want only the renewed one", res.Expired)
+	}
+	renewed, _ := repo.GetLease(ctx, ids[0])
+	if renewed.Status != biz.LeaseExpired || renewed.RenewalID == 0 {
+		t.Errorf("renewed lease = status %d, renewal %d, want expired with its renewal kept", renewed.Status, renewed.RenewalID)
+	}
+	terminated, _ := repo.GetLease(ctx, ids[1])
+	if terminated.Status != biz.LeaseTerminated || terminated.TerminatedAt == nil {
+		t.Errorf("terminated lease = status %d, terminated at %v, want it left terminated", terminated.Status, terminated.TerminatedAt)
+	}
+}
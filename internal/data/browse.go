@@ -0,0 +1,231 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// browseTTL is how long an idle user's history stays in Redis; it is
+	// loaded back from MySQL on the next view.
+	browseTTL = 30 * 24 * time.Hour
+	// browseFlushInterval is how often changed histories are written to
+	// MySQL.
+	browseFlushInterval = time.Minute
+	// browseFlushBatch is the most users flushed per round trip.
+	browseFlushBatch = 100
+	browseDirtyKey   = "browse:dirty"
+)
+
+// browseRecord is a row of the browse_records table, the durable copy of
+// the Redis history.
+type browseRecord struct {
+	ID       uint `gorm:"primaryKey"`
+	UserID   uint
+	HouseID  uint
+	ViewedAt time.Time
+}
+
+func (browseRecord) TableName() string { return "browse_records" }
+
+func browseKey(userID uint) string {
+	return "browse:" + strconv.FormatUint(uint64(userID), 10)
+}
+
+// BrowseHistoryRepo keeps each user's history in a Redis sorted set of
+// listing IDs scored by view time, capped at biz.MaxBrowseHistory. Users
+// with new views are marked dirty and written to MySQL by BrowseFlusher.
+type BrowseHistoryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewBrowseHistoryRepo(data *Data, logger log.Logger) biz.BrowseHistoryRepo {
+	return &BrowseHistoryRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *BrowseHistoryRepo) RecordView(ctx context.Context, userID, houseID uint, at time.Time) error {
+	if err := r.load(ctx, userID); err != nil {
+		return err
+	}
+	key := browseKey(userID)
+	pipe := r.data.rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, &redis.Z{Score: float64(at.UnixMilli()), Member: houseID})
+	pipe.ZRemRangeByRank(ctx, key, 0, -biz.MaxBrowseHistory-1)
+	pipe.Expire(ctx, key, browseTTL)
+	pipe.SAdd(ctx, browseDirtyKey, userID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("记录浏览失败: %v", err)
+	}
+	return nil
+}
+
+func (r *BrowseHistoryRepo) ListViews(ctx context.Context, userID uint, limit int) ([]*biz.BrowseRecord, error) {
+	if err := r.load(ctx, userID); err != nil {
+		return nil, err
+	}
+	zs, err := r.data.rdb.ZRevRangeWithScores(ctx, browseKey(userID), 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("查询浏览记录失败: %v", err)
+	}
+	list := make([]*biz.BrowseRecord, 0, len(zs))
+	for _, z := range zs {
+		id, err := strconv.ParseUint(z.Member.(string), 10, 64)
+		if err != nil {
+			continue
+		}
+		list = append(list, &biz.BrowseRecord{HouseID: uint(id), ViewedAt: time.UnixMilli(int64(z.Score))})
+	}
+	return list, nil
+}
+
+// load copies the MySQL history into Redis when the sorted set has expired,
+// so new views are added to the full history.
+func (r *BrowseHistoryRepo) load(ctx context.Context, userID uint) error {
+	key := browseKey(userID)
+	n, err := r.data.rdb.Exists(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("查询浏览记录失败: %v", err)
+	}
+	if n > 0 {
+		return nil
+	}
+	var rows []*browseRecord
+	err = r.data.DB(ctx).Where("user_id = ?", userID).Order("viewed_at DESC").Limit(biz.MaxBrowseHistory).Find(&rows).Error
+	if err != nil {
+		return fmt.Errorf("查询浏览记录失败: %v", err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	zs := make([]*redis.Z, 0, len(rows))
+	for _, row := range rows {
+		zs = append(zs, &redis.Z{Score: float64(row.ViewedAt.UnixMilli()), Member: row.HouseID})
+	}
+	pipe := r.data.rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, zs...)
+	pipe.Expire(ctx, key, browseTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("加载浏览记录失败: %v", err)
+	}
+	return nil
+}
+
+// BrowseFlusher writes the Redis histories of dirty users to MySQL. It
+// implements transport.Server so the kratos app starts and stops it with
+// the other servers; several instances can run at once, each user being
+// popped by one of them.
+type BrowseFlusher struct {
+	data *Data
+	log  *log.Helper
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewBrowseFlusher new a BrowseFlusher.
+func NewBrowseFlusher(data *Data, logger log.Logger) *BrowseFlusher {
+	return &BrowseFlusher{data: data, log: log.NewHelper(logger), stop: make(chan struct{})}
+}
+
+// Start flushes every browseFlushInterval until Stop is called or ctx is
+// done, with a last flush on Stop.
+func (f *BrowseFlusher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(browseFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-f.stop:
+			f.flush(context.Background())
+			return nil
+		case <-ticker.C:
+			f.flush(ctx)
+		}
+	}
+}
+
+// Stop stops the loop after a last flush.
+func (f *BrowseFlusher) Stop(context.Context) error {
+	f.stopOnce.Do(func() { close(f.stop) })
+	return nil
+}
+
+func (f *BrowseFlusher) flush(ctx context.Context) {
+	for {
+		n, err := f.FlushOnce(ctx)
+		if err != nil {
+			f.log.Errorf("browse flush: %v", err)
+		}
+		if err != nil || n < browseFlushBatch {
+			return
+		}
+	}
+}
+
+// FlushOnce flushes a batch of dirty users and returns how many were popped.
+// A user that fails is marked dirty again for the next round.
+func (f *BrowseFlusher) FlushOnce(ctx context.Context) (int, error) {
+	ids, err := f.data.rdb.SPopN(ctx, browseDirtyKey, browseFlushBatch).Result()
+	if err != nil {
+		return 0, fmt.Errorf("读取待写入用户失败: %v", err)
+	}
+	for i, id := range ids {
+		userID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			continue
+		}
+		if err := f.flushUser(ctx, uint(userID)); err != nil {
+			rest := make([]interface{}, 0, len(ids)-i)
+			for _, id := range ids[i:] {
+				rest = append(rest, id)
+			}
+			if serr := f.data.rdb.SAdd(ctx, browseDirtyKey, rest...).Err(); serr != nil {
+				f.log.Errorf("browse flush: re-mark %d users: %v", len(rest), serr)
+			}
+			return len(ids), fmt.Errorf("写入用户 %d 的浏览记录失败: %v", userID, err)
+		}
+	}
+	return len(ids), nil
+}
+
+// flushUser upserts the user's history and, once it is full, deletes the
+// rows that fell out of Redis.
+func (f *BrowseFlusher) flushUser(ctx context.Context, userID uint) error {
+	zs, err := f.data.rdb.ZRangeWithScores(ctx, browseKey(userID), 0, -1).Result()
+	if err != nil || len(zs) == 0 {
+		return err
+	}
+	rows := make([]*browseRecord, 0, len(zs))
+	for _, z := range zs {
+		houseID, err := strconv.ParseUint(z.Member.(string), 10, 64)
+		if err != nil {
+			continue
+		}
+		rows = append(rows, &browseRecord{UserID: userID, HouseID: uint(houseID), ViewedAt: time.UnixMilli(int64(z.Score))})
+	}
+	return f.data.InTx(ctx, func(ctx context.Context) error {
+		err := f.data.DB(ctx).Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"viewed_at"}),
+		}).Create(&rows).Error
+		if err != nil {
+			return err
+		}
+		if len(zs) < biz.MaxBrowseHistory {
+			return nil
+		}
+		return f.data.DB(ctx).Where("user_id = ? AND viewed_at < ?", userID, rows[0].ViewedAt).Delete(&browseRecord{}).Error
+	})
+}
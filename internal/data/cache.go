@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultCacheTTL         = 10 * time.Minute
+	defaultCacheNegativeTTL = time.Minute
+	// cacheJitter spreads expiries by ±10% so keys written together do not
+	// expire together.
+	cacheJitter = 0.1
+)
+
+// cacheMiss is stored for keys whose record does not exist.
+var cacheMiss = []byte("null")
+
+// Cache is a Redis cache-aside layer for repo reads of T. Concurrent misses
+// for one key share a single load, missing records are cached briefly, and
+// Redis errors fall back to the loader so the cache never fails a read.
+type Cache[T any] struct {
+	rdb         *redis.Client
+	prefix      string
+	ttl         time.Duration
+	negativeTTL time.Duration
+	group       singleflight.Group
+	log         *log.Helper
+}
+
+// NewCache new a Cache whose keys are prefixed with cache:<name>:.
+func NewCache[T any](rdb *redis.Client, name string, c *conf.Data_Cache, logger log.Logger) *Cache[T] {
+	ttl, negativeTTL := defaultCacheTTL, defaultCacheNegativeTTL
+	if c.GetTtl() != nil {
+		ttl = c.GetTtl().AsDuration()
+	}
+	if c.GetNegativeTtl() != nil {
+		negativeTTL = c.GetNegativeTtl().AsDuration()
+	}
+	return &Cache[T]{
+		rdb:         rdb,
+		prefix:      "cache:" + name + ":",
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// Get returns the cached value for key, calling load on a miss. load returns
+// nil, nil when the record does not exist. Every caller gets its own copy.
+// Inside a transaction the cache is bypassed so uncommitted rows are never
+// cached.
+func (c *Cache[T]) Get(ctx context.Context, key string, load func(context.Context) (*T, error)) (*T, error) {
+	if _, ok := txFrom(ctx); ok {
+		return load(ctx)
+	}
+	k := c.prefix + key
+	b, err := c.rdb.Get(ctx, k).Bytes()
+	switch {
+	case err == nil:
+		if v, ok := c.decode(b); ok {
+			return v, nil
+		}
+	case err != redis.Nil:
+		c.log.WithContext(ctx).Warnf("cache get %s: %v", k, err)
+	}
+
+	shared, err, _ := c.group.Do(k, func() (interface{}, error) {
+		// 合并后的加载不随某一个调用方取消
+		ctx := context.WithoutCancel(ctx)
+		v, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		b, err := c.encode(v)
+		if err != nil {
+			c.log.WithContext(ctx).Errorf("cache encode %s: %v", k, err)
+			return nil, err
+		}
+		c.set(ctx, k, b, v == nil)
+		return b, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 共享的是编码结果，每个调用方各自解码，切片等字段互不影响
+	v, ok := c.decode(shared.([]byte))
+	if !ok {
+		return nil, fmt.Errorf("cache decode %s", k)
+	}
+	return v, nil
+}
+
+// Delete invalidates keys now and again after the transaction in ctx
+// commits, so a reader cannot refill the cache with the pre-commit row.
+func (c *Cache[T]) Delete(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	ks := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ks = append(ks, c.prefix+key)
+	}
+	del := func() {
+		if err := c.rdb.Del(context.WithoutCancel(ctx), ks...).Err(); err != nil {
+			c.log.WithContext(ctx).Errorf("cache delete %v: %v", ks, err)
+		}
+	}
+	del()
+	if _, ok := txFrom(ctx); ok {
+		afterCommit(ctx, del)
+	}
+}
+
+func (c *Cache[T]) set(ctx context.Context, k string, b []byte, miss bool) {
+	ttl := c.ttl
+	if miss {
+		ttl = c.negativeTTL
+	}
+	ttl = time.Duration(float64(ttl) * (1 - cacheJitter + 2*cacheJitter*rand.Float64()))
+	if err := c.rdb.Set(ctx, k, b, ttl).Err(); err != nil {
+		c.log.WithContext(ctx).Warnf("cache set %s: %v", k, err)
+	}
+}
+
+func (c *Cache[T]) encode(v *T) ([]byte, error) {
+	if v == nil {
+		return cacheMiss, nil
+	}
+	return json.Marshal(v)
+}
+
+// decode returns the cached value, nil for a cached miss; ok is false when
+// the entry is unreadable and must be reloaded.
+func (c *Cache[T]) decode(b []byte) (*T, bool) {
+	if string(b) == string(cacheMiss) {
+		return nil, true
+	}
+	v := new(T)
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"anjuke/internal/conf"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type cachedRow struct {
+	ID   int
+	Tags []string
+}
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { rdb.Close() })
+	return mr, rdb
+}
+
+func TestCache(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	c := NewCache[cachedRow](rdb, "row", &conf.Data_Cache{Ttl: durationpb.New(time.Hour), NegativeTtl: durationpb.New(time.Minute)}, log.DefaultLogger)
+	d := &Data{db: newTestDB(t), rdb: rdb}
+	ctx := context.Background()
+
+	rows := map[string]*cachedRow{"1": {ID: 1, Tags: []string{"近地铁"}}}
+	loads := 0
+	load := func(key string) func(context.Context) (*cachedRow, error) {
+		return func(context.Context) (*cachedRow, error) {
+			loads++
+			if r, ok := rows[key]; ok {
+				cp := *r
+				return &cp, nil
+			}
+			return nil, nil
+		}
+	}
+
+	t.Run("hit", func(t *testing.T) {
+		loads = 0
+		first, err := c.Get(ctx, "1", load("1"))
+		if err != nil || first == nil || first.Tags[0] != "近地铁" {
+			t.Fatalf("Get() = %v, %v", first, err)
+		}
+		// 调用方修改自己的副本不影响缓存和其他调用方
+		first.Tags[0] = "改过"
+		second, err := c.Get(ctx, "1", load("1"))
+		if err != nil || second.Tags[0] != "近地铁" {
+			t.Errorf("Get() again = %v, %v, want the cached row unchanged", second, err)
+		}
+		if loads != 1 {
+			t.Errorf("loads = %d, want 1", loads)
+		}
+		if ttl := mr.TTL("cache:row:1"); ttl < 54*time.Minute || ttl > 66*time.Minute {
+			t.Errorf("ttl = %v, want about an hour", ttl)
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		loads = 0
+		for i := 0; i < 2; i++ {
+			if v, err := c.Get(ctx, "404", load("404")); v != nil || err != nil {
+				t.Errorf("Get() missing = %v, %v, want nil", v, err)
+			}
+		}
+		if loads != 1 {
+			t.Errorf("loads = %d, want the miss cached", loads)
+		}
+		if ttl := mr.TTL("cache:row:404"); ttl > 66*time.Second {
+			t.Errorf("ttl = %v, want the negative ttl", ttl)
+		}
+		// 记录创建后失效
+		rows["404"] = &cachedRow{ID: 404}
+		c.Delete(ctx, "404")
+		if v, _ := c.Get(ctx, "404", load("404")); v == nil || v.ID != 404 {
+			t.Errorf("Get() after create = %v", v)
+		}
+	})
+
+	t.Run("invalidate after commit", func(t *testing.T) {
+		loads = 0
+		err := d.InTx(ctx, func(ctx context.Context) error {
+			rows["1"] = &cachedRow{ID: 1, Tags: []string{"新"}}
+			c.Delete(ctx, "1")
+			// 事务内不读写缓存
+			if _, err := c.Get(ctx, "1", load("1")); err != nil || mr.Exists("cache:row:1") {
+				t.Errorf("Get() in tx filled the cache")
+			}
+			// 提交前别的请求把旧值读回缓存
+			mr.Set("cache:row:1", `{"ID":1,"Tags":["旧"]}`)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mr.Exists("cache:row:1") {
+			t.Error("stale entry survived the commit")
+		}
+		if v, _ := c.Get(ctx, "1", load("1")); v.Tags[0] != "新" {
+			t.Errorf("Get() after commit = %v, want the new row", v)
+		}
+
+		mr.Set("cache:row:1", `{"ID":1,"Tags":["新"]}`)
+		d.InTx(ctx, func(ctx context.Context) error {
+			c.Delete(ctx, "1")
+			mr.Set("cache:row:1", `{"ID":1,"Tags":["新"]}`)
+			return errors.New("rollback")
+		})
+		if !mr.Exists("cache:row:1") {
+			t.Error("rolled back transaction invalidated after the fact")
+		}
+	})
+
+	t.Run("redis down", func(t *testing.T) {
+		mr.Close()
+		loads = 0
+		if v, err := c.Get(ctx, "1", load("1")); err != nil || v == nil {
+			t.Errorf("Get() without redis = %v, %v, want the loaded row", v, err)
+		}
+		if loads != 1 {
+			t.Errorf("loads = %d, want 1", loads)
+		}
+	})
+}
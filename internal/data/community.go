@@ -0,0 +1,141 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type CommunityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCommunityRepo(data *Data, logger log.Logger) biz.CommunityRepo {
+	return &CommunityRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *CommunityRepo) CreateCommunity(ctx context.Context, c *biz.Community) (*biz.Community, error) {
+	if err := r.data.DB(ctx).Omit("Buildings").Create(c).Error; err != nil {
+		return nil, fmt.Errorf("创建小区失败: %v", err)
+	}
+	return c, nil
+}
+
+func (r *CommunityRepo) GetCommunity(ctx context.Context, id uint) (*biz.Community, error) {
+	var c biz.Community
+	err := r.data.DB(ctx).Take(&c, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询小区失败: %v", err)
+	}
+	return &c, nil
+}
+
+func (r *CommunityRepo) UpdateCommunity(ctx context.Context, c *biz.Community) (*biz.Community, error) {
+	if err := r.data.DB(ctx).Omit("Buildings").Save(c).Error; err != nil {
+		return nil, fmt.Errorf("更新小区失败: %v", err)
+	}
+	return c, nil
+}
+
+func (r *CommunityRepo) DeleteCommunity(ctx context.Context, id uint) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		buildings := db.Model(&biz.Building{}).Select("id").Where("community_id = ?", id)
+		if err := db.Where("building_id IN (?)", buildings).Delete(&biz.Unit{}).Error; err != nil {
+			return fmt.Errorf("删除单元失败: %v", err)
+		}
+		if err := db.Where("community_id = ?", id).Delete(&biz.Building{}).Error; err != nil {
+			return fmt.Errorf("删除楼栋失败: %v", err)
+		}
+		if err := db.Delete(&biz.Community{}, id).Error; err != nil {
+			return fmt.Errorf("删除小区失败: %v", err)
+		}
+		return nil
+	})
+}
+
+// SearchCommunities matches the keyword against the name and the JSON alias
+// list; ranking puts exact names first, then prefixes, then shorter names.
+func (r *CommunityRepo) SearchCommunities(ctx context.Context, city, keyword string, limit int) ([]*biz.Community, error) {
+	like := "%" + escapeLike(keyword) + "%"
+	db := r.data.DB(ctx).Where("(name LIKE ? OR aliases LIKE ?)", like, like)
+	if city != "" {
+		db = db.Where("city = ?", city)
+	}
+	var list []*biz.Community
+	err := db.Order(gorm.Expr("CASE WHEN name = ? THEN 0 WHEN name LIKE ? THEN 1 ELSE 2 END, CHAR_LENGTH(name), id",
+		keyword, escapeLike(keyword)+"%")).Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询小区失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *CommunityRepo) CreateBuilding(ctx context.Context, b *biz.Building) (*biz.Building, error) {
+	// 楼栋和单元一起写入
+	if err := r.data.DB(ctx).Create(b).Error; err != nil {
+		return nil, fmt.Errorf("创建楼栋失败: %v", err)
+	}
+	return b, nil
+}
+
+func (r *CommunityRepo) GetBuilding(ctx context.Context, id uint) (*biz.Building, error) {
+	var b biz.Building
+	err := r.data.DB(ctx).Take(&b, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询楼栋失败: %v", err)
+	}
+	return &b, nil
+}
+
+func (r *CommunityRepo) DeleteBuilding(ctx context.Context, id uint) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.Where("building_id = ?", id).Delete(&biz.Unit{}).Error; err != nil {
+			return fmt.Errorf("删除单元失败: %v", err)
+		}
+		if err := db.Delete(&biz.Building{}, id).Error; err != nil {
+			return fmt.Errorf("删除楼栋失败: %v", err)
+		}
+		return nil
+	})
+}
+
+func (r *CommunityRepo) ListBuildings(ctx context.Context, communityID uint) ([]*biz.Building, error) {
+	var list []*biz.Building
+	err := r.data.DB(ctx).Preload("Units", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Where("community_id = ?", communityID).Order("id").Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询楼栋失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *CommunityRepo) ListCommunities(ctx context.Context, afterID uint, limit int) ([]*biz.Community, error) {
+	var list []*biz.Community
+	err := r.data.DB(ctx).Where("id > ?", afterID).Order("id").Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询小区失败: %v", err)
+	}
+	return list, nil
+}
+
+// escapeLike escapes the LIKE wildcards in s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// compareTTL is how long an untouched comparison basket is kept.
+const compareTTL = 7 * 24 * time.Hour
+
+// CompareBasketRepo keeps each basket in a Redis sorted set of listing IDs
+// scored by the time they were added.
+type CompareBasketRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCompareBasketRepo(data *Data, logger log.Logger) biz.CompareBasketRepo {
+	return &CompareBasketRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func compareKey(userID uint) string {
+	return "compare:" + strconv.FormatUint(uint64(userID), 10)
+}
+
+func (r *CompareBasketRepo) AddCompare(ctx context.Context, userID, houseID uint) error {
+	key := compareKey(userID)
+	pipe := r.data.rdb.TxPipeline()
+	pipe.ZAddNX(ctx, key, &redis.Z{Score: float64(time.Now().UnixMilli()), Member: houseID})
+	pipe.Expire(ctx, key, compareTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("加入对比失败: %v", err)
+	}
+	return nil
+}
+
+func (r *CompareBasketRepo) RemoveCompare(ctx context.Context, userID, houseID uint) error {
+	if err := r.data.rdb.ZRem(ctx, compareKey(userID), houseID).Err(); err != nil {
+		return fmt.Errorf("移出对比失败: %v", err)
+	}
+	return nil
+}
+
+func (r *CompareBasketRepo) ListCompare(ctx context.Context, userID uint) ([]uint, error) {
+	members, err := r.data.rdb.ZRange(ctx, compareKey(userID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("查询对比栏失败: %v", err)
+	}
+	ids := make([]uint, 0, len(members))
+	for _, m := range members {
+		if id, err := strconv.ParseUint(m, 10, 64); err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,61 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type SensitiveWordRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSensitiveWordRepo(data *Data, logger log.Logger) biz.SensitiveWordRepo {
+	return &SensitiveWordRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *SensitiveWordRepo) ListWords(ctx context.Context) ([]*biz.SensitiveWord, error) {
+	var list []*biz.SensitiveWord
+	if err := r.data.DB(ctx).Order("id").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询敏感词失败: %v", err)
+	}
+	return list, nil
+}
+
+// SaveWords upserts on the unique word key.
+func (r *SensitiveWordRepo) SaveWords(ctx context.Context, words []*biz.SensitiveWord) error {
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"action", "category", "updated_at"}),
+	}).Create(&words).Error
+	if err != nil {
+		return fmt.Errorf("保存敏感词失败: %v", err)
+	}
+	return nil
+}
+
+// DeleteWord deletes for good, so the word can be added again.
+func (r *SensitiveWordRepo) DeleteWord(ctx context.Context, word string) (bool, error) {
+	res := r.data.DB(ctx).Unscoped().Where("word = ?", word).Delete(&biz.SensitiveWord{})
+	if res.Error != nil {
+		return false, fmt.Errorf("删除敏感词失败: %v", res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// NewContentRules returns the content filter rules: the built-in contact
+// actions overridden by those set in data.content.
+func NewContentRules(c *conf.Data) *biz.ContentRules {
+	r := biz.DefaultContentRules()
+	for scene, action := range c.GetContent().GetContactActions() {
+		r.ContactActions[scene] = action
+	}
+	return r
+}
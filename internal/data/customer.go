@@ -2,7 +2,12 @@ package data
 
 import (
 	"anjuke/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 type CustomerRepo struct {
@@ -16,3 +21,38 @@ func NewCustomerRepo(data *Data, logger log.Logger) biz.CustomerRepo {
 		log:  log.NewHelper(logger),
 	}
 }
+
+func (r *CustomerRepo) CreateCustomer(ctx context.Context, c *biz.Customer) (*biz.Customer, error) {
+	if err := r.data.DB(ctx).Create(c).Error; err != nil {
+		return nil, fmt.Errorf("创建客户失败: %v", err)
+	}
+	return c, nil
+}
+
+func (r *CustomerRepo) GetCustomer(ctx context.Context, id uint) (*biz.Customer, error) {
+	var c biz.Customer
+	err := r.data.DB(ctx).Take(&c, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询客户失败: %v", err)
+	}
+	return &c, nil
+}
+
+func (r *CustomerRepo) UpdateCustomer(ctx context.Context, c *biz.Customer) (*biz.Customer, error) {
+	if err := r.data.DB(ctx).Save(c).Error; err != nil {
+		return nil, fmt.Errorf("更新客户失败: %v", err)
+	}
+	return c, nil
+}
+
+func (r *CustomerRepo) ListCustomersByUser(ctx context.Context, agentID, userID uint) ([]*biz.Customer, error) {
+	var list []*biz.Customer
+	err := r.data.DB(ctx).Where("agent_id = ? AND user_id = ?", agentID, userID).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询客户失败: %v", err)
+	}
+	return list, nil
+}
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"anjuke/internal/biz"
 	"anjuke/internal/conf"
 	"context"
 	"fmt"
@@ -13,23 +14,35 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewUserRepo, NewHouseRepo, NewTransactionRepo, NewPointsRepo, NewCustomerRepo)
+var ProviderSet = wire.NewSet(MysqlInit, ExampleClient, NewData, NewGreeterRepo, NewUserRepo, NewHouseRepo, NewTransactionRepo, NewPointsRepo, NewCustomerRepo, NewCommunityRepo, NewRegionRepo, NewKeywordRepo, NewPriceRepo, NewNotificationRepo, NewFavoriteRepo, NewBrowseHistoryRepo, NewBrowseFlusher, NewCompareBasketRepo,
+	NewTransaction, NewRedisEventBus, wire.Bind(new(biz.EventBus), new(*RedisEventBus)), wire.Bind(new(biz.EventPublisher), new(*RedisEventBus)), NewOutboxRelay,
+	NewRedisLocker, wire.Bind(new(biz.Locker), new(*RedisLocker)), NewHouseSearcher, NewMortgageRates, NewValuationRepo, NewValuationRules, NewStatsRepo, NewModerationRepo, NewModerationRules, NewImageHasher, NewSensitiveWordRepo, NewContentRules, NewLeaseRepo, NewBillRepo, NewBillingRules)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
-	db  *gorm.DB
-	rdb *redis.Client
+	db    *gorm.DB
+	rdb   *redis.Client
+	cache *conf.Data_Cache
 }
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*Data, func(), error) {
+	// 数据库结构落后于代码时拒绝启动
+	m, err := NewMigrator(db, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := m.Check(context.Background()); err != nil {
+		return nil, nil, err
+	}
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
 	return &Data{
-		db:  db,
-		rdb: rdb,
+		db:    db,
+		rdb:   rdb,
+		cache: c.Cache,
 	}, cleanup, nil
 }
 func MysqlInit(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
@@ -37,10 +50,15 @@ func MysqlInit(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
 	datas := &Data{}
 	dsn := c.Database.Source
 	var err error
-	datas.db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	datas.db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger: newGormLogger(c.Database, logger),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("数据库连接失败")
 	}
+	if err = configurePool(datas.db, c.Database); err != nil {
+		return nil, fmt.Errorf("数据库连接池配置失败: %v", err)
+	}
 
 	fmt.Println("数据库连接成功")
 	return datas.db, nil
@@ -48,9 +66,11 @@ func MysqlInit(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
 func ExampleClient(c *conf.Data, logger log.Logger) (*redis.Client, error) {
 	data := &Data{}
 	data.rdb = redis.NewClient(&redis.Options{
-		Addr:     c.Redis.Addr,
-		Password: c.Redis.Password, // no password set
-		DB:       0,                // use default DB
+		Addr:         c.Redis.Addr,
+		Password:     c.Redis.Password, // no password set
+		DB:           0,                // use default DB
+		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
 	})
 	_, err := data.rdb.Ping(context.Background()).Result()
 	if err != nil {
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"database/sql"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// testDriver is SQLite with MySQL's GET_LOCK and RELEASE_LOCK, enough for
+// the repos and the migrator. A held lock makes GET_LOCK return 0 at once
+// instead of waiting out its timeout.
+const testDriver = "sqlite3_anjuke"
+
+var advisoryLocks = struct {
+	sync.Mutex
+	owners map[string]*sqlite3.SQLiteConn
+}{owners: map[string]*sqlite3.SQLiteConn{}}
+
+func init() {
+	sql.Register(testDriver, &sqlite3.SQLiteDriver{ConnectHook: func(c *sqlite3.SQLiteConn) error {
+		if err := c.RegisterFunc("GET_LOCK", func(name string, _ int64) int64 {
+			advisoryLocks.Lock()
+			defer advisoryLocks.Unlock()
+			if owner, ok := advisoryLocks.owners[name]; ok && owner != c {
+				return 0
+			}
+			advisoryLocks.owners[name] = c
+			return 1
+		}, false); err != nil {
+			return err
+		}
+		return c.RegisterFunc("RELEASE_LOCK", func(name string) int64 {
+			advisoryLocks.Lock()
+			defer advisoryLocks.Unlock()
+			if advisoryLocks.owners[name] != c {
+				return 0
+			}
+			delete(advisoryLocks.owners, name)
+			return 1
+		}, false)
+	}})
+}
+
+// newTestDB opens an empty SQLite database in a temp dir.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "anjuke.db") + "?_busy_timeout=5000&_foreign_keys=1"
+	db, err := gorm.Open(sqlite.New(sqlite.Config{DriverName: testDriver, DSN: dsn}), &gorm.Config{Logger: gormlogger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+	return db
+}
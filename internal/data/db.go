@@ -0,0 +1,166 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
+)
+
+type primaryKey struct{}
+
+type txKey struct{}
+
+// txScope is the transaction carried in ctx by InTx. Each level, savepoints
+// included, collects its own hooks; a level that succeeds hands them to its
+// parent and the outermost one runs them after commit.
+type txScope struct {
+	db    *gorm.DB
+	hooks []func()
+}
+
+func txFrom(ctx context.Context) (*txScope, bool) {
+	s, ok := ctx.Value(txKey{}).(*txScope)
+	return s, ok
+}
+
+// ForcePrimary marks ctx so every query issued through Data.DB goes to the
+// primary. Use it right after a write when replica lag could return stale rows.
+func ForcePrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// DB returns a session bound to ctx. Inside InTx it is the transaction;
+// otherwise reads are routed to a replica and writes to the primary, unless
+// ctx was marked by ForcePrimary.
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if s, ok := txFrom(ctx); ok {
+		return s.db.WithContext(ctx)
+	}
+	if force, _ := ctx.Value(primaryKey{}).(bool); force {
+		return d.Primary(ctx)
+	}
+	return d.db.WithContext(ctx)
+}
+
+// Primary returns a session bound to ctx that always uses the primary.
+func (d *Data) Primary(ctx context.Context) *gorm.DB {
+	if s, ok := txFrom(ctx); ok {
+		return s.db.WithContext(ctx)
+	}
+	return d.db.WithContext(ctx).Clauses(dbresolver.Write)
+}
+
+// NewTransaction exposes Data as the biz unit of work.
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// InTx implements biz.Transaction. The transaction runs on the primary and
+// travels in ctx; when ctx already carries one, GORM opens a savepoint. GORM
+// also rolls back when fn panics and re-raises the panic.
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	outer, nested := txFrom(ctx)
+	var s *txScope
+	err := d.Primary(ctx).Transaction(func(tx *gorm.DB) error {
+		s = &txScope{db: tx}
+		return fn(context.WithValue(ctx, txKey{}, s))
+	})
+	if err != nil {
+		// 回滚的保存点丢弃自己的 hooks，不影响外层已登记的
+		return err
+	}
+	if nested {
+		outer.hooks = append(outer.hooks, s.hooks...)
+		return nil
+	}
+	for _, h := range s.hooks {
+		h()
+	}
+	return nil
+}
+
+// afterCommit runs fn once the transaction carried by ctx has committed, or
+// right away outside a transaction. Hooks of a rolled back transaction are
+// dropped.
+func afterCommit(ctx context.Context, fn func()) {
+	if s, ok := txFrom(ctx); ok {
+		s.hooks = append(s.hooks, fn)
+		return
+	}
+	fn()
+}
+
+// configurePool applies the pool limits to the primary and registers the
+// read replicas, which share the same limits.
+func configurePool(db *gorm.DB, c *conf.Data_Database) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if c.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(int(c.MaxOpenConns))
+	}
+	if c.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(int(c.MaxIdleConns))
+	}
+	if c.ConnMaxLifetime != nil {
+		sqlDB.SetConnMaxLifetime(c.ConnMaxLifetime.AsDuration())
+	}
+	if c.ConnMaxIdleTime != nil {
+		sqlDB.SetConnMaxIdleTime(c.ConnMaxIdleTime.AsDuration())
+	}
+	if len(c.Replicas) == 0 {
+		return nil
+	}
+
+	replicas := make([]gorm.Dialector, 0, len(c.Replicas))
+	for _, dsn := range c.Replicas {
+		replicas = append(replicas, mysql.Open(dsn))
+	}
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	})
+	if c.MaxOpenConns > 0 {
+		resolver.SetMaxOpenConns(int(c.MaxOpenConns))
+	}
+	if c.MaxIdleConns > 0 {
+		resolver.SetMaxIdleConns(int(c.MaxIdleConns))
+	}
+	if c.ConnMaxLifetime != nil {
+		resolver.SetConnMaxLifetime(c.ConnMaxLifetime.AsDuration())
+	}
+	if c.ConnMaxIdleTime != nil {
+		resolver.SetConnMaxIdleTime(c.ConnMaxIdleTime.AsDuration())
+	}
+	return db.Use(resolver)
+}
+
+// gormWriter routes GORM's own log lines (slow queries, errors) to kratos.
+type gormWriter struct {
+	log *log.Helper
+}
+
+func (w gormWriter) Printf(format string, args ...interface{}) {
+	w.log.Warnf(format, args...)
+}
+
+func newGormLogger(c *conf.Data_Database, logger log.Logger) gormlogger.Interface {
+	slow := 200 * time.Millisecond
+	if c.SlowThreshold != nil {
+		slow = c.SlowThreshold.AsDuration()
+	}
+	return gormlogger.New(gormWriter{log: log.NewHelper(logger)}, gormlogger.Config{
+		SlowThreshold:             slow,
+		LogLevel:                  gormlogger.Warn,
+		IgnoreRecordNotFoundError: true,
+	})
+}
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type txNote struct {
+	ID   uint
+	Text string
+}
+
+func TestData_InTx(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&txNote{}); err != nil {
+		t.Fatal(err)
+	}
+	d := &Data{db: db}
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	var ran []string
+	hook := func(ctx context.Context, name string) {
+		afterCommit(ctx, func() { ran = append(ran, name) })
+	}
+	insert := func(ctx context.Context, text string) error {
+		return d.DB(ctx).Create(&txNote{Text: text}).Error
+	}
+	count := func(text string) int64 {
+		var n int64
+		db.Model(&txNote{}).Where("text = ?", text).Count(&n)
+		return n
+	}
+
+	tests := []struct {
+		name      string
+		fn        func(ctx context.Context) error
+		wantErr   bool
+		wantPanic bool
+		wantRan   []string
+		wantRows  map[string]int64
+	}{
+		{"commit", func(ctx context.Context) error {
+			hook(ctx, "outer")
+			if err := insert(ctx, "commit"); err != nil {
+				return err
+			}
+			// 提交前不执行
+			if len(ran) != 0 {
+				t.Error("hook ran before commit")
+			}
+			return nil
+		}, false, false, []string{"outer"}, map[string]int64{"commit": 1}},
+		{"rollback", func(ctx context.Context) error {
+			hook(ctx, "outer")
+			insert(ctx, "rollback")
+			return errBoom
+		}, true, false, nil, map[string]int64{"rollback": 0}},
+		{"nested rollback", func(ctx context.Context) error {
+			hook(ctx, "outer")
+			insert(ctx, "kept")
+			err := d.InTx(ctx, func(ctx context.Context) error {
+				hook(ctx, "savepoint")
+				insert(ctx, "undone")
+				return errBoom
+			})
+			if err != errBoom {
+				t.Errorf("nested InTx() error = %v, want errBoom", err)
+			}
+			return d.InTx(ctx, func(ctx context.Context) error {
+				hook(ctx, "inner")
+				return d.InTx(ctx, func(ctx context.Context) error {
+					hook(ctx, "innermost")
+					return nil
+				})
+			})
+		}, false, false, []string{"outer", "inner", "innermost"}, map[string]int64{"kept": 1, "undone": 0}},
+		{"nested commit in rolled back outer", func(ctx context.Context) error {
+			if err := d.InTx(ctx, func(ctx context.Context) error {
+				hook(ctx, "inner")
+				return insert(ctx, "outer rolled back")
+			}); err != nil {
+				return err
+			}
+			return errBoom
+		}, true, false, nil, map[string]int64{"outer rolled back": 0}},
+		{"panic", func(ctx context.Context) error {
+			hook(ctx, "outer")
+			insert(ctx, "panic")
+			panic("boom")
+		}, false, true, nil, map[string]int64{"panic": 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ran = nil
+			func() {
+				defer func() {
+					if r := recover(); (r != nil) != tt.wantPanic {
+						t.Errorf("recover() = %v, want panic %v", r, tt.wantPanic)
+					}
+				}()
+				if err := d.InTx(ctx, tt.fn); (err != nil) != tt.wantErr {
+					t.Errorf("InTx() error = %v, want error %v", err, tt.wantErr)
+				}
+			}()
+			if len(ran) != len(tt.wantRan) {
+				t.Fatalf("hooks ran = %v, want %v", ran, tt.wantRan)
+			}
+			for i := range ran {
+				if ran[i] != tt.wantRan[i] {
+					t.Errorf("hooks ran = %v, want %v", ran, tt.wantRan)
+				}
+			}
+			for text, want := range tt.wantRows {
+				if got := count(text); got != want {
+					t.Errorf("rows %q = %d, want %d", text, got, want)
+				}
+			}
+		})
+	}
+
+	// 事务外立即执行
+	ran = nil
+	hook(ctx, "now")
+	if len(ran) != 1 {
+		t.Errorf("hook outside a transaction ran = %v, want at once", ran)
+	}
+}
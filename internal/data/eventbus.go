@@ -0,0 +1,321 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// streamMaxLen caps every topic stream; consumers lagging further behind
+	// lose events, so keep it well above the normal backlog.
+	streamMaxLen = 100000
+	// streamBlock is how long a consumer waits for new events per read.
+	streamBlock = 2 * time.Second
+	// streamRetry is how long a failed event waits before redelivery.
+	streamRetry = 5 * time.Second
+	// streamMaxAttempts is how often an event is delivered to a consumer
+	// before it is given up on and moved to deadLetterStream.
+	streamMaxAttempts = 10
+	// deadLetterStream keeps the events no consumer could handle, with the
+	// consumer and its last error, for inspection and manual replay.
+	deadLetterStream = "events:dead"
+)
+
+// eventConsumption records that a consumer has handled an event, so
+// redeliveries are skipped.
+type eventConsumption struct {
+	ID        uint   `gorm:"primaryKey"`
+	Consumer  string `gorm:"size:64;uniqueIndex:uk_event_consumptions"`
+	EventID   string `gorm:"size:32;uniqueIndex:uk_event_consumptions"`
+	CreatedAt time.Time
+}
+
+func (eventConsumption) TableName() string { return "event_consumptions" }
+
+type streamSubscription struct {
+	topic    string
+	consumer string
+	h        biz.EventHandler
+}
+
+// RedisEventBus is the biz.EventBus on Redis Streams. Each topic is a stream
+// and each consumer a consumer group, so every instance of a consumer shares
+// the work. Events are acknowledged only after their handler succeeds.
+type RedisEventBus struct {
+	data *Data
+	rdb  *redis.Client
+	log  *log.Helper
+	// name identifies this instance within every consumer group.
+	name string
+	// block, retry and maxAttempts are streamBlock, streamRetry and
+	// streamMaxAttempts; tests shorten them.
+	block       time.Duration
+	retry       time.Duration
+	maxAttempts int64
+
+	mu       sync.Mutex
+	subs     []*streamSubscription
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRedisEventBus new a RedisEventBus.
+func NewRedisEventBus(data *Data, rdb *redis.Client, logger log.Logger) *RedisEventBus {
+	host, _ := os.Hostname()
+	return &RedisEventBus{
+		data: data,
+		rdb:  rdb,
+		log:  log.NewHelper(logger),
+		name: fmt.Sprintf("%s-%d", host, os.Getpid()),
+		stop: make(chan struct{}),
+
+		block:       streamBlock,
+		retry:       streamRetry,
+		maxAttempts: streamMaxAttempts,
+	}
+}
+
+func streamName(topic string) string {
+	return "events:" + topic
+}
+
+// Publish appends e to the topic's stream.
+func (b *RedisEventBus) Publish(ctx context.Context, e *biz.Event) error {
+	return b.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: streamName(e.Topic),
+		MaxLen: streamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"id":          e.ID,
+			"key":         e.Key,
+			"payload":     e.Payload,
+			"occurred_at": e.OccurredAt.UnixMilli(),
+		},
+	}).Err()
+}
+
+// Subscribe registers h; it takes effect when the bus is started.
+func (b *RedisEventBus) Subscribe(topic, consumer string, h biz.EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs = append(b.subs, &streamSubscription{topic: topic, consumer: consumer, h: b.dedup(consumer, h)})
+}
+
+// Position returns the ID of the last event in the topic's stream, or "0"
+// when the stream is empty.
+func (b *RedisEventBus) Position(ctx context.Context, topic string) (string, error) {
+	msgs, err := b.rdb.XRevRangeN(ctx, streamName(topic), "+", "-", 1).Result()
+	if err != nil {
+		return "", err
+	}
+	if len(msgs) == 0 {
+		return "0", nil
+	}
+	return msgs[0].ID, nil
+}
+
+// Seek recreates the consumer group at pos. Its pending events are dropped
+// with it, so seek only while the consumer is not running.
+func (b *RedisEventBus) Seek(ctx context.Context, topic, consumer, pos string) error {
+	stream := streamName(topic)
+	err := b.rdb.XGroupCreateMkStream(ctx, stream, consumer, pos).Err()
+	if err == nil || !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	if err := b.rdb.XGroupDestroy(ctx, stream, consumer).Err(); err != nil {
+		return err
+	}
+	return b.rdb.XGroupCreate(ctx, stream, consumer, pos).Err()
+}
+
+// Start consumes every subscription until Stop is called or ctx is done.
+func (b *RedisEventBus) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-b.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	b.mu.Lock()
+	subs := append([]*streamSubscription{}, b.subs...)
+	b.mu.Unlock()
+	var wg sync.WaitGroup
+	for _, s := range subs {
+		wg.Add(1)
+		go func(s *streamSubscription) {
+			defer wg.Done()
+			b.consume(ctx, s)
+		}(s)
+	}
+	wg.Wait()
+	return nil
+}
+
+// Stop stops the consumers.
+func (b *RedisEventBus) Stop(context.Context) error {
+	b.stopOnce.Do(func() { close(b.stop) })
+	return nil
+}
+
+func (b *RedisEventBus) consume(ctx context.Context, s *streamSubscription) {
+	stream := streamName(s.topic)
+	err := b.rdb.XGroupCreateMkStream(ctx, stream, s.consumer, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		b.log.Errorf("event bus: create group %s on %s: %v", s.consumer, stream, err)
+	}
+
+	// 启动时先重投本实例未确认的事件，之后处理失败的事件每隔 retry 重投一次
+	pending := true
+	var retryAt time.Time
+	for ctx.Err() == nil {
+		var msgs []redis.XMessage
+		attempts := map[string]int64{}
+		if pending {
+			msgs, attempts, err = b.claimPending(ctx, stream, s.consumer)
+		} else {
+			var res []redis.XStream
+			res, err = b.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+				Group:    s.consumer,
+				Consumer: b.name,
+				Streams:  []string{stream, ">"},
+				Count:    outboxBatch,
+				Block:    b.block,
+			}).Result()
+			for _, st := range res {
+				msgs = append(msgs, st.Messages...)
+			}
+		}
+		if err != nil && err != redis.Nil {
+			if ctx.Err() == nil {
+				b.log.Errorf("event bus: read %s: %v", stream, err)
+				time.Sleep(time.Second)
+			}
+			continue
+		}
+		pending = false
+		for _, m := range msgs {
+			err := s.h(ctx, streamEvent(s.topic, m))
+			if err == nil {
+				if err := b.rdb.XAck(ctx, stream, s.consumer, m.ID).Err(); err != nil {
+					b.log.Errorf("event bus: ack %s: %v", m.ID, err)
+				}
+				continue
+			}
+			n := attempts[m.ID]
+			if n == 0 {
+				n = 1
+			}
+			if n >= b.maxAttempts {
+				b.deadLetter(ctx, s, m, err)
+				continue
+			}
+			b.log.Errorf("event bus: %s handle %s (attempt %d): %v", s.consumer, m.ID, n, err)
+			retryAt = time.Now().Add(b.retry)
+		}
+		if !retryAt.IsZero() && time.Now().After(retryAt) {
+			pending, retryAt = true, time.Time{}
+		}
+	}
+}
+
+// claimPending redelivers this instance's unacknowledged events of the
+// consumer group. XCLAIM bumps each event's delivery count, so the returned
+// attempts include the current one.
+func (b *RedisEventBus) claimPending(ctx context.Context, stream, group string) ([]redis.XMessage, map[string]int64, error) {
+	pend, err := b.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream:   stream,
+		Group:    group,
+		Start:    "-",
+		End:      "+",
+		Count:    outboxBatch,
+		Consumer: b.name,
+	}).Result()
+	if err != nil || len(pend) == 0 {
+		return nil, nil, err
+	}
+	ids := make([]string, 0, len(pend))
+	attempts := make(map[string]int64, len(pend))
+	for _, p := range pend {
+		ids = append(ids, p.ID)
+		attempts[p.ID] = p.RetryCount + 1
+	}
+	msgs, err := b.rdb.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: b.name,
+		Messages: ids,
+	}).Result()
+	return msgs, attempts, err
+}
+
+// deadLetter gives up on m: it is copied to deadLetterStream and
+// acknowledged so the consumer moves on.
+func (b *RedisEventBus) deadLetter(ctx context.Context, s *streamSubscription, m redis.XMessage, cause error) {
+	values := map[string]interface{}{
+		"topic":     s.topic,
+		"consumer":  s.consumer,
+		"stream_id": m.ID,
+		"error":     cause.Error(),
+	}
+	for k, v := range m.Values {
+		values[k] = v
+	}
+	err := b.rdb.XAdd(ctx, &redis.XAddArgs{Stream: deadLetterStream, MaxLen: streamMaxLen, Approx: true, Values: values}).Err()
+	if err != nil {
+		b.log.Errorf("event bus: dead letter %s: %v", m.ID, err)
+		return
+	}
+	b.log.Errorf("event bus: %s gave up on %s %s after %d attempts, moved to %s: %v", s.consumer, s.topic, m.ID, b.maxAttempts, deadLetterStream, cause)
+	if err := b.rdb.XAck(ctx, streamName(s.topic), s.consumer, m.ID).Err(); err != nil {
+		b.log.Errorf("event bus: ack %s: %v", m.ID, err)
+	}
+}
+
+func streamEvent(topic string, m redis.XMessage) *biz.Event {
+	str := func(k string) string {
+		v, _ := m.Values[k].(string)
+		return v
+	}
+	var ms int64
+	fmt.Sscan(str("occurred_at"), &ms)
+	return &biz.Event{
+		ID:         str("id"),
+		Topic:      topic,
+		Key:        str("key"),
+		Payload:    []byte(str("payload")),
+		OccurredAt: time.UnixMilli(ms),
+	}
+}
+
+// dedup skips events the consumer has already handled. The handler runs in a
+// transaction together with the consumption record, so its database changes
+// are applied exactly once.
+func (b *RedisEventBus) dedup(consumer string, h biz.EventHandler) biz.EventHandler {
+	return func(ctx context.Context, e *biz.Event) error {
+		return b.data.InTx(ctx, func(ctx context.Context) error {
+			res := b.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&eventConsumption{Consumer: consumer, EventID: e.ID})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return nil
+			}
+			return h(ctx, e)
+		})
+	}
+}
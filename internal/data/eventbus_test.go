@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+func TestRedisEventBus_DeadLetter(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&eventConsumption{}); err != nil {
+		t.Fatal(err)
+	}
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	bus := NewRedisEventBus(&Data{db: db, rdb: rdb}, rdb, log.DefaultLogger)
+	bus.block, bus.retry, bus.maxAttempts = 20*time.Millisecond, 10*time.Millisecond, 3
+
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	bus.Subscribe("test.happened", "flaky", func(ctx context.Context, e *biz.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[e.ID]++
+		// poison 永远失败，flaky 第二次成功
+		if e.ID == "poison" || attempts[e.ID] < 2 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	ctx := context.Background()
+	for _, id := range []string{"poison", "flaky"} {
+		if err := bus.Publish(ctx, &biz.Event{ID: id, Topic: "test.happened", Key: "1", Payload: []byte(`{}`), OccurredAt: time.Now()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	go bus.Start(ctx)
+	defer bus.Stop(ctx)
+
+	// 等到两个事件都被确认：flaky 重试后成功，poison 移入死信
+	var dead []redis.XMessage
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("events not settled, dead letters = %v", dead)
+		}
+		dead, _ = rdb.XRange(ctx, deadLetterStream, "-", "+").Result()
+		pending, _ := rdb.XPending(ctx, streamName("test.happened"), "flaky").Result()
+		if len(dead) > 0 && pending != nil && pending.Count == 0 {
+			break
+		}
+	}
+	v := dead[0].Values
+	if len(dead) != 1 || v["id"] != "poison" || v["topic"] != "test.happened" || v["consumer"] != "flaky" || v["error"] != "boom" {
+		t.Errorf("dead letters = %v, want the poison event with its consumer and error", dead)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if attempts["poison"] != 3 || attempts["flaky"] != 2 {
+		t.Errorf("attempts = %v, want poison given up after 3 and flaky handled on the 2nd", attempts)
+	}
+}
+
+func TestRedisEventBus_Seek(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&eventConsumption{}); err != nil {
+		t.Fatal(err)
+	}
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	bus := NewRedisEventBus(&Data{db: db, rdb: rdb}, rdb, log.DefaultLogger)
+	bus.block = 20 * time.Millisecond
+
+	ctx := context.Background()
+	if pos, err := bus.Position(ctx, "test.happened"); err != nil || pos != "0" {
+		t.Fatalf("Position of a missing stream = %q, %v, want 0", pos, err)
+	}
+	publish := func(id string) {
+		if err := bus.Publish(ctx, &biz.Event{ID: id, Topic: "test.happened", Key: "1", Payload: []byte(`{}`), OccurredAt: time.Now()}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	publish("old")
+	// 消费组已经存在，停在最早的位置
+	if err := bus.Seek(ctx, "test.happened", "index", "0"); err != nil {
+		t.Fatal(err)
+	}
+	publish("indexed")
+	pos, err := bus.Position(ctx, "test.happened")
+	if err != nil {
+		t.Fatal(err)
+	}
+	publish("new")
+	if err := bus.Seek(ctx, "test.happened", "index", pos); err != nil {
+		t.Fatal(err)
+	}
+
+	handled := make(chan string, 3)
+	bus.Subscribe("test.happened", "index", func(ctx context.Context, e *biz.Event) error {
+		handled <- e.ID
+		return nil
+	})
+	go bus.Start(ctx)
+	defer bus.Stop(ctx)
+	select {
+	case id := <-handled:
+		if id != "new" {
+			t.Errorf("first event after Seek = %s, want new", id)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event delivered after Seek")
+	}
+}
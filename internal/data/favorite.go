@@ -0,0 +1,94 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type FavoriteRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewFavoriteRepo(data *Data, logger log.Logger) biz.FavoriteRepo {
+	return &FavoriteRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SaveFavorite upserts on the unique (user_id, house_id) key, then reads
+// the row back since MySQL reports no ID for an update.
+func (r *FavoriteRepo) SaveFavorite(ctx context.Context, f *biz.Favorite) (*biz.Favorite, error) {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		err := r.data.DB(ctx).Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"folder", "note", "updated_at"}),
+		}).Create(&biz.Favorite{UserID: f.UserID, HouseID: f.HouseID, Folder: f.Folder, Note: f.Note}).Error
+		if err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Where("user_id = ? AND house_id = ?", f.UserID, f.HouseID).Take(f).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("收藏房源失败: %v", err)
+	}
+	return f, nil
+}
+
+func (r *FavoriteRepo) DeleteFavorite(ctx context.Context, userID, houseID uint) error {
+	// 物理删除，以免软删除的行占住唯一键
+	err := r.data.DB(ctx).Unscoped().Where("user_id = ? AND house_id = ?", userID, houseID).Delete(&biz.Favorite{}).Error
+	if err != nil {
+		return fmt.Errorf("取消收藏失败: %v", err)
+	}
+	return nil
+}
+
+func (r *FavoriteRepo) ListFavorites(ctx context.Context, userID uint, folder string) ([]*biz.Favorite, error) {
+	var list []*biz.Favorite
+	db := r.data.DB(ctx).Where("user_id = ?", userID)
+	if folder != "" {
+		db = db.Where("folder = ?", folder)
+	}
+	if err := db.Order("id DESC").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询收藏失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *FavoriteRepo) ListFolders(ctx context.Context, userID uint) ([]*biz.FavoriteFolder, error) {
+	var list []*biz.FavoriteFolder
+	err := r.data.DB(ctx).Model(&biz.Favorite{}).
+		Select("folder AS name, COUNT(*) AS `count`").
+		Where("user_id = ?", userID).
+		Group("folder").Order("MIN(id)").
+		Scan(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询收藏夹失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *FavoriteRepo) CountFavorites(ctx context.Context, houseIDs []uint) (map[uint]int64, error) {
+	var rows []struct {
+		HouseID uint
+		N       int64
+	}
+	err := r.data.DB(ctx).Model(&biz.Favorite{}).
+		Select("house_id, COUNT(*) AS n").
+		Where("house_id IN ?", houseIDs).
+		Group("house_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("统计收藏数失败: %v", err)
+	}
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.HouseID] = row.N
+	}
+	return counts, nil
+}
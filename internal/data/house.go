@@ -2,17 +2,143 @@ package data
 
 import (
 	"anjuke/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type HouseRepo struct {
-	data *Data
-	log  *log.Helper
+	data  *Data
+	cache *Cache[biz.House]
+	log   *log.Helper
 }
 
 func NewHouseRepo(data *Data, logger log.Logger) biz.HouseRepo {
 	return &HouseRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:  data,
+		cache: NewCache[biz.House](data.rdb, "house", data.cache, logger),
+		log:   log.NewHelper(logger),
+	}
+}
+
+func houseKey(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+func (r *HouseRepo) CreateHouse(ctx context.Context, h *biz.House) (*biz.House, error) {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Create(h).Error; err != nil {
+			return err
+		}
+		return r.changed(ctx, h.ID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建房源失败: %v", err)
+	}
+	// 清掉创建前可能缓存的“不存在”
+	r.cache.Delete(ctx, houseKey(h.ID))
+	return h, nil
+}
+
+// GetHouse is cached; cache fills read the primary so replica lag is never
+// cached.
+func (r *HouseRepo) GetHouse(ctx context.Context, id uint) (*biz.House, error) {
+	return r.cache.Get(ctx, houseKey(id), func(ctx context.Context) (*biz.House, error) {
+		return r.getHouse(ForcePrimary(ctx), id)
+	})
+}
+
+func (r *HouseRepo) getHouse(ctx context.Context, id uint) (*biz.House, error) {
+	var h biz.House
+	err := r.data.DB(ctx).Take(&h, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询房源失败: %v", err)
+	}
+	return &h, nil
+}
+
+// LockHouse takes the row lock with SELECT ... FOR UPDATE; outside a
+// transaction the lock is released at once.
+func (r *HouseRepo) LockHouse(ctx context.Context, id uint) (*biz.House, error) {
+	var h biz.House
+	err := r.data.Primary(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Take(&h, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询房源失败: %v", err)
+	}
+	return &h, nil
+}
+
+// GetHouses bypasses the cache: one query is cheaper than a cache round
+// trip per listing.
+func (r *HouseRepo) GetHouses(ctx context.Context, ids []uint) ([]*biz.House, error) {
+	var list []*biz.House
+	if err := r.data.DB(ctx).Where("id IN ?", ids).Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询房源失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *HouseRepo) UpdateHouse(ctx context.Context, h *biz.House) (*biz.House, error) {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Save(h).Error; err != nil {
+			return err
+		}
+		return r.changed(ctx, h.ID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("更新房源失败: %v", err)
+	}
+	r.cache.Delete(ctx, houseKey(h.ID))
+	return h, nil
+}
+
+func (r *HouseRepo) ListHouses(ctx context.Context, afterID uint, limit int) ([]*biz.House, error) {
+	var list []*biz.House
+	err := r.data.DB(ctx).Where("id > ?", afterID).Order("id").Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询房源失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *HouseRepo) ListOnSale(ctx context.Context, communityID uint, city, district string, limit int) ([]*biz.House, error) {
+	db := r.data.DB(ctx).Where("listing_type = ? AND status = ?", biz.ListingSale, biz.HouseOnSale)
+	if communityID != 0 {
+		db = db.Where("community_id = ?", communityID)
+	} else {
+		db = db.Where("city = ? AND district = ?", city, district)
+	}
+	var list []*biz.House
+	if err := db.Order("id DESC").Limit(limit).Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询在售房源失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *HouseRepo) ListRooms(ctx context.Context, parentID uint) ([]*biz.House, error) {
+	var list []*biz.House
+	if err := r.data.DB(ctx).Where("parent_id = ?", parentID).Order("id").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询合租房间失败: %v", err)
+	}
+	return list, nil
+}
+
+// changed records a HouseChangedEvent in the transaction of ctx.
+func (r *HouseRepo) changed(ctx context.Context, id uint) error {
+	e, err := biz.NewEvent(&biz.HouseChangedEvent{HouseID: id})
+	if err != nil {
+		return err
 	}
+	return writeOutbox(r.data.DB(ctx), []*biz.Event{e})
 }
@@ -0,0 +1,54 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+	"anjuke/internal/data/memory"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestHouseUsecase_LeaseSignedKeepsPrice(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&biz.House{}, &biz.PriceChange{}, &outboxEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	_, rdb := newTestRedis(t)
+	d := &Data{db: db, rdb: rdb, cache: &conf.Data_Cache{Ttl: durationpb.New(time.Hour), NegativeTtl: durationpb.New(time.Minute)}}
+	houses := NewHouseRepo(d, log.DefaultLogger)
+	bus := memory.NewEventBus()
+	biz.NewHouseUsecase(houses, NewCommunityRepo(d, log.DefaultLogger), nil, NewPriceRepo(d, log.DefaultLogger), nil,
+		NewTransaction(d), bus, log.DefaultLogger)
+	ctx := context.Background()
+
+	h, err := houses.CreateHouse(ctx, &biz.House{Title: "整租 一室", ListingType: biz.ListingRent, Price: 5000, Area: 50, OwnerID: 1, PayMonths: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 缓存里还是原价，库里已经降价
+	if _, err := houses.GetHouse(ctx, h.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Model(&biz.House{}).Where("id = ?", h.ID).Updates(map[string]interface{}{"price": 4500, "monthly_rent": 4500}).Error; err != nil {
+		t.Fatal(err)
+	}
+	e, err := biz.NewEvent(&biz.LeaseSignedEvent{LeaseID: 1, HouseID: h.ID, TenantID: 2, StartDate: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Publish(ctx, e); err != nil {
+		t.Fatal(err)
+	}
+	var got biz.House
+	if err := db.Take(&got, h.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != biz.HouseRented || got.Price != 4500 {
+		t.Errorf("house = status %d, price %d, want rented at the new price 4500", got.Status, got.Price)
+	}
+}
@@ -0,0 +1,165 @@
+// Package imagehash is the HTTP implementation of biz.ImageHasher.
+//
+// Images are hashed with dHash: the image is shrunk to 9×8 grey pixels and
+// each bit tells whether a pixel is brighter than its right neighbour. The
+// hash survives rescaling, recompression and small edits such as a
+// watermark, so reposted photos stay within a few bits of each other.
+package imagehash
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF
+	_ "image/jpeg" // register JPEG
+	_ "image/png"  // register PNG
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+const (
+	// maxImageBytes bounds the size of an image read for hashing.
+	maxImageBytes = 10 << 20
+	// maxImagePixels bounds the size of an image decoded for hashing; a
+	// small file can declare enough pixels to exhaust memory once decoded.
+	maxImagePixels = 40_000_000
+)
+
+// Hasher downloads images and hashes them.
+type Hasher struct {
+	client *http.Client
+}
+
+// New returns a Hasher whose downloads time out after timeout. The image
+// URLs come from listings, so it only fetches http and https URLs that
+// resolve to public addresses, redirects included.
+func New(timeout time.Duration) *Hasher {
+	dialer := &net.Dialer{Timeout: timeout, Control: publicOnly}
+	return &Hasher{client: &http.Client{
+		Timeout:       timeout,
+		Transport:     &http.Transport{DialContext: dialer.DialContext},
+		CheckRedirect: checkRedirect,
+	}}
+}
+
+// NewInsecure is New without the address check, for tests that serve their
+// images from a local server.
+func NewInsecure(timeout time.Duration) *Hasher {
+	return &Hasher{client: &http.Client{Timeout: timeout, CheckRedirect: checkRedirect}}
+}
+
+// publicOnly refuses connections to loopback, private, link-local and
+// other non-public addresses. It runs after name resolution, so a host name
+// resolving to such an address is refused as well.
+func publicOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return fmt.Errorf("图片地址 %s 不是公网地址", host)
+	}
+	return nil
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return fmt.Errorf("图片地址重定向次数过多")
+	}
+	return checkScheme(req.URL)
+}
+
+func checkScheme(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("图片地址只支持 http 和 https: %s", u.Redacted())
+	}
+	return nil
+}
+
+func (h *Hasher) Hash(ctx context.Context, rawURL string) (uint64, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0, err
+	}
+	if err := checkScheme(u); err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("下载图片失败: %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageBytes))
+	if err != nil {
+		return 0, fmt.Errorf("下载图片失败: %v", err)
+	}
+	// 先只读图片头，像素数超限的图片不解码
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("解析图片失败: %v", err)
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > maxImagePixels {
+		return 0, fmt.Errorf("图片过大: %d×%d", cfg.Width, cfg.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("解析图片失败: %v", err)
+	}
+	return DHash(img), nil
+}
+
+// DHash returns the difference hash of img.
+func DHash(img image.Image) uint64 {
+	const w, h = 9, 8
+	var grey [h][w]float64
+	b := img.Bounds()
+	for y := 0; y < h; y++ {
+		y0, y1 := b.Min.Y+y*b.Dy()/h, b.Min.Y+(y+1)*b.Dy()/h
+		// 小于 9×8 的图片每格至少取一个像素
+		if y1 == y0 {
+			y1++
+		}
+		for x := 0; x < w; x++ {
+			x0, x1 := b.Min.X+x*b.Dx()/w, b.Min.X+(x+1)*b.Dx()/w
+			if x1 == x0 {
+				x1++
+			}
+			grey[y][x] = average(img, x0, y0, x1, y1)
+		}
+	}
+	var hash uint64
+	for y := 0; y < h; y++ {
+		for x := 0; x < w-1; x++ {
+			hash <<= 1
+			if grey[y][x] > grey[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// average returns the mean luminance of the pixels in [x0,x1)×[y0,y1).
+func average(img image.Image, x0, y0, x1, y1 int) float64 {
+	var sum float64
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			sum += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+		}
+	}
+	return sum / float64((x1-x0)*(y1-y0))
+}
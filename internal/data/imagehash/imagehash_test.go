@@ -0,0 +1,61 @@
+package imagehash
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/gif"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHash_RefusesLocalAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("image fetched from %s", r.URL)
+	}))
+	defer srv.Close()
+
+	h := New(time.Second)
+	for _, url := range []string{
+		srv.URL,
+		"http://169.254.169.254/latest/meta-data/",
+		"http://10.0.0.1/a.jpg",
+		"http://[::1]/a.jpg",
+		"file:///etc/passwd",
+		"gopher://example.com/",
+	} {
+		if _, err := h.Hash(context.Background(), url); err == nil {
+			t.Errorf("Hash(%s) succeeded, want refused", url)
+		}
+	}
+}
+
+func TestHash_RefusesHugeImages(t *testing.T) {
+	var buf bytes.Buffer
+	if err := gif.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1)), nil); err != nil {
+		t.Fatal(err)
+	}
+	small := append([]byte{}, buf.Bytes()...)
+	// GIF 头部的画布尺寸改成 65535×65535，文件本身仍然只有几十字节
+	huge := buf.Bytes()
+	huge[6], huge[7], huge[8], huge[9] = 0xff, 0xff, 0xff, 0xff
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/huge.gif" {
+			w.Write(huge)
+			return
+		}
+		w.Write(small)
+	}))
+	defer srv.Close()
+
+	h := NewInsecure(time.Second)
+	if _, err := h.Hash(context.Background(), srv.URL+"/huge.gif"); err == nil || !strings.Contains(err.Error(), "图片过大") {
+		t.Errorf("Hash(huge) error = %v, want refused as too large", err)
+	}
+	if _, err := h.Hash(context.Background(), srv.URL+"/small.gif"); err != nil {
+		t.Errorf("Hash(small) error = %v", err)
+	}
+}
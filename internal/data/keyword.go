@@ -0,0 +1,112 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// keywordWindow is how far back searches count towards trending.
+	keywordWindow = 24 * time.Hour
+	// keywordHotTTL is how long the merged window is reused before it is
+	// merged again from the hourly buckets.
+	keywordHotTTL = time.Minute
+	keywordHotKey = "search:keywords:hot"
+)
+
+// KeywordRepo counts searches in hourly Redis sorted sets; the trending
+// keywords are the union of the buckets in the window.
+type KeywordRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewKeywordRepo(data *Data, logger log.Logger) biz.KeywordRepo {
+	return &KeywordRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func keywordBucket(t time.Time) string {
+	return "search:keywords:" + t.Format("2006010215")
+}
+
+func (r *KeywordRepo) RecordKeyword(ctx context.Context, keyword string) error {
+	key := keywordBucket(time.Now())
+	pipe := r.data.rdb.TxPipeline()
+	pipe.ZIncrBy(ctx, key, 1, keyword)
+	pipe.Expire(ctx, key, keywordWindow+time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("记录搜索词失败: %v", err)
+	}
+	return nil
+}
+
+func (r *KeywordRepo) HotKeywords(ctx context.Context, limit int) ([]*biz.KeywordCount, error) {
+	if err := r.merge(ctx); err != nil {
+		return nil, err
+	}
+	zs, err := r.data.rdb.ZRevRangeWithScores(ctx, keywordHotKey, 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("查询热搜词失败: %v", err)
+	}
+	list := make([]*biz.KeywordCount, 0, len(zs))
+	for _, z := range zs {
+		list = append(list, &biz.KeywordCount{Keyword: z.Member.(string), Count: int64(z.Score)})
+	}
+	return list, nil
+}
+
+func (r *KeywordRepo) KeywordCounts(ctx context.Context, keywords []string) (map[string]int64, error) {
+	counts := map[string]int64{}
+	if len(keywords) == 0 {
+		return counts, nil
+	}
+	if err := r.merge(ctx); err != nil {
+		return nil, err
+	}
+	pipe := r.data.rdb.Pipeline()
+	cmds := make([]*redis.FloatCmd, len(keywords))
+	for i, k := range keywords {
+		cmds[i] = pipe.ZScore(ctx, keywordHotKey, k)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("查询搜索次数失败: %v", err)
+	}
+	for i, cmd := range cmds {
+		if n, err := cmd.Result(); err == nil {
+			counts[keywords[i]] = int64(n)
+		}
+	}
+	return counts, nil
+}
+
+// merge rebuilds the window union once it has expired. Concurrent merges
+// produce the same result, so they are not coordinated.
+func (r *KeywordRepo) merge(ctx context.Context) error {
+	n, err := r.data.rdb.Exists(ctx, keywordHotKey).Result()
+	if err != nil {
+		return fmt.Errorf("查询热搜词失败: %v", err)
+	}
+	if n > 0 {
+		return nil
+	}
+	now := time.Now()
+	keys := make([]string, 0, int(keywordWindow/time.Hour))
+	for d := time.Duration(0); d < keywordWindow; d += time.Hour {
+		keys = append(keys, keywordBucket(now.Add(-d)))
+	}
+	pipe := r.data.rdb.TxPipeline()
+	pipe.ZUnionStore(ctx, keywordHotKey, &redis.ZStore{Keys: keys})
+	pipe.Expire(ctx, keywordHotKey, keywordHotTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("合并热搜词失败: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LeaseRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewLeaseRepo(data *Data, logger log.Logger) biz.LeaseRepo {
+	return &LeaseRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *LeaseRepo) CreateLease(ctx context.Context, l *biz.Lease) (*biz.Lease, error) {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Create(l).Error; err != nil {
+			return err
+		}
+		e, err := biz.NewEvent(&biz.LeaseSignedEvent{LeaseID: l.ID, HouseID: l.HouseID, TenantID: l.TenantID, StartDate: l.StartDate})
+		if err != nil {
+			return err
+		}
+		return writeOutbox(r.data.DB(ctx), []*biz.Event{e})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("创建租约失败: %v", err)
+	}
+	return l, nil
+}
+
+func (r *LeaseRepo) GetLease(ctx context.Context, id uint) (*biz.Lease, error) {
+	var l biz.Lease
+	err := r.data.DB(ctx).Take(&l, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询租约失败: %v", err)
+	}
+	return &l, nil
+}
+
+// LockLease takes the row lock with SELECT ... FOR UPDATE; outside a
+// transaction the lock is released at once.
+func (r *LeaseRepo) LockLease(ctx context.Context, id uint) (*biz.Lease, error) {
+	var l biz.Lease
+	err := r.data.Primary(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Take(&l, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询租约失败: %v", err)
+	}
+	return &l, nil
+}
+
+func (r *LeaseRepo) UpdateLease(ctx context.Context, l *biz.Lease, events ...*biz.Event) (*biz.Lease, error) {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Save(l).Error; err != nil {
+			return err
+		}
+		return writeOutbox(r.data.DB(ctx), events)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("更新租约失败: %v", err)
+	}
+	return l, nil
+}
+
+func (r *LeaseRepo) ListLeases(ctx context.Context, tenantID, landlordID, houseID uint) ([]*biz.Lease, error) {
+	db := r.data.DB(ctx)
+	if tenantID != 0 {
+		db = db.Where("tenant_id = ?", tenantID)
+	}
+	if landlordID != 0 {
+		db = db.Where("landlord_id = ?", landlordID)
+	}
+	if houseID != 0 {
+		db = db.Where("house_id = ?", houseID)
+	}
+	var list []*biz.Lease
+	if err := db.Order("id DESC").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询租约失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *LeaseRepo) ListActiveLeases(ctx context.Context, afterID uint, limit int) ([]*biz.Lease, error) {
+	var list []*biz.Lease
+	err := r.data.DB(ctx).Where("status = ? AND id > ?", biz.LeaseActive, afterID).Order("id").Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询租约失败: %v", err)
+	}
+	return list, nil
+}
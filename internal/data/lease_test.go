@@ -0,0 +1,103 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestLeaseUsecase_SignTwice(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&biz.House{}, &biz.Lease{}, &outboxEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	_, rdb := newTestRedis(t)
+	d := &Data{db: db, rdb: rdb, cache: &conf.Data_Cache{Ttl: durationpb.New(time.Hour), NegativeTtl: durationpb.New(time.Minute)}}
+	houses := NewHouseRepo(d, log.DefaultLogger)
+	uc := biz.NewLeaseUsecase(NewLeaseRepo(d, log.DefaultLogger), houses, NewTransaction(d), log.DefaultLogger)
+	ctx := context.Background()
+
+	h, err := houses.CreateHouse(ctx, &biz.House{Title: "整租 一室", ListingType: biz.ListingRent, Price: 5000, OwnerID: 1, PayMonths: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 缓存里是在售状态
+	if _, err := houses.GetHouse(ctx, h.ID); err != nil {
+		t.Fatal(err)
+	}
+	sign := func(tenant uint, start string, months int32) error {
+		at, _ := time.ParseInLocation(biz.LeaseDateLayout, start, time.Local)
+		_, err := uc.SignLease(ctx, &biz.Lease{HouseID: h.ID, TenantID: tenant, StartDate: at, Months: months})
+		return err
+	}
+
+	// 标记已出租的事件还在发件箱里，房源仍是在售
+	if err := sign(2, "2030-01-01", 12); err != nil {
+		t.Fatalf("first SignLease() error = %v", err)
+	}
+	if err := sign(3, "2030-06-01", 6); errors.Reason(err) != "LEASE_OVERLAP" {
+		t.Errorf("overlapping SignLease() error = %v, want LEASE_OVERLAP", err)
+	}
+	if err := sign(3, "2029-12-01", 1); err != nil {
+		t.Errorf("SignLease() ending on the first start error = %v", err)
+	}
+
+	// 库里已出租，缓存过期前也不能再签
+	if err := db.Model(&biz.House{}).Where("id = ?", h.ID).Update("status", biz.HouseRented).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := sign(4, "2031-01-01", 12); errors.Reason(err) != "HOUSE_NOT_ON_SALE" {
+		t.Errorf("SignLease() of a rented house error = %v, want HOUSE_NOT_ON_SALE", err)
+	}
+}
+
+func TestLeaseUsecase_RenewTerminate(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&biz.House{}, &biz.Lease{}, &outboxEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	_, rdb := newTestRedis(t)
+	d := &Data{db: db, rdb: rdb, cache: &conf.Data_Cache{Ttl: durationpb.New(time.Hour), NegativeTtl: durationpb.New(time.Minute)}}
+	houses := NewHouseRepo(d, log.DefaultLogger)
+	leases := NewLeaseRepo(d, log.DefaultLogger)
+	uc := biz.NewLeaseUsecase(leases, houses, NewTransaction(d), log.DefaultLogger)
+	ctx := context.Background()
+
+	h, err := houses.CreateHouse(ctx, &biz.House{Title: "整租 一室", ListingType: biz.ListingRent, Price: 5000, OwnerID: 1, PayMonths: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, _ := time.ParseInLocation(biz.LeaseDateLayout, "2030-01-01", time.Local)
+	l, err := uc.SignLease(ctx, &biz.Lease{HouseID: h.ID, TenantID: 2, StartDate: start, Months: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	renewal, err := uc.RenewLease(ctx, l.ID, 12, 0)
+	if err != nil {
+		t.Fatalf("RenewLease() error = %v", err)
+	}
+	if _, err := uc.RenewLease(ctx, l.ID, 6, 0); errors.Reason(err) != "LEASE_STATE" {
+		t.Errorf("second RenewLease() error = %v, want LEASE_STATE", err)
+	}
+	if _, err := uc.TerminateLease(ctx, l.ID, start.AddDate(0, 6, 0), 0, ""); errors.Reason(err) != "LEASE_STATE" {
+		t.Errorf("TerminateLease() of a renewed lease error = %v, want LEASE_STATE", err)
+	}
+
+	// 在续租起始日终止续租，原租约可以重新续租
+	if _, err := uc.TerminateLease(ctx, renewal.ID, renewal.StartDate, 0, "不续了"); err != nil {
+		t.Fatalf("TerminateLease() of the renewal error = %v", err)
+	}
+	if got, err := leases.GetLease(ctx, l.ID); err != nil || got.RenewalID != 0 {
+		t.Fatalf("renewed lease after cancelling the renewal = %+v, %v, want no renewal", got, err)
+	}
+	if _, err := uc.RenewLease(ctx, l.ID, 6, 0); err != nil {
+		t.Errorf("RenewLease() after cancelling the renewal error = %v", err)
+	}
+}
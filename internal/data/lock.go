@@ -0,0 +1,205 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	mrand "math/rand"
+	"sync"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// lockTTL is the lease of a lock; a crashed holder blocks the lock for at
+	// most this long.
+	lockTTL = 10 * time.Second
+	// lockRetry is the base wait between attempts of a blocked Lock.
+	lockRetry = 50 * time.Millisecond
+)
+
+// acquireScript takes the lock and, only if it was free, issues the next
+// fencing token. The token counter never expires so tokens keep increasing.
+var acquireScript = redis.NewScript(`
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return redis.call("INCR", KEYS[2])
+end
+return 0`)
+
+// releaseScript deletes the lock only if ARGV[1] still owns it.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// renewScript extends the lease only if ARGV[1] still owns the lock.
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// lockFence is the highest fencing token that has written under a lock.
+type lockFence struct {
+	Name      string `gorm:"primaryKey;size:128"`
+	Token     int64
+	UpdatedAt time.Time
+}
+
+func (lockFence) TableName() string { return "lock_fences" }
+
+// RedisLocker is the biz.Locker on Redis. Each lock is a key holding its
+// owner's random token, so only the owner can renew or release it.
+type RedisLocker struct {
+	data *Data
+	ttl  time.Duration
+	log  *log.Helper
+}
+
+// NewRedisLocker new a RedisLocker.
+func NewRedisLocker(data *Data, logger log.Logger) *RedisLocker {
+	return &RedisLocker{data: data, ttl: lockTTL, log: log.NewHelper(logger)}
+}
+
+// 花括号让锁和计数器落在 Redis Cluster 的同一个槽
+func lockKeys(name string) []string {
+	return []string{"lock:{" + name + "}", "lock:{" + name + "}:fence"}
+}
+
+// TryLock acquires the lock on name only if it is free.
+func (l *RedisLocker) TryLock(ctx context.Context, name string) (biz.Lock, bool, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, false, err
+	}
+	owner := hex.EncodeToString(b)
+	token, err := acquireScript.Run(ctx, l.data.rdb, lockKeys(name), owner, l.ttl.Milliseconds()).Int64()
+	if err != nil {
+		return nil, false, fmt.Errorf("获取锁 %s 失败: %v", name, err)
+	}
+	if token == 0 {
+		return nil, false, nil
+	}
+	lk := &redisLock{
+		locker: l,
+		name:   name,
+		owner:  owner,
+		token:  token,
+		done:   make(chan struct{}),
+		stop:   make(chan struct{}),
+	}
+	go lk.renew()
+	return lk, true, nil
+}
+
+// Lock waits until the lock on name is acquired, returning ErrLockTimeout
+// when ctx is done first.
+func (l *RedisLocker) Lock(ctx context.Context, name string) (biz.Lock, error) {
+	for {
+		lk, ok, err := l.TryLock(ctx, name)
+		if err != nil || ok {
+			return lk, err
+		}
+		// 加随机等待，避免等锁的实例同时重试
+		wait := lockRetry + time.Duration(mrand.Int63n(int64(lockRetry)))
+		select {
+		case <-ctx.Done():
+			return nil, biz.ErrLockTimeout
+		case <-time.After(wait):
+		}
+	}
+}
+
+type redisLock struct {
+	locker *RedisLocker
+	name   string
+	owner  string
+	token  int64
+
+	done     chan struct{}
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func (lk *redisLock) Token() int64          { return lk.token }
+func (lk *redisLock) Done() <-chan struct{} { return lk.done }
+
+// renew extends the lease every third of its TTL until Release. The lock is
+// lost when another owner holds it or the lease lapses while Redis errors.
+func (lk *redisLock) renew() {
+	l := lk.locker
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+	renewed := time.Now()
+	for {
+		select {
+		case <-lk.stop:
+			return
+		case <-ticker.C:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), l.ttl/3)
+		n, err := renewScript.Run(ctx, l.data.rdb, lockKeys(lk.name)[:1], lk.owner, l.ttl.Milliseconds()).Int64()
+		cancel()
+		switch {
+		case err == nil && n == 1:
+			renewed = time.Now()
+			continue
+		case err != nil && time.Since(renewed) < l.ttl:
+			l.log.Warnf("renew lock %s: %v", lk.name, err)
+			continue
+		}
+		l.log.Errorf("lock %s lost (token %d)", lk.name, lk.token)
+		close(lk.done)
+		return
+	}
+}
+
+// Fence records the token as the latest writer of the lock, failing when a
+// larger token has already written.
+func (lk *redisLock) Fence(ctx context.Context) error {
+	select {
+	case <-lk.done:
+		return biz.ErrLockStale
+	default:
+	}
+	d := lk.locker.data
+	return d.InTx(ctx, func(ctx context.Context) error {
+		// 只增不减；未用 GREATEST/VALUES，MySQL 和 SQLite 都能执行
+		err := d.DB(ctx).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "name"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"token":      gorm.Expr("CASE WHEN token < ? THEN ? ELSE token END", lk.token, lk.token),
+				"updated_at": time.Now(),
+			}),
+		}).Create(&lockFence{Name: lk.name, Token: lk.token}).Error
+		if err != nil {
+			return fmt.Errorf("写入锁 %s 的令牌失败: %v", lk.name, err)
+		}
+		// 本事务已锁住该行，读到的就是最终值
+		var f lockFence
+		if err := d.DB(ctx).Take(&f, "name = ?", lk.name).Error; err != nil {
+			return err
+		}
+		if f.Token != lk.token {
+			return biz.ErrLockStale
+		}
+		return nil
+	})
+}
+
+// Release stops renewal and unlocks if the lock is still owned.
+func (lk *redisLock) Release(ctx context.Context) error {
+	lk.stopOnce.Do(func() { close(lk.stop) })
+	if err := releaseScript.Run(ctx, lk.locker.data.rdb, lockKeys(lk.name)[:1], lk.owner).Err(); err != nil {
+		return fmt.Errorf("释放锁 %s 失败: %v", lk.name, err)
+	}
+	return nil
+}
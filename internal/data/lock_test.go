@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestRedisLocker(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&lockFence{}); err != nil {
+		t.Fatal(err)
+	}
+	mr, rdb := newTestRedis(t)
+	l := NewRedisLocker(&Data{db: db, rdb: rdb}, log.DefaultLogger)
+	// 续期按真实时间每 ttl/3 一次；miniredis 的过期只随 FastForward 推进
+	l.ttl = time.Minute
+	ctx := context.Background()
+
+	t.Run("acquire and release", func(t *testing.T) {
+		a, ok, err := l.TryLock(ctx, "house:1")
+		if err != nil || !ok {
+			t.Fatalf("TryLock() = %v, %v", ok, err)
+		}
+		if _, ok, _ := l.TryLock(ctx, "house:1"); ok {
+			t.Error("TryLock() of a held lock succeeded")
+		}
+		if ttl := mr.TTL("lock:{house:1}"); ttl != time.Minute {
+			t.Errorf("lease = %v, want a minute", ttl)
+		}
+		if err := a.Fence(ctx); err != nil {
+			t.Errorf("Fence() error = %v", err)
+		}
+		if err := a.Release(ctx); err != nil {
+			t.Fatal(err)
+		}
+		b, ok, err := l.TryLock(ctx, "house:1")
+		if err != nil || !ok || b.Token() <= a.Token() {
+			t.Fatalf("TryLock() after release = %v, %v, want a larger token than %d", ok, err, a.Token())
+		}
+		b.Release(ctx)
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		a, _, _ := l.TryLock(ctx, "house:2")
+		short, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		if _, err := l.Lock(short, "house:2"); !errors.Is(err, biz.ErrLockTimeout) {
+			t.Errorf("Lock() of a held lock error = %v, want ErrLockTimeout", err)
+		}
+		time.AfterFunc(100*time.Millisecond, func() { a.Release(ctx) })
+		b, err := l.Lock(ctx, "house:2")
+		if err != nil {
+			t.Fatalf("Lock() after release error = %v", err)
+		}
+		b.Release(ctx)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		a, _, _ := l.TryLock(ctx, "house:3")
+		defer a.Release(ctx)
+		// 持有者停顿超过租期，锁被别人拿走
+		mr.FastForward(time.Minute)
+		b, ok, err := l.TryLock(ctx, "house:3")
+		if err != nil || !ok {
+			t.Fatalf("TryLock() after expiry = %v, %v", ok, err)
+		}
+		if err := b.Fence(ctx); err != nil {
+			t.Errorf("Fence() of the new holder error = %v", err)
+		}
+		if err := a.Fence(ctx); !errors.Is(err, biz.ErrLockStale) {
+			t.Errorf("Fence() of the old holder error = %v, want ErrLockStale", err)
+		}
+		// 旧持有者释放不会删掉新持有者的锁
+		if err := a.Release(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if !mr.Exists("lock:{house:3}") {
+			t.Error("Release() by the old holder deleted the lock")
+		}
+		b.Release(ctx)
+	})
+}
+
+func TestRedisLocker_Renew(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	l := NewRedisLocker(&Data{db: newTestDB(t), rdb: rdb}, log.DefaultLogger)
+	l.ttl = 300 * time.Millisecond
+	ctx := context.Background()
+
+	lk, _, err := l.TryLock(ctx, "customer:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lk.Release(ctx)
+	mr.FastForward(200 * time.Millisecond)
+	waitFor(t, "lease renewed", func() bool { return mr.TTL("lock:{customer:1}") > 200*time.Millisecond })
+	select {
+	case <-lk.Done():
+		t.Fatal("Done() closed while the lease is renewed")
+	default:
+	}
+
+	// 租期在两次续期之间耗尽：续期发现锁已不属于自己
+	mr.FastForward(300 * time.Millisecond)
+	select {
+	case <-lk.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Done() not closed after the lease expired")
+	}
+	if err := lk.Fence(ctx); !errors.Is(err, biz.ErrLockStale) {
+		t.Errorf("Fence() after losing the lock error = %v, want ErrLockStale", err)
+	}
+}
+
+func waitFor(t *testing.T, what string, ok func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); !ok(); time.Sleep(5 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+	}
+}
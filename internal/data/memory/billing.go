@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type billRepo struct {
+	bills *table[biz.RentBill]
+}
+
+// NewBillRepo .
+func NewBillRepo() biz.BillRepo {
+	return &billRepo{bills: newTable(func(b *biz.RentBill) *gorm.Model { return &b.Model })}
+}
+
+func (r *billRepo) CreateBills(_ context.Context, bills []*biz.RentBill) error {
+	for _, b := range bills {
+		dup := r.bills.find(func(o *biz.RentBill) bool { return o.LeaseID == b.LeaseID && o.Kind == b.Kind && o.Seq == b.Seq })
+		if len(dup) == 0 {
+			r.bills.insert(b)
+		}
+	}
+	return nil
+}
+
+// LockBill reads like a plain get; memory transactions are not isolated.
+func (r *billRepo) LockBill(_ context.Context, id uint) (*biz.RentBill, error) {
+	return r.bills.get(id), nil
+}
+
+func (r *billRepo) UpdateBill(_ context.Context, b *biz.RentBill) (*biz.RentBill, error) {
+	r.bills.save(b)
+	return b, nil
+}
+
+func (r *billRepo) UpdateOpenBill(_ context.Context, b *biz.RentBill) (bool, error) {
+	return r.bills.saveIf(b, func(o *biz.RentBill) bool { return o.Status == biz.BillUnpaid || o.Status == biz.BillOverdue }), nil
+}
+
+func (r *billRepo) ListLeaseBills(_ context.Context, leaseID uint) ([]*biz.RentBill, error) {
+	return r.bills.find(func(b *biz.RentBill) bool { return b.LeaseID == leaseID }), nil
+}
+
+func (r *billRepo) ListBills(_ context.Context, f *biz.BillFilter) ([]*biz.RentBill, error) {
+	list := r.bills.find(func(b *biz.RentBill) bool {
+		return (f.TenantID == 0 || b.TenantID == f.TenantID) && (f.LandlordID == 0 || b.LandlordID == f.LandlordID) &&
+			(f.LeaseID == 0 || b.LeaseID == f.LeaseID) && (f.Status == nil || b.Status == *f.Status)
+	})
+	sort.SliceStable(list, func(i, j int) bool {
+		if !list[i].DueDate.Equal(list[j].DueDate) {
+			return list[i].DueDate.After(list[j].DueDate)
+		}
+		return list[i].ID > list[j].ID
+	})
+	return list, nil
+}
+
+func (r *billRepo) ListOpenBills(_ context.Context, dueBefore time.Time, afterID uint, limit int) ([]*biz.RentBill, error) {
+	list := r.bills.find(func(b *biz.RentBill) bool {
+		return (b.Status == biz.BillUnpaid || b.Status == biz.BillOverdue) && b.DueDate.Before(dueBefore) && b.ID > afterID
+	})
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
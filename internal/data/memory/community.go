@@ -0,0 +1,126 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type communityRepo struct {
+	communities *table[biz.Community]
+	buildings   *table[biz.Building]
+	units       *table[biz.Unit]
+}
+
+// NewCommunityRepo .
+func NewCommunityRepo() biz.CommunityRepo {
+	return &communityRepo{
+		communities: newTable(func(c *biz.Community) *gorm.Model { return &c.Model }),
+		buildings:   newTable(func(b *biz.Building) *gorm.Model { return &b.Model }),
+		units:       newTable(func(u *biz.Unit) *gorm.Model { return &u.Model }),
+	}
+}
+
+func (r *communityRepo) CreateCommunity(_ context.Context, c *biz.Community) (*biz.Community, error) {
+	r.communities.insert(c)
+	return c, nil
+}
+
+func (r *communityRepo) GetCommunity(_ context.Context, id uint) (*biz.Community, error) {
+	return r.communities.get(id), nil
+}
+
+func (r *communityRepo) UpdateCommunity(_ context.Context, c *biz.Community) (*biz.Community, error) {
+	r.communities.save(c)
+	return c, nil
+}
+
+func (r *communityRepo) DeleteCommunity(_ context.Context, id uint) error {
+	for _, b := range r.buildings.find(func(b *biz.Building) bool { return b.CommunityID == id }) {
+		r.units.delete(func(u *biz.Unit) bool { return u.BuildingID == b.ID })
+	}
+	r.buildings.delete(func(b *biz.Building) bool { return b.CommunityID == id })
+	r.communities.delete(func(c *biz.Community) bool { return c.ID == id })
+	return nil
+}
+
+// SearchCommunities ranks like the MySQL repo: exact name, name prefix, then
+// shorter names.
+func (r *communityRepo) SearchCommunities(_ context.Context, city, keyword string, limit int) ([]*biz.Community, error) {
+	list := r.communities.find(func(c *biz.Community) bool {
+		if city != "" && c.City != city {
+			return false
+		}
+		if strings.Contains(c.Name, keyword) {
+			return true
+		}
+		for _, a := range c.Aliases {
+			if strings.Contains(a, keyword) {
+				return true
+			}
+		}
+		return false
+	})
+	rank := func(c *biz.Community) int {
+		switch {
+		case c.Name == keyword:
+			return 0
+		case strings.HasPrefix(c.Name, keyword):
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		if ri, rj := rank(list[i]), rank(list[j]); ri != rj {
+			return ri < rj
+		}
+		return utf8.RuneCountInString(list[i].Name) < utf8.RuneCountInString(list[j].Name)
+	})
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *communityRepo) CreateBuilding(_ context.Context, b *biz.Building) (*biz.Building, error) {
+	units := b.Units
+	b.Units = nil
+	r.buildings.insert(b)
+	for _, u := range units {
+		u.BuildingID = b.ID
+		r.units.insert(u)
+	}
+	b.Units = units
+	return b, nil
+}
+
+func (r *communityRepo) GetBuilding(_ context.Context, id uint) (*biz.Building, error) {
+	return r.buildings.get(id), nil
+}
+
+func (r *communityRepo) DeleteBuilding(_ context.Context, id uint) error {
+	r.units.delete(func(u *biz.Unit) bool { return u.BuildingID == id })
+	r.buildings.delete(func(b *biz.Building) bool { return b.ID == id })
+	return nil
+}
+
+func (r *communityRepo) ListBuildings(_ context.Context, communityID uint) ([]*biz.Building, error) {
+	list := r.buildings.find(func(b *biz.Building) bool { return b.CommunityID == communityID })
+	for _, b := range list {
+		b.Units = r.units.find(func(u *biz.Unit) bool { return u.BuildingID == b.ID })
+	}
+	return list, nil
+}
+
+func (r *communityRepo) ListCommunities(_ context.Context, afterID uint, limit int) ([]*biz.Community, error) {
+	list := r.communities.find(func(c *biz.Community) bool { return c.ID > afterID })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"anjuke/internal/biz"
+)
+
+type compareBasketRepo struct {
+	mu      sync.Mutex
+	baskets map[uint][]uint
+}
+
+// NewCompareBasketRepo .
+func NewCompareBasketRepo() biz.CompareBasketRepo {
+	return &compareBasketRepo{baskets: map[uint][]uint{}}
+}
+
+func (r *compareBasketRepo) AddCompare(_ context.Context, userID, houseID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range r.baskets[userID] {
+		if id == houseID {
+			return nil
+		}
+	}
+	r.baskets[userID] = append(r.baskets[userID], houseID)
+	return nil
+}
+
+func (r *compareBasketRepo) RemoveCompare(_ context.Context, userID, houseID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := r.baskets[userID][:0:0]
+	for _, id := range r.baskets[userID] {
+		if id != houseID {
+			ids = append(ids, id)
+		}
+	}
+	r.baskets[userID] = ids
+	return nil
+}
+
+func (r *compareBasketRepo) ListCompare(_ context.Context, userID uint) ([]uint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]uint{}, r.baskets[userID]...), nil
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"context"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type sensitiveWordRepo struct {
+	words *table[biz.SensitiveWord]
+}
+
+// NewSensitiveWordRepo .
+func NewSensitiveWordRepo() biz.SensitiveWordRepo {
+	return &sensitiveWordRepo{words: newTable(func(w *biz.SensitiveWord) *gorm.Model { return &w.Model })}
+}
+
+func (r *sensitiveWordRepo) ListWords(context.Context) ([]*biz.SensitiveWord, error) {
+	return r.words.find(nil), nil
+}
+
+func (r *sensitiveWordRepo) SaveWords(_ context.Context, words []*biz.SensitiveWord) error {
+	for _, w := range words {
+		if old := r.words.find(func(o *biz.SensitiveWord) bool { return o.Word == w.Word }); len(old) > 0 {
+			w.ID, w.CreatedAt = old[0].ID, old[0].CreatedAt
+		}
+		r.words.save(w)
+	}
+	return nil
+}
+
+func (r *sensitiveWordRepo) DeleteWord(_ context.Context, word string) (bool, error) {
+	n := len(r.words.find(func(w *biz.SensitiveWord) bool { return w.Word == word }))
+	r.words.delete(func(w *biz.SensitiveWord) bool { return w.Word == word })
+	return n > 0, nil
+}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"anjuke/internal/biz"
+)
+
+type subscription struct {
+	consumer string
+	h        biz.EventHandler
+}
+
+// EventBus is an in-process biz.EventBus. Publish runs the subscribers
+// synchronously, so a test sees every effect of an event as soon as the call
+// that raised it returns.
+type EventBus struct {
+	mu   sync.Mutex
+	subs map[string][]subscription
+	// handled dedups redeliveries per consumer, like the outbox consumer table.
+	handled map[string]bool
+}
+
+// NewEventBus .
+func NewEventBus() *EventBus {
+	return &EventBus{subs: map[string][]subscription{}, handled: map[string]bool{}}
+}
+
+func (b *EventBus) Subscribe(topic, consumer string, h biz.EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[topic] = append(b.subs[topic], subscription{consumer: consumer, h: h})
+}
+
+// Position returns an empty position: the bus keeps no history.
+func (b *EventBus) Position(context.Context, string) (string, error) {
+	return "", nil
+}
+
+// Seek does nothing: events are delivered as they are published.
+func (b *EventBus) Seek(context.Context, string, string, string) error {
+	return nil
+}
+
+// Publish delivers e to every consumer that has not handled it yet and
+// returns their errors joined.
+func (b *EventBus) Publish(ctx context.Context, e *biz.Event) error {
+	b.mu.Lock()
+	subs := append([]subscription{}, b.subs[e.Topic]...)
+	b.mu.Unlock()
+	var errs []error
+	for _, s := range subs {
+		key := s.consumer + "/" + e.ID
+		b.mu.Lock()
+		done := b.handled[key]
+		b.mu.Unlock()
+		if done {
+			continue
+		}
+		if err := s.h(ctx, e); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		b.mu.Lock()
+		b.handled[key] = true
+		b.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type favoriteRepo struct {
+	favorites *table[biz.Favorite]
+}
+
+// NewFavoriteRepo .
+func NewFavoriteRepo() biz.FavoriteRepo {
+	return &favoriteRepo{favorites: newTable(func(f *biz.Favorite) *gorm.Model { return &f.Model })}
+}
+
+func (r *favoriteRepo) SaveFavorite(_ context.Context, f *biz.Favorite) (*biz.Favorite, error) {
+	if old := r.favorites.find(func(x *biz.Favorite) bool { return x.UserID == f.UserID && x.HouseID == f.HouseID }); len(old) > 0 {
+		f.ID, f.CreatedAt = old[0].ID, old[0].CreatedAt
+	}
+	r.favorites.save(f)
+	return f, nil
+}
+
+func (r *favoriteRepo) DeleteFavorite(_ context.Context, userID, houseID uint) error {
+	r.favorites.delete(func(f *biz.Favorite) bool { return f.UserID == userID && f.HouseID == houseID })
+	return nil
+}
+
+func (r *favoriteRepo) ListFavorites(_ context.Context, userID uint, folder string) ([]*biz.Favorite, error) {
+	list := r.favorites.find(func(f *biz.Favorite) bool { return f.UserID == userID && (folder == "" || f.Folder == folder) })
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+	return list, nil
+}
+
+func (r *favoriteRepo) ListFolders(_ context.Context, userID uint) ([]*biz.FavoriteFolder, error) {
+	var list []*biz.FavoriteFolder
+	byName := map[string]*biz.FavoriteFolder{}
+	for _, f := range r.favorites.find(func(f *biz.Favorite) bool { return f.UserID == userID }) {
+		if byName[f.Folder] == nil {
+			byName[f.Folder] = &biz.FavoriteFolder{Name: f.Folder}
+			list = append(list, byName[f.Folder])
+		}
+		byName[f.Folder].Count++
+	}
+	return list, nil
+}
+
+func (r *favoriteRepo) CountFavorites(_ context.Context, houseIDs []uint) (map[uint]int64, error) {
+	want := map[uint]bool{}
+	for _, id := range houseIDs {
+		want[id] = true
+	}
+	counts := map[uint]int64{}
+	for _, f := range r.favorites.find(func(f *biz.Favorite) bool { return want[f.HouseID] }) {
+		counts[f.HouseID]++
+	}
+	return counts, nil
+}
+
+type browseHistoryRepo struct {
+	mu    sync.Mutex
+	views map[uint]map[uint]time.Time // 用户 -> 房源 -> 浏览时间
+}
+
+// NewBrowseHistoryRepo .
+func NewBrowseHistoryRepo() biz.BrowseHistoryRepo {
+	return &browseHistoryRepo{views: map[uint]map[uint]time.Time{}}
+}
+
+func (r *browseHistoryRepo) RecordView(_ context.Context, userID, houseID uint, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.views[userID] == nil {
+		r.views[userID] = map[uint]time.Time{}
+	}
+	r.views[userID][houseID] = at
+	if list := r.list(userID); len(list) > biz.MaxBrowseHistory {
+		delete(r.views[userID], list[len(list)-1].HouseID)
+	}
+	return nil
+}
+
+func (r *browseHistoryRepo) ListViews(_ context.Context, userID uint, limit int) ([]*biz.BrowseRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := r.list(userID)
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+// list returns the views of a user, newest first.
+func (r *browseHistoryRepo) list(userID uint) []*biz.BrowseRecord {
+	list := make([]*biz.BrowseRecord, 0, len(r.views[userID]))
+	for houseID, at := range r.views[userID] {
+		list = append(list, &biz.BrowseRecord{HouseID: houseID, ViewedAt: at})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].ViewedAt.Equal(list[j].ViewedAt) {
+			return list[i].ViewedAt.After(list[j].ViewedAt)
+		}
+		return list[i].HouseID > list[j].HouseID
+	})
+	return list
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"anjuke/internal/biz"
+)
+
+type keywordRepo struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+// NewKeywordRepo counts keywords forever; tests never outlive the window.
+func NewKeywordRepo() biz.KeywordRepo {
+	return &keywordRepo{counts: map[string]int64{}}
+}
+
+func (r *keywordRepo) RecordKeyword(_ context.Context, keyword string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counts[keyword]++
+	return nil
+}
+
+func (r *keywordRepo) HotKeywords(_ context.Context, limit int) ([]*biz.KeywordCount, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := make([]*biz.KeywordCount, 0, len(r.counts))
+	for k, n := range r.counts {
+		list = append(list, &biz.KeywordCount{Keyword: k, Count: n})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return list[i].Keyword < list[j].Keyword
+	})
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *keywordRepo) KeywordCounts(_ context.Context, keywords []string) (map[string]int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	counts := map[string]int64{}
+	for _, k := range keywords {
+		if n, ok := r.counts[k]; ok {
+			counts[k] = n
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type leaseRepo struct {
+	leases *table[biz.Lease]
+	pub    biz.EventPublisher
+}
+
+// NewLeaseRepo publishes events straight to pub instead of an outbox.
+func NewLeaseRepo(pub biz.EventPublisher) biz.LeaseRepo {
+	return &leaseRepo{leases: newTable(func(l *biz.Lease) *gorm.Model { return &l.Model }), pub: pub}
+}
+
+func (r *leaseRepo) CreateLease(ctx context.Context, l *biz.Lease) (*biz.Lease, error) {
+	r.leases.insert(l)
+	e, err := biz.NewEvent(&biz.LeaseSignedEvent{LeaseID: l.ID, HouseID: l.HouseID, TenantID: l.TenantID, StartDate: l.StartDate})
+	if err != nil {
+		return nil, err
+	}
+	return l, r.pub.Publish(ctx, e)
+}
+
+func (r *leaseRepo) GetLease(_ context.Context, id uint) (*biz.Lease, error) {
+	return r.leases.get(id), nil
+}
+
+// LockLease reads like GetLease; memory transactions are not isolated.
+func (r *leaseRepo) LockLease(_ context.Context, id uint) (*biz.Lease, error) {
+	return r.leases.get(id), nil
+}
+
+func (r *leaseRepo) UpdateLease(ctx context.Context, l *biz.Lease, events ...*biz.Event) (*biz.Lease, error) {
+	r.leases.save(l)
+	for _, e := range events {
+		if err := r.pub.Publish(ctx, e); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+func (r *leaseRepo) ListLeases(_ context.Context, tenantID, landlordID, houseID uint) ([]*biz.Lease, error) {
+	list := r.leases.find(func(l *biz.Lease) bool {
+		return (tenantID == 0 || l.TenantID == tenantID) && (landlordID == 0 || l.LandlordID == landlordID) && (houseID == 0 || l.HouseID == houseID)
+	})
+	sort.Slice(list, func(i, j int) bool { return list[i].ID > list[j].ID })
+	return list, nil
+}
+
+func (r *leaseRepo) ListActiveLeases(_ context.Context, afterID uint, limit int) ([]*biz.Lease, error) {
+	list := r.leases.find(func(l *biz.Lease) bool { return l.Status == biz.LeaseActive && l.ID > afterID })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
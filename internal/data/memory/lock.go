@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"anjuke/internal/biz"
+)
+
+// Locker is an in-process biz.Locker. Leases never expire, so Done is only
+// closed by tests that simulate a lost lock.
+type Locker struct {
+	mu     sync.Mutex
+	held   map[string]bool
+	tokens map[string]int64
+	fences map[string]int64
+}
+
+// NewLocker new a Locker.
+func NewLocker() *Locker {
+	return &Locker{held: map[string]bool{}, tokens: map[string]int64{}, fences: map[string]int64{}}
+}
+
+func (l *Locker) TryLock(_ context.Context, name string) (biz.Lock, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.held[name] {
+		return nil, false, nil
+	}
+	l.held[name] = true
+	l.tokens[name]++
+	return &lock{locker: l, name: name, token: l.tokens[name], done: make(chan struct{})}, true, nil
+}
+
+func (l *Locker) Lock(ctx context.Context, name string) (biz.Lock, error) {
+	for {
+		if lk, ok, _ := l.TryLock(ctx, name); ok {
+			return lk, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, biz.ErrLockTimeout
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+type lock struct {
+	locker *Locker
+	name   string
+	token  int64
+	done   chan struct{}
+	once   sync.Once
+}
+
+func (lk *lock) Token() int64          { return lk.token }
+func (lk *lock) Done() <-chan struct{} { return lk.done }
+
+func (lk *lock) Fence(context.Context) error {
+	l := lk.locker
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.fences[lk.name] > lk.token {
+		return biz.ErrLockStale
+	}
+	l.fences[lk.name] = lk.token
+	return nil
+}
+
+func (lk *lock) Release(context.Context) error {
+	lk.once.Do(func() {
+		l := lk.locker
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if l.tokens[lk.name] == lk.token {
+			delete(l.held, lk.name)
+		}
+	})
+	return nil
+}
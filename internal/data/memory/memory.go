@@ -0,0 +1,183 @@
+// Package memory provides in-memory implementations of the biz repos and a
+// miniredis-backed redis client, so the full service graph can run in tests
+// without MySQL or Redis.
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"anjuke/internal/biz"
+	"anjuke/internal/data/imagehash"
+	"anjuke/internal/data/search"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+)
+
+// ProviderSet is the in-memory replacement for data.ProviderSet.
+var ProviderSet = wire.NewSet(NewRedis, NewTransaction, NewGreeterRepo, NewUserRepo, NewHouseRepo, NewTransactionRepo, NewPointsRepo, NewCustomerRepo, NewCommunityRepo, NewRegionRepo, NewKeywordRepo, NewPriceRepo, NewNotificationRepo, NewFavoriteRepo, NewBrowseHistoryRepo, NewCompareBasketRepo,
+	NewEventBus, wire.Bind(new(biz.EventBus), new(*EventBus)), wire.Bind(new(biz.EventPublisher), new(*EventBus)),
+	NewLocker, wire.Bind(new(biz.Locker), new(*Locker)), NewHouseSearcher, NewMortgageRates, NewValuationRepo, NewValuationRules, NewStatsRepo, NewModerationRepo, NewModerationRules, NewImageHasher, NewSensitiveWordRepo, NewContentRules, NewLeaseRepo, NewBillRepo, NewBillingRules)
+
+// NewHouseSearcher returns the Bleve listing index kept in memory.
+func NewHouseSearcher() (biz.HouseSearcher, func(), error) {
+	return search.Open("", "search")
+}
+
+// NewMortgageRates returns the built-in rate tables.
+func NewMortgageRates() *biz.MortgageRates {
+	return biz.DefaultMortgageRates()
+}
+
+// NewValuationRules returns the built-in valuation coefficients.
+func NewValuationRules() *biz.ValuationRules {
+	return biz.DefaultValuationRules()
+}
+
+// NewModerationRules returns the built-in detector thresholds.
+func NewModerationRules() *biz.ModerationRules {
+	return biz.DefaultModerationRules()
+}
+
+// NewContentRules returns the built-in content filter rules.
+func NewContentRules() *biz.ContentRules {
+	return biz.DefaultContentRules()
+}
+
+// NewBillingRules returns the built-in billing rules.
+func NewBillingRules() *biz.BillingRules {
+	return biz.DefaultBillingRules()
+}
+
+// NewImageHasher downloads listing images over HTTP like the real one, so
+// tests serve their images from an httptest server. Such a server listens
+// on loopback, which the real one refuses.
+func NewImageHasher() biz.ImageHasher {
+	return imagehash.NewInsecure(5 * time.Second)
+}
+
+// NewRedis starts a miniredis server and returns a client connected to it.
+func NewRedis() (*redis.Client, func(), error) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		return nil, nil, err
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	return rdb, func() {
+		rdb.Close()
+		mr.Close()
+	}, nil
+}
+
+type transaction struct{}
+
+// NewTransaction returns a biz.Transaction that just runs fn: the in-memory
+// tables have no rollback, so tests must not rely on it.
+func NewTransaction() biz.Transaction {
+	return transaction{}
+}
+
+func (transaction) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// table is an in-memory table of gorm models keyed by ID. Rows are copied
+// in and out so callers never share memory with the store, like a database.
+type table[T any] struct {
+	mu    sync.RWMutex
+	seq   uint
+	rows  map[uint]*T
+	model func(*T) *gorm.Model
+}
+
+func newTable[T any](model func(*T) *gorm.Model) *table[T] {
+	return &table[T]{rows: map[uint]*T{}, model: model}
+}
+
+// insert assigns ID and timestamps on v, as GORM does, and stores a copy.
+func (t *table[T]) insert(v *T) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.seq++
+	m := t.model(v)
+	m.ID = t.seq
+	m.CreatedAt = time.Now()
+	m.UpdatedAt = m.CreatedAt
+	cp := *v
+	t.rows[m.ID] = &cp
+}
+
+// save overwrites the row with v's ID, inserting it when the ID is unknown.
+func (t *table[T]) save(v *T) {
+	m := t.model(v)
+	if m.ID == 0 {
+		t.insert(v)
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	m.UpdatedAt = time.Now()
+	if m.ID > t.seq {
+		t.seq = m.ID
+	}
+	cp := *v
+	t.rows[m.ID] = &cp
+}
+
+// saveIf overwrites the row with v's ID if ok accepts the stored row, like a
+// conditional UPDATE, and reports whether it did.
+func (t *table[T]) saveIf(v *T, ok func(*T) bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	m := t.model(v)
+	r, found := t.rows[m.ID]
+	if !found || !ok(r) {
+		return false
+	}
+	m.UpdatedAt = time.Now()
+	cp := *v
+	t.rows[m.ID] = &cp
+	return true
+}
+
+func (t *table[T]) get(id uint) *T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	r, ok := t.rows[id]
+	if !ok {
+		return nil
+	}
+	cp := *r
+	return &cp
+}
+
+// find returns copies of the rows matching keep, ordered by ID.
+func (t *table[T]) find(keep func(*T) bool) []*T {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var res []*T
+	for _, r := range t.rows {
+		if keep == nil || keep(r) {
+			cp := *r
+			res = append(res, &cp)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return t.model(res[i]).ID < t.model(res[j]).ID })
+	return res
+}
+
+// delete removes the rows matching keep.
+func (t *table[T]) delete(keep func(*T) bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id, r := range t.rows {
+		if keep(r) {
+			delete(t.rows, id)
+		}
+	}
+}
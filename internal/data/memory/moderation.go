@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type moderationRepo struct {
+	fingerprints *table[biz.ListingFingerprint]
+	items        *table[biz.ModerationItem]
+}
+
+// NewModerationRepo .
+func NewModerationRepo() biz.ModerationRepo {
+	return &moderationRepo{
+		fingerprints: newTable(func(fp *biz.ListingFingerprint) *gorm.Model { return &fp.Model }),
+		items:        newTable(func(m *biz.ModerationItem) *gorm.Model { return &m.Model }),
+	}
+}
+
+func (r *moderationRepo) GetFingerprint(_ context.Context, houseID uint) (*biz.ListingFingerprint, error) {
+	list := r.fingerprints.find(func(fp *biz.ListingFingerprint) bool { return fp.HouseID == houseID })
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (r *moderationRepo) SaveFingerprint(ctx context.Context, fp *biz.ListingFingerprint) error {
+	old, _ := r.GetFingerprint(ctx, fp.HouseID)
+	if old != nil {
+		fp.ID, fp.CreatedAt = old.ID, old.CreatedAt
+	}
+	r.fingerprints.save(fp)
+	return nil
+}
+
+func (r *moderationRepo) DeleteFingerprint(_ context.Context, houseID uint) error {
+	r.fingerprints.delete(func(fp *biz.ListingFingerprint) bool { return fp.HouseID == houseID })
+	return nil
+}
+
+func (r *moderationRepo) ListFingerprints(_ context.Context, communityID uint) ([]*biz.ListingFingerprint, error) {
+	return r.fingerprints.find(func(fp *biz.ListingFingerprint) bool { return fp.CommunityID == communityID }), nil
+}
+
+func (r *moderationRepo) CreateModeration(_ context.Context, m *biz.ModerationItem) (*biz.ModerationItem, error) {
+	r.items.insert(m)
+	return m, nil
+}
+
+func (r *moderationRepo) UpdateModeration(_ context.Context, m *biz.ModerationItem) (*biz.ModerationItem, error) {
+	r.items.save(m)
+	return m, nil
+}
+
+func (r *moderationRepo) GetModeration(_ context.Context, id uint) (*biz.ModerationItem, error) {
+	return r.items.get(id), nil
+}
+
+func (r *moderationRepo) LatestModeration(_ context.Context, houseID uint) (*biz.ModerationItem, error) {
+	list := r.items.find(func(m *biz.ModerationItem) bool { return m.HouseID == houseID })
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[len(list)-1], nil
+}
+
+func (r *moderationRepo) ListModeration(_ context.Context, status int32, offset, limit int) ([]*biz.ModerationItem, int64, error) {
+	list := r.items.find(func(m *biz.ModerationItem) bool { return m.Status == status })
+	total := int64(len(list))
+	if offset >= len(list) {
+		return nil, total, nil
+	}
+	list = list[offset:]
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, total, nil
+}
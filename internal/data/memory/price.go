@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type priceRepo struct {
+	changes *table[biz.PriceChange]
+	alerts  *table[biz.PriceAlert]
+	pub     biz.EventPublisher
+}
+
+// NewPriceRepo publishes price change events straight to pub instead of an
+// outbox.
+func NewPriceRepo(pub biz.EventPublisher) biz.PriceRepo {
+	return &priceRepo{
+		changes: newTable(func(c *biz.PriceChange) *gorm.Model { return &c.Model }),
+		alerts:  newTable(func(a *biz.PriceAlert) *gorm.Model { return &a.Model }),
+		pub:     pub,
+	}
+}
+
+func (r *priceRepo) CreatePriceChange(ctx context.Context, c *biz.PriceChange) (*biz.PriceChange, error) {
+	r.changes.insert(c)
+	e, err := biz.NewEvent(&biz.HousePriceChangedEvent{HouseID: c.HouseID, OldPrice: c.OldPrice, NewPrice: c.NewPrice})
+	if err != nil {
+		return nil, err
+	}
+	return c, r.pub.Publish(ctx, e)
+}
+
+func (r *priceRepo) ListPriceChanges(_ context.Context, houseID uint) ([]*biz.PriceChange, error) {
+	return r.changes.find(func(c *biz.PriceChange) bool { return c.HouseID == houseID }), nil
+}
+
+func (r *priceRepo) SavePriceAlert(_ context.Context, a *biz.PriceAlert) (*biz.PriceAlert, error) {
+	if old := r.alerts.find(func(x *biz.PriceAlert) bool { return x.UserID == a.UserID && x.HouseID == a.HouseID }); len(old) > 0 {
+		a.ID, a.CreatedAt = old[0].ID, old[0].CreatedAt
+	}
+	r.alerts.save(a)
+	return a, nil
+}
+
+func (r *priceRepo) DeletePriceAlert(_ context.Context, userID, houseID uint) error {
+	r.alerts.delete(func(a *biz.PriceAlert) bool { return a.UserID == userID && a.HouseID == houseID })
+	return nil
+}
+
+func (r *priceRepo) ListPriceAlerts(_ context.Context, houseID uint) ([]*biz.PriceAlert, error) {
+	return r.alerts.find(func(a *biz.PriceAlert) bool { return a.HouseID == houseID }), nil
+}
+
+type notificationRepo struct {
+	notifications *table[biz.Notification]
+}
+
+// NewNotificationRepo .
+func NewNotificationRepo() biz.NotificationRepo {
+	return &notificationRepo{notifications: newTable(func(n *biz.Notification) *gorm.Model { return &n.Model })}
+}
+
+func (r *notificationRepo) CreateNotification(_ context.Context, n *biz.Notification) (*biz.Notification, error) {
+	r.notifications.insert(n)
+	return n, nil
+}
+
+func (r *notificationRepo) ListNotifications(_ context.Context, userID uint, limit int) ([]*biz.Notification, error) {
+	list := r.notifications.find(func(n *biz.Notification) bool { return n.UserID == userID })
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"anjuke/internal/biz"
+	"anjuke/internal/data/regiondata"
+)
+
+type regionRepo struct {
+	mu      sync.Mutex
+	regions []*biz.Region
+}
+
+// NewRegionRepo starts empty and seeds from the bundled dataset, like a new
+// database.
+func NewRegionRepo() biz.RegionRepo {
+	return &regionRepo{}
+}
+
+func (r *regionRepo) ListRegions(context.Context) ([]*biz.Region, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := make([]*biz.Region, 0, len(r.regions))
+	for _, g := range r.regions {
+		cp := *g
+		list = append(list, &cp)
+	}
+	return list, nil
+}
+
+func (r *regionRepo) SeedRegions(context.Context) (int, error) {
+	list, err := regiondata.Load()
+	if err != nil {
+		return 0, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	have := map[uint]bool{}
+	for _, g := range r.regions {
+		have[g.ID] = true
+	}
+	n := 0
+	for _, g := range list {
+		if !have[g.ID] {
+			r.regions = append(r.regions, g)
+			n++
+		}
+	}
+	sort.Slice(r.regions, func(i, j int) bool { return r.regions[i].ID < r.regions[j].ID })
+	return n, nil
+}
+
+func (r *regionRepo) ListStations(context.Context) ([]*biz.Station, error) {
+	return regiondata.LoadStations()
+}
@@ -0,0 +1,221 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type greeterRepo struct{}
+
+// NewGreeterRepo .
+func NewGreeterRepo() biz.GreeterRepo {
+	return greeterRepo{}
+}
+
+func (greeterRepo) Save(_ context.Context, g *biz.Greeter) (*biz.Greeter, error)   { return g, nil }
+func (greeterRepo) Update(_ context.Context, g *biz.Greeter) (*biz.Greeter, error) { return g, nil }
+func (greeterRepo) FindByID(context.Context, int64) (*biz.Greeter, error)          { return nil, nil }
+func (greeterRepo) ListByHello(context.Context, string) ([]*biz.Greeter, error)    { return nil, nil }
+func (greeterRepo) ListAll(context.Context) ([]*biz.Greeter, error)                { return nil, nil }
+
+type userRepo struct {
+	users *table[biz.User]
+}
+
+// NewUserRepo .
+func NewUserRepo() biz.UserRepo {
+	return &userRepo{users: newTable(func(u *biz.User) *gorm.Model { return &u.Model })}
+}
+
+func (r *userRepo) CreateUser(_ context.Context, u *biz.User) (*biz.User, error) {
+	if u.Mobile == "" {
+		return nil, fmt.Errorf("手机号不能为空")
+	}
+	if len(r.users.find(func(x *biz.User) bool { return x.Mobile == u.Mobile })) > 0 {
+		return nil, fmt.Errorf("创建用户失败: 手机号 %s 已存在", u.Mobile)
+	}
+	r.users.insert(u)
+	return u, nil
+}
+
+func (r *userRepo) GetUser(_ context.Context, phone string) (*biz.User, error) {
+	if res := r.users.find(func(x *biz.User) bool { return x.Mobile == phone }); len(res) > 0 {
+		res[0].Password = ""
+		return res[0], nil
+	}
+	return nil, nil
+}
+
+func (r *userRepo) GetPassword(_ context.Context, phone string) (string, error) {
+	if res := r.users.find(func(x *biz.User) bool { return x.Mobile == phone }); len(res) > 0 {
+		return res[0].Password, nil
+	}
+	return "", nil
+}
+
+type houseRepo struct {
+	houses *table[biz.House]
+	pub    biz.EventPublisher
+}
+
+// NewHouseRepo publishes change events straight to pub instead of an outbox.
+func NewHouseRepo(pub biz.EventPublisher) biz.HouseRepo {
+	return &houseRepo{houses: newTable(func(h *biz.House) *gorm.Model { return &h.Model }), pub: pub}
+}
+
+func (r *houseRepo) CreateHouse(ctx context.Context, h *biz.House) (*biz.House, error) {
+	r.houses.insert(h)
+	return h, r.changed(ctx, h.ID)
+}
+
+func (r *houseRepo) GetHouse(_ context.Context, id uint) (*biz.House, error) {
+	return r.houses.get(id), nil
+}
+
+// LockHouse reads like GetHouse; memory transactions are not isolated.
+func (r *houseRepo) LockHouse(_ context.Context, id uint) (*biz.House, error) {
+	return r.houses.get(id), nil
+}
+
+func (r *houseRepo) GetHouses(_ context.Context, ids []uint) ([]*biz.House, error) {
+	var list []*biz.House
+	for _, id := range ids {
+		if h := r.houses.get(id); h != nil {
+			list = append(list, h)
+		}
+	}
+	return list, nil
+}
+
+func (r *houseRepo) UpdateHouse(ctx context.Context, h *biz.House) (*biz.House, error) {
+	r.houses.save(h)
+	return h, r.changed(ctx, h.ID)
+}
+
+func (r *houseRepo) ListHouses(_ context.Context, afterID uint, limit int) ([]*biz.House, error) {
+	list := r.houses.find(func(h *biz.House) bool { return h.ID > afterID })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *houseRepo) ListOnSale(_ context.Context, communityID uint, city, district string, limit int) ([]*biz.House, error) {
+	list := r.houses.find(func(h *biz.House) bool {
+		if h.ListingType != biz.ListingSale || h.Status != biz.HouseOnSale {
+			return false
+		}
+		if communityID != 0 {
+			return h.CommunityID == communityID
+		}
+		return h.City == city && h.District == district
+	})
+	sort.Slice(list, func(i, j int) bool { return list[i].ID > list[j].ID })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *houseRepo) ListRooms(_ context.Context, parentID uint) ([]*biz.House, error) {
+	return r.houses.find(func(h *biz.House) bool { return h.ParentID == parentID }), nil
+}
+
+func (r *houseRepo) changed(ctx context.Context, id uint) error {
+	e, err := biz.NewEvent(&biz.HouseChangedEvent{HouseID: id})
+	if err != nil {
+		return err
+	}
+	return r.pub.Publish(ctx, e)
+}
+
+type transactionRepo struct {
+	deals *table[biz.Deal]
+	pub   biz.EventPublisher
+}
+
+// NewTransactionRepo publishes events straight to pub instead of an outbox.
+func NewTransactionRepo(pub biz.EventPublisher) biz.TransactionRepo {
+	return &transactionRepo{deals: newTable(func(d *biz.Deal) *gorm.Model { return &d.Model }), pub: pub}
+}
+
+func (r *transactionRepo) CreateDeal(_ context.Context, d *biz.Deal) (*biz.Deal, error) {
+	r.deals.insert(d)
+	return d, nil
+}
+
+func (r *transactionRepo) GetDeal(_ context.Context, id uint) (*biz.Deal, error) {
+	return r.deals.get(id), nil
+}
+
+func (r *transactionRepo) UpdateDeal(ctx context.Context, d *biz.Deal, events ...*biz.Event) (*biz.Deal, error) {
+	r.deals.save(d)
+	for _, e := range events {
+		if err := r.pub.Publish(ctx, e); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+type pointsRepo struct {
+	records *table[biz.PointsRecord]
+}
+
+// NewPointsRepo .
+func NewPointsRepo() biz.PointsRepo {
+	return &pointsRepo{records: newTable(func(p *biz.PointsRecord) *gorm.Model { return &p.Model })}
+}
+
+func (r *pointsRepo) CreateRecord(_ context.Context, p *biz.PointsRecord) (*biz.PointsRecord, error) {
+	r.records.insert(p)
+	return p, nil
+}
+
+func (r *pointsRepo) Balance(_ context.Context, userID uint) (int64, error) {
+	var balance int64
+	for _, p := range r.records.find(func(p *biz.PointsRecord) bool { return p.UserID == userID }) {
+		balance += p.Amount
+	}
+	return balance, nil
+}
+
+func (r *pointsRepo) ListRecords(_ context.Context, userID uint) ([]*biz.PointsRecord, error) {
+	list := r.records.find(func(p *biz.PointsRecord) bool { return p.UserID == userID })
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+	return list, nil
+}
+
+type customerRepo struct {
+	customers *table[biz.Customer]
+}
+
+// NewCustomerRepo .
+func NewCustomerRepo() biz.CustomerRepo {
+	return &customerRepo{customers: newTable(func(c *biz.Customer) *gorm.Model { return &c.Model })}
+}
+
+func (r *customerRepo) CreateCustomer(_ context.Context, c *biz.Customer) (*biz.Customer, error) {
+	r.customers.insert(c)
+	return c, nil
+}
+
+func (r *customerRepo) GetCustomer(_ context.Context, id uint) (*biz.Customer, error) {
+	return r.customers.get(id), nil
+}
+
+func (r *customerRepo) UpdateCustomer(_ context.Context, c *biz.Customer) (*biz.Customer, error) {
+	r.customers.save(c)
+	return c, nil
+}
+
+func (r *customerRepo) ListCustomersByUser(_ context.Context, agentID, userID uint) ([]*biz.Customer, error) {
+	return r.customers.find(func(c *biz.Customer) bool { return c.AgentID == agentID && c.UserID == userID }), nil
+}
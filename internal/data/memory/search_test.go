@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSearchUsecase_StaleHits(t *testing.T) {
+	bus := NewEventBus()
+	houses := NewHouseRepo(bus).(*houseRepo)
+	searcher, cleanup, err := NewHouseSearcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	uc := biz.NewSearchUsecase(houses, biz.NewRegionUsecase(NewRegionRepo(), log.DefaultLogger), searcher, NewKeywordRepo(), bus, log.DefaultLogger)
+	ctx := context.Background()
+
+	var ids []uint
+	for i := 0; i < 5; i++ {
+		h, err := houses.CreateHouse(ctx, &biz.House{Title: "浦东 两室", City: "上海", Price: 3000000})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, h.ID)
+	}
+	// 成交事件还没到索引：库里已售，索引里仍在售
+	for _, id := range ids[3:] {
+		h := houses.houses.get(id)
+		h.Status = biz.HouseSold
+		houses.houses.save(h)
+	}
+	// 索引里的非在售文档不会被搜到
+	if err := searcher.Index(ctx, &biz.HouseDocument{House: &biz.House{Model: houses.houses.get(ids[0]).Model, Title: "浦东 两室", Status: biz.HouseRented}}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := uc.SearchHouses(ctx, &biz.HouseQuery{Keyword: "浦东", PageSize: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Houses) != 2 || res.Total != 2 {
+		t.Errorf("SearchHouses() = %d houses of %d, want the 2 on sale", len(res.Houses), res.Total)
+	}
+	for _, h := range res.Houses {
+		if h.Status != biz.HouseOnSale {
+			t.Errorf("SearchHouses() returned house %d with status %d", h.ID, h.Status)
+		}
+	}
+	found, err := searcher.Search(ctx, &biz.HouseQuery{Keyword: "浦东", Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Total != 2 {
+		t.Errorf("index total = %d, want the stale hits removed", found.Total)
+	}
+}
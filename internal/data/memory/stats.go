@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type statsRepo struct {
+	stats *table[biz.MarketStats]
+}
+
+// NewStatsRepo .
+func NewStatsRepo() biz.StatsRepo {
+	return &statsRepo{stats: newTable(func(s *biz.MarketStats) *gorm.Model { return &s.Model })}
+}
+
+func (r *statsRepo) ReplaceMonth(_ context.Context, month string, rows []*biz.MarketStats) error {
+	r.stats.delete(func(s *biz.MarketStats) bool { return s.Month == month })
+	for _, s := range rows {
+		r.stats.insert(s)
+	}
+	return nil
+}
+
+func (r *statsRepo) ListStats(_ context.Context, communityID uint, city, district, from, to string) ([]*biz.MarketStats, error) {
+	list := r.stats.find(func(s *biz.MarketStats) bool {
+		if s.Month < from || s.Month > to {
+			return false
+		}
+		if communityID != 0 {
+			return s.Scope == biz.ScopeCommunity && s.CommunityID == communityID
+		}
+		return s.Scope == biz.ScopeDistrict && s.City == city && s.District == district
+	})
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Month < list[j].Month })
+	return list, nil
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// countingCommunities counts the index builds, each of which lists the
+// communities once.
+type countingCommunities struct {
+	biz.CommunityRepo
+	builds atomic.Int64
+}
+
+func (r *countingCommunities) ListCommunities(ctx context.Context, after uint, limit int) ([]*biz.Community, error) {
+	if after == 0 {
+		r.builds.Add(1)
+	}
+	return r.CommunityRepo.ListCommunities(ctx, after, limit)
+}
+
+func TestSuggestUsecase_SharedBuild(t *testing.T) {
+	communities := &countingCommunities{CommunityRepo: NewCommunityRepo()}
+	ctx := context.Background()
+	if _, err := communities.CreateCommunity(ctx, &biz.Community{Name: "万科城市花园", City: "上海", District: "浦东"}); err != nil {
+		t.Fatal(err)
+	}
+	uc := biz.NewSuggestUsecase(communities, biz.NewRegionUsecase(NewRegionRepo(), log.DefaultLogger), NewKeywordRepo(), log.DefaultLogger)
+
+	// 并发的首批请求共享同一次构建
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := uc.Suggest(ctx, "wk", "", 0)
+			if err != nil || len(res) == 0 || res[0].Text != "万科城市花园" {
+				t.Errorf("Suggest(wk) = %v, %v", res, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if _, err := uc.Suggest(ctx, "wanke", "", 0); err != nil {
+		t.Fatal(err)
+	}
+	if n := communities.builds.Load(); n != 1 {
+		t.Errorf("index built %d times, want once", n)
+	}
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type valuationRepo struct {
+	sales *table[biz.SaleRecord]
+}
+
+// NewValuationRepo .
+func NewValuationRepo() biz.ValuationRepo {
+	return &valuationRepo{sales: newTable(func(s *biz.SaleRecord) *gorm.Model { return &s.Model })}
+}
+
+func (r *valuationRepo) SaveSale(_ context.Context, s *biz.SaleRecord) error {
+	if old := r.sales.find(func(x *biz.SaleRecord) bool { return x.DealID == s.DealID }); len(old) > 0 {
+		s.ID, s.CreatedAt = old[0].ID, old[0].CreatedAt
+	}
+	r.sales.save(s)
+	return nil
+}
+
+func (r *valuationRepo) ListSales(_ context.Context, communityID uint, city, district string, since time.Time, limit int) ([]*biz.SaleRecord, error) {
+	list := r.sales.find(func(s *biz.SaleRecord) bool {
+		if s.SoldAt.Before(since) {
+			return false
+		}
+		if communityID != 0 {
+			return s.CommunityID == communityID
+		}
+		return s.City == city && s.District == district
+	})
+	sort.SliceStable(list, func(i, j int) bool { return list[i].SoldAt.After(list[j].SoldAt) })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *valuationRepo) ListSalesBetween(_ context.Context, from, to time.Time) ([]*biz.SaleRecord, error) {
+	list := r.sales.find(func(s *biz.SaleRecord) bool { return !s.SoldAt.Before(from) && s.SoldAt.Before(to) })
+	sort.SliceStable(list, func(i, j int) bool { return list[i].SoldAt.Before(list[j].SoldAt) })
+	return list, nil
+}
@@ -0,0 +1,327 @@
+package data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"anjuke/internal/data/migrations"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+const (
+	migrationTable = "schema_migrations"
+	// migrationLock serialises concurrent `migrate` runs against one database.
+	migrationLock = "anjuke_schema_migrate"
+)
+
+var migrationName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// MigrationStatus is a Migration together with its state in the database.
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+	// Modified means the embedded file no longer matches what was applied.
+	Modified bool
+}
+
+// schemaMigration is a row of the migrations table.
+type schemaMigration struct {
+	Version   int64  `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"size:255;not null"`
+	Checksum  string `gorm:"size:64;not null"`
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string { return migrationTable }
+
+// Migrator applies the embedded migrations and records them in
+// schema_migrations with their checksums.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+	log        *log.Helper
+}
+
+// NewMigrator new a Migrator over the migrations embedded in the binary.
+func NewMigrator(db *gorm.DB, logger log.Logger) (*Migrator, error) {
+	ms, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:         db.Clauses(dbresolver.Write),
+		migrations: ms,
+		log:        log.NewHelper(logger),
+	}, nil
+}
+
+// LoadMigrations reads up/down pairs from fsys sorted by version.
+func LoadMigrations(fsys fs.FS) ([]*Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int64]*Migration{}
+	for _, f := range files {
+		m := migrationName.FindStringSubmatch(f)
+		if m == nil {
+			return nil, fmt.Errorf("迁移文件名不合法: %s", f)
+		}
+		version, _ := strconv.ParseInt(m[1], 10, 64)
+		body, err := fs.ReadFile(fsys, f)
+		if err != nil {
+			return nil, err
+		}
+		mg, ok := byVersion[version]
+		if !ok {
+			mg = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mg
+		} else if mg.Name != m[2] {
+			return nil, fmt.Errorf("迁移版本 %d 重复: %s 与 %s", version, mg.Name, m[2])
+		}
+		if m[3] == "up" {
+			mg.Up = string(body)
+			sum := sha256.Sum256(body)
+			mg.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			mg.Down = string(body)
+		}
+	}
+	ms := make([]*Migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		if mg.Up == "" {
+			return nil, fmt.Errorf("迁移版本 %d 缺少 up 文件", mg.Version)
+		}
+		ms = append(ms, mg)
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Version < ms[j].Version })
+	return ms, nil
+}
+
+// Status lists every known migration and whether it has been applied. It
+// only reads: before the first `migrate up` nothing is applied.
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	applied, err := m.applied(m.db.WithContext(ctx), false)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*MigrationStatus, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		st := &MigrationStatus{Migration: *mg}
+		if row, ok := applied[mg.Version]; ok {
+			st.Applied = true
+			st.AppliedAt = row.AppliedAt
+			st.Modified = row.Checksum != mg.Checksum
+		}
+		res = append(res, st)
+	}
+	return res, nil
+}
+
+// Check returns an error when the database schema is behind the binary or an
+// applied migration was edited afterwards. The server refuses to start then.
+// Like Status it runs no DDL; schema changes are left to `migrate up`.
+func (m *Migrator) Check(ctx context.Context) error {
+	sts, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, st := range sts {
+		if st.Modified {
+			return fmt.Errorf("迁移 %04d_%s 已执行但文件被修改，请新增迁移而不是修改旧文件", st.Version, st.Name)
+		}
+		if !st.Applied {
+			pending = append(pending, fmt.Sprintf("%04d_%s", st.Version, st.Name))
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("数据库结构落后 %d 个版本（%s），请先执行 migrate up", len(pending), strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// Up applies up to n pending migrations in order, all of them when n <= 0.
+func (m *Migrator) Up(ctx context.Context, n int) ([]*Migration, error) {
+	var done []*Migration
+	err := m.withLock(ctx, func(db *gorm.DB) error {
+		applied, err := m.applied(db, true)
+		if err != nil {
+			return err
+		}
+		for _, mg := range m.migrations {
+			if row, ok := applied[mg.Version]; ok {
+				if row.Checksum != mg.Checksum {
+					return fmt.Errorf("迁移 %04d_%s 已执行但文件被修改", mg.Version, mg.Name)
+				}
+				continue
+			}
+			if n > 0 && len(done) >= n {
+				break
+			}
+			m.log.WithContext(ctx).Infof("migrate up: %04d_%s", mg.Version, mg.Name)
+			if err := execScript(db, mg.Up); err != nil {
+				return fmt.Errorf("执行迁移 %04d_%s 失败: %v", mg.Version, mg.Name, err)
+			}
+			row := &schemaMigration{Version: mg.Version, Name: mg.Name, Checksum: mg.Checksum, AppliedAt: time.Now()}
+			if err := db.Create(row).Error; err != nil {
+				return err
+			}
+			done = append(done, mg)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down rolls back the last n applied migrations, one when n <= 0.
+func (m *Migrator) Down(ctx context.Context, n int) ([]*Migration, error) {
+	if n <= 0 {
+		n = 1
+	}
+	var done []*Migration
+	err := m.withLock(ctx, func(db *gorm.DB) error {
+		applied, err := m.applied(db, true)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < n; i-- {
+			mg := m.migrations[i]
+			if _, ok := applied[mg.Version]; !ok {
+				continue
+			}
+			if mg.Down == "" {
+				return fmt.Errorf("迁移 %04d_%s 没有 down 文件，无法回滚", mg.Version, mg.Name)
+			}
+			m.log.WithContext(ctx).Infof("migrate down: %04d_%s", mg.Version, mg.Name)
+			if err := execScript(db, mg.Down); err != nil {
+				return fmt.Errorf("回滚迁移 %04d_%s 失败: %v", mg.Version, mg.Name, err)
+			}
+			if err := db.Delete(&schemaMigration{}, mg.Version).Error; err != nil {
+				return err
+			}
+			done = append(done, mg)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// applied returns the applied migrations by version. create makes the
+// migrations table when missing; otherwise a missing table means none.
+func (m *Migrator) applied(db *gorm.DB, create bool) (map[int64]*schemaMigration, error) {
+	if !db.Migrator().HasTable(&schemaMigration{}) {
+		if !create {
+			return map[int64]*schemaMigration{}, nil
+		}
+		if err := db.AutoMigrate(&schemaMigration{}); err != nil {
+			return nil, fmt.Errorf("创建 %s 表失败: %v", migrationTable, err)
+		}
+	}
+	var rows []*schemaMigration
+	if err := db.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	res := make(map[int64]*schemaMigration, len(rows))
+	for _, r := range rows {
+		res[r.Version] = r
+	}
+	return res, nil
+}
+
+// withLock runs fn on a single connection holding a MySQL advisory lock, so
+// two instances migrating at once cannot interleave.
+func (m *Migrator) withLock(ctx context.Context, fn func(db *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		var got int
+		if err := conn.Raw("SELECT GET_LOCK(?, 30)", migrationLock).Scan(&got).Error; err != nil {
+			return err
+		}
+		if got != 1 {
+			return fmt.Errorf("获取迁移锁超时，可能有其他实例正在执行迁移")
+		}
+		defer conn.Exec("SELECT RELEASE_LOCK(?)", migrationLock)
+		return fn(conn)
+	})
+}
+
+// execScript runs the statements of a migration file one by one; the MySQL
+// DSN does not enable multiStatements.
+func execScript(db *gorm.DB, script string) error {
+	for _, stmt := range splitStatements(script) {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func splitStatements(script string) []string {
+	var stmts []string
+	var cur strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		cur.WriteString(line)
+		cur.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSpace(cur.String()))
+			cur.Reset()
+		}
+	}
+	if s := strings.TrimSpace(cur.String()); s != "" {
+		stmts = append(stmts, s)
+	}
+	return stmts
+}
+
+// CreateMigration writes an empty up/down pair with the next version into dir,
+// which should be the source directory of package migrations.
+func CreateMigration(dir, name string) (up, down string, err error) {
+	name = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "-", "_"))
+	if !regexp.MustCompile(`^[a-z0-9_]+$`).MatchString(name) {
+		return "", "", fmt.Errorf("迁移名称只能包含小写字母、数字和下划线: %q", name)
+	}
+	ms, err := LoadMigrations(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	var next int64 = 1
+	if len(ms) > 0 {
+		next = ms[len(ms)-1].Version + 1
+	}
+	base := filepath.Join(dir, fmt.Sprintf("%04d_%s", next, name))
+	up, down = base+".up.sql", base+".down.sql"
+	if err = os.WriteFile(up, []byte("-- "+name+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	if err = os.WriteFile(down, []byte("-- revert "+name+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	return up, down, nil
+}
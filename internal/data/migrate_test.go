@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+func testMigrator(t *testing.T, db *gorm.DB, files fstest.MapFS) *Migrator {
+	t.Helper()
+	ms, err := LoadMigrations(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Migrator{db: db, migrations: ms, log: log.NewHelper(log.DefaultLogger)}
+}
+
+func testMigrations() fstest.MapFS {
+	return fstest.MapFS{
+		"0001_create_notes.up.sql":   {Data: []byte("-- notes\nCREATE TABLE notes (\n  id INTEGER PRIMARY KEY\n);\n")},
+		"0001_create_notes.down.sql": {Data: []byte("DROP TABLE notes;\n")},
+		"0002_create_tags.up.sql":    {Data: []byte("CREATE TABLE tags (id INTEGER PRIMARY KEY);\nCREATE INDEX idx_tags_id ON tags (id);\n")},
+		"0002_create_tags.down.sql":  {Data: []byte("DROP TABLE tags;\n")},
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m := testMigrator(t, db, testMigrations())
+
+	// Check 只读：不建表，只报告落后的版本
+	if err := m.Check(ctx); err == nil || !strings.Contains(err.Error(), "0001_create_notes, 0002_create_tags") {
+		t.Errorf("Check() error = %v, want both migrations pending", err)
+	}
+	if db.Migrator().HasTable(migrationTable) {
+		t.Errorf("Check() created %s", migrationTable)
+	}
+
+	done, err := m.Up(ctx, 1)
+	if err != nil {
+		t.Fatalf("Up(1) error = %v", err)
+	}
+	if len(done) != 1 || done[0].Version != 1 || !db.Migrator().HasTable("notes") || db.Migrator().HasTable("tags") {
+		t.Errorf("Up(1) = %v, want only 0001 applied", done)
+	}
+	if err := m.Check(ctx); err == nil || !strings.Contains(err.Error(), "落后 1 个版本") {
+		t.Errorf("Check() error = %v, want 1 pending", err)
+	}
+	if done, err := m.Up(ctx, 0); err != nil || len(done) != 1 || !db.Migrator().HasTable("tags") {
+		t.Fatalf("Up(0) = %v, %v, want 0002 applied", done, err)
+	}
+	if err := m.Check(ctx); err != nil {
+		t.Errorf("Check() error = %v, want up to date", err)
+	}
+	sts, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sts) != 2 || !sts[0].Applied || !sts[1].Applied || sts[0].Modified {
+		t.Errorf("Status() = %v, want both applied", sts)
+	}
+
+	t.Run("modified", func(t *testing.T) {
+		files := testMigrations()
+		files["0001_create_notes.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE notes (id INTEGER PRIMARY KEY, body TEXT);\n")}
+		edited := testMigrator(t, db, files)
+		if err := edited.Check(ctx); err == nil || !strings.Contains(err.Error(), "文件被修改") {
+			t.Errorf("Check() error = %v, want the edited migration reported", err)
+		}
+		if _, err := edited.Up(ctx, 0); err == nil {
+			t.Error("Up() with an edited migration error = nil")
+		}
+	})
+
+	t.Run("lock", func(t *testing.T) {
+		sqlDB, err := db.DB()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn, err := sqlDB.Conn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		if _, err := conn.ExecContext(ctx, "SELECT GET_LOCK(?, 30)", migrationLock); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := m.Down(ctx, 1); err == nil || !strings.Contains(err.Error(), "迁移锁") {
+			t.Errorf("Down() while another run holds the lock error = %v", err)
+		}
+		if !db.Migrator().HasTable("tags") {
+			t.Error("Down() rolled back without the lock")
+		}
+		if _, err := conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", migrationLock); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	done, err = m.Down(ctx, 0)
+	if err != nil {
+		t.Fatalf("Down(0) error = %v", err)
+	}
+	if len(done) != 1 || done[0].Version != 2 || db.Migrator().HasTable("tags") || !db.Migrator().HasTable("notes") {
+		t.Errorf("Down(0) = %v, want the last migration rolled back", done)
+	}
+	if done, err := m.Down(ctx, 5); err != nil || len(done) != 1 || db.Migrator().HasTable("notes") {
+		t.Errorf("Down(5) = %v, %v, want the rest rolled back", done, err)
+	}
+	if err := m.Check(ctx); err == nil {
+		t.Error("Check() after rolling back error = nil")
+	}
+}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `users` (
+  `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at` DATETIME(3)     NULL,
+  `updated_at` DATETIME(3)     NULL,
+  `deleted_at` DATETIME(3)     NULL,
+  `mobile`     VARCHAR(20)     NOT NULL COMMENT '账号或手机号',
+  `nick_name`  VARCHAR(64)     NOT NULL DEFAULT '' COMMENT '昵称',
+  `password`   VARCHAR(255)    NOT NULL DEFAULT '' COMMENT '密码',
+  `birthday`   INT             NOT NULL DEFAULT 0 COMMENT '生日',
+  `gender`     TINYINT         NOT NULL DEFAULT 0 COMMENT '性别（0男 1女）',
+  `grade`      TINYINT         NOT NULL DEFAULT 0 COMMENT '等级（0普通游客 1会员 2商家 3管理）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_users_mobile` (`mobile`),
+  KEY `idx_users_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `customers`;
+DROP TABLE IF EXISTS `points_records`;
+DROP TABLE IF EXISTS `deals`;
+DROP TABLE IF EXISTS `houses`;
@@ -0,0 +1,83 @@
+CREATE TABLE IF NOT EXISTS `houses` (
+  `id`             BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`     DATETIME(3)     NULL,
+  `updated_at`     DATETIME(3)     NULL,
+  `deleted_at`     DATETIME(3)     NULL,
+  `owner_id`       BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '发布人（商家）',
+  `listing_type`   TINYINT         NOT NULL DEFAULT 0 COMMENT '0出售 1出租',
+  `title`          VARCHAR(128)    NOT NULL DEFAULT '' COMMENT '标题',
+  `description`    TEXT            NULL COMMENT '描述',
+  `city`           VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '城市',
+  `district`       VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '区县',
+  `community_name` VARCHAR(64)     NOT NULL DEFAULT '' COMMENT '小区名称',
+  `rooms`          TINYINT         NOT NULL DEFAULT 0 COMMENT '室',
+  `halls`          TINYINT         NOT NULL DEFAULT 0 COMMENT '厅',
+  `baths`          TINYINT         NOT NULL DEFAULT 0 COMMENT '卫',
+  `area`           DOUBLE          NOT NULL DEFAULT 0 COMMENT '建筑面积（㎡）',
+  `floor`          INT             NOT NULL DEFAULT 0 COMMENT '所在楼层',
+  `total_floors`   INT             NOT NULL DEFAULT 0 COMMENT '总楼层',
+  `orientation`    VARCHAR(16)     NOT NULL DEFAULT '' COMMENT '朝向',
+  `build_year`     INT             NOT NULL DEFAULT 0 COMMENT '建成年份',
+  `price`          BIGINT          NOT NULL DEFAULT 0 COMMENT '总价（元）',
+  `unit_price`     BIGINT          NOT NULL DEFAULT 0 COMMENT '单价（元/㎡）',
+  `status`         TINYINT         NOT NULL DEFAULT 0 COMMENT '0在售 1已售 2下架',
+  PRIMARY KEY (`id`),
+  KEY `idx_houses_district` (`city`, `district`),
+  KEY `idx_houses_community` (`community_name`),
+  KEY `idx_houses_owner` (`owner_id`),
+  KEY `idx_houses_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='房源';
+
+CREATE TABLE IF NOT EXISTS `deals` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`   DATETIME(3)     NULL,
+  `updated_at`   DATETIME(3)     NULL,
+  `deleted_at`   DATETIME(3)     NULL,
+  `house_id`     BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `buyer_id`     BIGINT UNSIGNED NOT NULL COMMENT '买方',
+  `agent_id`     BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '经纪人',
+  `price`        BIGINT          NOT NULL DEFAULT 0 COMMENT '成交价（元）',
+  `status`       TINYINT         NOT NULL DEFAULT 0 COMMENT '0进行中 1已成交 2已取消',
+  `completed_at` DATETIME(3)     NULL COMMENT '成交时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_deals_house` (`house_id`),
+  KEY `idx_deals_buyer` (`buyer_id`),
+  KEY `idx_deals_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='交易';
+
+CREATE TABLE IF NOT EXISTS `points_records` (
+  `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at` DATETIME(3)     NULL,
+  `updated_at` DATETIME(3)     NULL,
+  `deleted_at` DATETIME(3)     NULL,
+  `user_id`    BIGINT UNSIGNED NOT NULL COMMENT '用户',
+  `amount`     BIGINT          NOT NULL COMMENT '变动积分',
+  `balance`    BIGINT          NOT NULL DEFAULT 0 COMMENT '变动后余额',
+  `reason`     VARCHAR(64)     NOT NULL DEFAULT '' COMMENT '原因',
+  `biz_type`   VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '关联业务类型',
+  `biz_id`     BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '关联业务ID',
+  PRIMARY KEY (`id`),
+  KEY `idx_points_records_user` (`user_id`),
+  KEY `idx_points_records_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='积分流水';
+
+CREATE TABLE IF NOT EXISTS `customers` (
+  `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at` DATETIME(3)     NULL,
+  `updated_at` DATETIME(3)     NULL,
+  `deleted_at` DATETIME(3)     NULL,
+  `agent_id`   BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '跟进经纪人，0为公共池',
+  `user_id`    BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '关联用户',
+  `name`       VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '称呼',
+  `mobile`     VARCHAR(20)     NOT NULL COMMENT '手机号',
+  `stage`      TINYINT         NOT NULL DEFAULT 0 COMMENT '跟进阶段',
+  `district`   VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '意向区域',
+  `rooms`      TINYINT         NOT NULL DEFAULT 0 COMMENT '意向居室',
+  `budget_min` BIGINT          NOT NULL DEFAULT 0 COMMENT '预算下限（元）',
+  `budget_max` BIGINT          NOT NULL DEFAULT 0 COMMENT '预算上限（元）',
+  `remark`     VARCHAR(255)    NOT NULL DEFAULT '' COMMENT '备注',
+  PRIMARY KEY (`id`),
+  KEY `idx_customers_agent` (`agent_id`),
+  KEY `idx_customers_mobile` (`mobile`),
+  KEY `idx_customers_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='客户';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `event_consumptions`;
+DROP TABLE IF EXISTS `outbox_events`;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS `outbox_events` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `event_id`     VARCHAR(32)     NOT NULL COMMENT '事件ID，订阅方据此去重',
+  `topic`        VARCHAR(64)     NOT NULL COMMENT '事件类型',
+  `event_key`    VARCHAR(64)     NOT NULL DEFAULT '' COMMENT '聚合ID',
+  `payload`      BLOB            NOT NULL COMMENT 'JSON',
+  `occurred_at`  DATETIME(3)     NOT NULL COMMENT '发生时间',
+  `published_at` DATETIME(3)     NULL COMMENT '发布时间，NULL 表示待发布',
+  `attempts`     INT             NOT NULL DEFAULT 0 COMMENT '发布失败次数',
+  `last_error`   VARCHAR(512)    NOT NULL DEFAULT '' COMMENT '最近一次发布错误',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_outbox_events_event_id` (`event_id`),
+  KEY `idx_outbox_events_published_at` (`published_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='事务发件箱';
+
+CREATE TABLE IF NOT EXISTS `event_consumptions` (
+  `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `consumer`   VARCHAR(64)     NOT NULL COMMENT '订阅方',
+  `event_id`   VARCHAR(32)     NOT NULL COMMENT '事件ID',
+  `created_at` DATETIME(3)     NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_event_consumptions` (`consumer`, `event_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='已处理事件，用于去重';
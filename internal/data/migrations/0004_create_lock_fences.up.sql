@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS `lock_fences` (
+  `name`       VARCHAR(128) NOT NULL COMMENT '锁名',
+  `token`      BIGINT       NOT NULL COMMENT '最近一次写入的持有者令牌',
+  `updated_at` DATETIME(3)  NULL,
+  PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='分布式锁的 fencing token';
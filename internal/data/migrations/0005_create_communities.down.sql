@@ -0,0 +1,7 @@
+ALTER TABLE `houses`
+  DROP KEY `idx_houses_community_id`,
+  DROP COLUMN `community_id`;
+
+DROP TABLE IF EXISTS `units`;
+DROP TABLE IF EXISTS `buildings`;
+DROP TABLE IF EXISTS `communities`;
@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS `communities` (
+  `id`               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`       DATETIME(3)     NULL,
+  `updated_at`       DATETIME(3)     NULL,
+  `deleted_at`       DATETIME(3)     NULL,
+  `name`             VARCHAR(64)     NOT NULL COMMENT '名称',
+  `aliases`          VARCHAR(512)    NULL COMMENT '别名，JSON 数组',
+  `city`             VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '城市',
+  `district`         VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '区县',
+  `business_area`    VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '商圈',
+  `address`          VARCHAR(128)    NOT NULL DEFAULT '' COMMENT '地址',
+  `longitude`        DOUBLE          NOT NULL DEFAULT 0 COMMENT '经度',
+  `latitude`         DOUBLE          NOT NULL DEFAULT 0 COMMENT '纬度',
+  `build_year`       INT             NOT NULL DEFAULT 0 COMMENT '建成年份',
+  `property_company` VARCHAR(64)     NOT NULL DEFAULT '' COMMENT '物业公司',
+  `property_fee`     DOUBLE          NOT NULL DEFAULT 0 COMMENT '物业费（元/㎡·月）',
+  `greening_rate`    DOUBLE          NOT NULL DEFAULT 0 COMMENT '绿化率（%）',
+  `plot_ratio`       DOUBLE          NOT NULL DEFAULT 0 COMMENT '容积率',
+  PRIMARY KEY (`id`),
+  KEY `idx_communities_name` (`city`, `name`),
+  KEY `idx_communities_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='小区';
+
+CREATE TABLE IF NOT EXISTS `buildings` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`   DATETIME(3)     NULL,
+  `updated_at`   DATETIME(3)     NULL,
+  `deleted_at`   DATETIME(3)     NULL,
+  `community_id` BIGINT UNSIGNED NOT NULL COMMENT '小区',
+  `name`         VARCHAR(32)     NOT NULL COMMENT '名称，如3号楼',
+  `floors`       INT             NOT NULL DEFAULT 0 COMMENT '地上层数',
+  PRIMARY KEY (`id`),
+  KEY `idx_buildings_community` (`community_id`),
+  KEY `idx_buildings_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='楼栋';
+
+CREATE TABLE IF NOT EXISTS `units` (
+  `id`          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`  DATETIME(3)     NULL,
+  `updated_at`  DATETIME(3)     NULL,
+  `deleted_at`  DATETIME(3)     NULL,
+  `building_id` BIGINT UNSIGNED NOT NULL COMMENT '楼栋',
+  `name`        VARCHAR(32)     NOT NULL COMMENT '名称，如1单元',
+  `households`  INT             NOT NULL DEFAULT 0 COMMENT '户数',
+  PRIMARY KEY (`id`),
+  KEY `idx_units_building` (`building_id`),
+  KEY `idx_units_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='单元';
+
+ALTER TABLE `houses`
+  ADD COLUMN `community_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '小区' AFTER `district`,
+  ADD KEY `idx_houses_community_id` (`community_id`);
@@ -0,0 +1,13 @@
+ALTER TABLE `customers`
+  DROP KEY `idx_customers_region`,
+  DROP COLUMN `region_id`;
+
+ALTER TABLE `communities`
+  DROP KEY `idx_communities_region`,
+  DROP COLUMN `region_id`;
+
+ALTER TABLE `houses`
+  DROP KEY `idx_houses_region`,
+  DROP COLUMN `region_id`;
+
+DROP TABLE IF EXISTS `regions`;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `regions` (
+  `id`         BIGINT UNSIGNED NOT NULL COMMENT '区划代码，商圈为区县代码加三位序号',
+  `parent_id`  BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '上级，省为0',
+  `level`      TINYINT         NOT NULL COMMENT '1省 2市 3区县 4商圈',
+  `name`       VARCHAR(32)     NOT NULL COMMENT '全称',
+  `short_name` VARCHAR(32)     NOT NULL COMMENT '简称',
+  PRIMARY KEY (`id`),
+  KEY `idx_regions_parent` (`parent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='行政区域';
+
+ALTER TABLE `houses`
+  ADD COLUMN `region_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '区域（区县或商圈）' AFTER `district`,
+  ADD KEY `idx_houses_region` (`region_id`);
+
+ALTER TABLE `communities`
+  ADD COLUMN `region_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '区域（区县或商圈）' AFTER `business_area`,
+  ADD KEY `idx_communities_region` (`region_id`);
+
+ALTER TABLE `customers`
+  ADD COLUMN `region_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '意向区域' AFTER `district`,
+  ADD KEY `idx_customers_region` (`region_id`);
@@ -0,0 +1,2 @@
+ALTER TABLE `houses`
+  ADD COLUMN `tags` VARCHAR(512) NULL COMMENT '标签，JSON 数组' AFTER `status`;
@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS `price_changes` (
+  `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at` DATETIME(3)     NULL,
+  `updated_at` DATETIME(3)     NULL,
+  `deleted_at` DATETIME(3)     NULL,
+  `house_id`   BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `old_price`  BIGINT          NOT NULL COMMENT '调整前总价（元）',
+  `new_price`  BIGINT          NOT NULL COMMENT '调整后总价（元）',
+  PRIMARY KEY (`id`),
+  KEY `idx_price_changes_house` (`house_id`),
+  KEY `idx_price_changes_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='房源调价记录';
+
+CREATE TABLE IF NOT EXISTS `price_alerts` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`   DATETIME(3)     NULL,
+  `updated_at`   DATETIME(3)     NULL,
+  `deleted_at`   DATETIME(3)     NULL,
+  `user_id`      BIGINT UNSIGNED NOT NULL COMMENT '用户',
+  `house_id`     BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `drop_percent` INT             NOT NULL COMMENT '降价幅度（%）',
+  `base_price`   BIGINT          NOT NULL COMMENT '计算降幅的基准总价（元）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_price_alerts_user_house` (`user_id`, `house_id`),
+  KEY `idx_price_alerts_house` (`house_id`),
+  KEY `idx_price_alerts_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='降价提醒';
+
+CREATE TABLE IF NOT EXISTS `notifications` (
+  `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at` DATETIME(3)     NULL,
+  `updated_at` DATETIME(3)     NULL,
+  `deleted_at` DATETIME(3)     NULL,
+  `user_id`    BIGINT UNSIGNED NOT NULL COMMENT '接收用户',
+  `kind`       VARCHAR(32)     NOT NULL COMMENT '类型',
+  `title`      VARCHAR(64)     NOT NULL DEFAULT '' COMMENT '标题',
+  `content`    VARCHAR(512)    NOT NULL DEFAULT '' COMMENT '内容',
+  `biz_type`   VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '关联业务类型',
+  `biz_id`     BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '关联业务ID',
+  PRIMARY KEY (`id`),
+  KEY `idx_notifications_user` (`user_id`),
+  KEY `idx_notifications_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='站内通知';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `browse_records`;
+DROP TABLE IF EXISTS `favorites`;
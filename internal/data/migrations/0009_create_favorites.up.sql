@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS `favorites` (
+  `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at` DATETIME(3)     NULL,
+  `updated_at` DATETIME(3)     NULL,
+  `deleted_at` DATETIME(3)     NULL,
+  `user_id`    BIGINT UNSIGNED NOT NULL COMMENT '用户',
+  `house_id`   BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `folder`     VARCHAR(32)     NOT NULL DEFAULT '默认' COMMENT '收藏夹',
+  `note`       VARCHAR(512)    NOT NULL DEFAULT '' COMMENT '备注',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_favorites_user_house` (`user_id`, `house_id`),
+  KEY `idx_favorites_house` (`house_id`),
+  KEY `idx_favorites_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='房源收藏';
+
+CREATE TABLE IF NOT EXISTS `browse_records` (
+  `id`        BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `user_id`   BIGINT UNSIGNED NOT NULL COMMENT '用户',
+  `house_id`  BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `viewed_at` DATETIME(3)     NOT NULL COMMENT '最近浏览时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_browse_records_user_house` (`user_id`, `house_id`),
+  KEY `idx_browse_records_user_viewed` (`user_id`, `viewed_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='浏览记录，Redis 中浏览历史的持久化副本';
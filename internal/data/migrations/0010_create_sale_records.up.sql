@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS `sale_records` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`   DATETIME(3)     NULL,
+  `updated_at`   DATETIME(3)     NULL,
+  `deleted_at`   DATETIME(3)     NULL,
+  `deal_id`      BIGINT UNSIGNED NOT NULL COMMENT '交易',
+  `house_id`     BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `community_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '小区',
+  `city`         VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '城市',
+  `district`     VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '区县',
+  `area`         DOUBLE          NOT NULL COMMENT '建筑面积（㎡）',
+  `floor`        INT             NOT NULL DEFAULT 0 COMMENT '所在楼层',
+  `total_floors` INT             NOT NULL DEFAULT 0 COMMENT '总楼层',
+  `orientation`  VARCHAR(16)     NOT NULL DEFAULT '' COMMENT '朝向',
+  `build_year`   INT             NOT NULL DEFAULT 0 COMMENT '建成年份',
+  `price`        BIGINT          NOT NULL COMMENT '成交价（元）',
+  `unit_price`   BIGINT          NOT NULL COMMENT '成交单价（元/㎡）',
+  `sold_at`      DATETIME(3)     NOT NULL COMMENT '成交时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_sale_records_deal` (`deal_id`),
+  KEY `idx_sale_records_community_sold` (`community_id`, `sold_at`),
+  KEY `idx_sale_records_district_sold` (`city`, `district`, `sold_at`),
+  KEY `idx_sale_records_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='成交案例，估价的可比案例';
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `market_stats` (
+  `id`                     BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`             DATETIME(3)     NULL,
+  `updated_at`             DATETIME(3)     NULL,
+  `deleted_at`             DATETIME(3)     NULL,
+  `scope`                  VARCHAR(16)     NOT NULL COMMENT 'community小区 district区县',
+  `community_id`           BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '小区，区县统计为0',
+  `city`                   VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '城市',
+  `district`               VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '区县',
+  `month`                  CHAR(7)         NOT NULL COMMENT '月份，如 2025-06',
+  `listing_count`          BIGINT          NOT NULL DEFAULT 0 COMMENT '在售挂牌数',
+  `new_listings`           BIGINT          NOT NULL DEFAULT 0 COMMENT '新增挂牌数',
+  `avg_listing_unit_price` BIGINT          NOT NULL DEFAULT 0 COMMENT '挂牌均价（元/㎡）',
+  `deal_count`             BIGINT          NOT NULL DEFAULT 0 COMMENT '成交数',
+  `avg_deal_unit_price`    BIGINT          NOT NULL DEFAULT 0 COMMENT '成交均价（元/㎡）',
+  `avg_days_on_market`     DOUBLE          NOT NULL DEFAULT 0 COMMENT '平均成交周期（天）',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_market_stats_scope_month` (`scope`, `community_id`, `city`, `district`, `month`),
+  KEY `idx_market_stats_month` (`month`),
+  KEY `idx_market_stats_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='小区和区县的月度行情，由定时任务汇总';
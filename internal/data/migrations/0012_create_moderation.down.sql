@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS `moderation_items`;
+DROP TABLE IF EXISTS `listing_fingerprints`;
+ALTER TABLE `houses`
+  DROP COLUMN `images`,
+  DROP COLUMN `building_id`;
@@ -0,0 +1,37 @@
+ALTER TABLE `houses`
+  ADD COLUMN `building_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '楼栋' AFTER `community_name`,
+  ADD COLUMN `images` TEXT NULL COMMENT '图片地址，JSON 数组' AFTER `tags`;
+
+CREATE TABLE IF NOT EXISTS `listing_fingerprints` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`   DATETIME(3)     NULL,
+  `updated_at`   DATETIME(3)     NULL,
+  `deleted_at`   DATETIME(3)     NULL,
+  `house_id`     BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `community_id` BIGINT UNSIGNED NOT NULL COMMENT '小区',
+  `fingerprint`  VARCHAR(128)    NOT NULL COMMENT '房源类型、小区、楼栋、楼层、户型和面积',
+  `images`       TEXT            NULL COMMENT '已计算的图片地址，JSON 数组',
+  `image_hashes` TEXT            NULL COMMENT '图片 dHash，JSON 数组',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_listing_fingerprints_house` (`house_id`),
+  KEY `idx_listing_fingerprints_community` (`community_id`, `fingerprint`),
+  KEY `idx_listing_fingerprints_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='在售房源的指纹，用于检测重复和盗图';
+
+CREATE TABLE IF NOT EXISTS `moderation_items` (
+  `id`          BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`  DATETIME(3)     NULL,
+  `updated_at`  DATETIME(3)     NULL,
+  `deleted_at`  DATETIME(3)     NULL,
+  `house_id`    BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `status`      TINYINT         NOT NULL DEFAULT 0 COMMENT '0待审核 1通过 2驳回',
+  `score`       INT             NOT NULL DEFAULT 0 COMMENT '可疑程度 0-100',
+  `reasons`     TEXT            NULL COMMENT '可疑原因，JSON 数组',
+  `reviewer_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人',
+  `note`        VARCHAR(255)    NOT NULL DEFAULT '' COMMENT '审核备注',
+  `reviewed_at` DATETIME(3)     NULL COMMENT '审核时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_moderation_items_house_id` (`house_id`),
+  KEY `idx_moderation_items_status` (`status`, `id`),
+  KEY `idx_moderation_items_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='房源审核队列';
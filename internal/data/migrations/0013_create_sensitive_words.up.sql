@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `sensitive_words` (
+  `id`         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at` DATETIME(3)     NULL,
+  `updated_at` DATETIME(3)     NULL,
+  `deleted_at` DATETIME(3)     NULL,
+  `word`       VARCHAR(64)     NOT NULL COMMENT '敏感词',
+  `action`     VARCHAR(16)     NOT NULL DEFAULT 'block' COMMENT 'block拒绝 mask打码 review人工审核',
+  `category`   VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '分类',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_sensitive_words_word` (`word`),
+  KEY `idx_sensitive_words_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='敏感词，各实例每分钟重新加载';
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS `leases`;
+ALTER TABLE `houses`
+  DROP KEY `idx_houses_parent`,
+  DROP COLUMN `available_from`,
+  DROP COLUMN `utilities`,
+  DROP COLUMN `room_name`,
+  DROP COLUMN `parent_id`,
+  DROP COLUMN `rental_mode`,
+  DROP COLUMN `pay_months`,
+  DROP COLUMN `deposit_months`,
+  DROP COLUMN `monthly_rent`,
+  MODIFY COLUMN `status` TINYINT NOT NULL DEFAULT 0 COMMENT '0在售 1已售 2下架';
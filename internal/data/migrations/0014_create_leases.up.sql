@@ -0,0 +1,39 @@
+ALTER TABLE `houses`
+  MODIFY COLUMN `status`      TINYINT         NOT NULL DEFAULT 0 COMMENT '0在售 1已售 2下架 3已出租',
+  ADD COLUMN `monthly_rent`   BIGINT          NOT NULL DEFAULT 0 COMMENT '月租金（元）' AFTER `images`,
+  ADD COLUMN `deposit_months` TINYINT         NOT NULL DEFAULT 0 COMMENT '押几个月' AFTER `monthly_rent`,
+  ADD COLUMN `pay_months`     TINYINT         NOT NULL DEFAULT 0 COMMENT '付几个月' AFTER `deposit_months`,
+  ADD COLUMN `rental_mode`    TINYINT         NOT NULL DEFAULT 0 COMMENT '0整租 1合租' AFTER `pay_months`,
+  ADD COLUMN `parent_id`      BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '合租房间所在的整套房源' AFTER `rental_mode`,
+  ADD COLUMN `room_name`      VARCHAR(32)     NOT NULL DEFAULT '' COMMENT '合租房间名' AFTER `parent_id`,
+  ADD COLUMN `utilities`      VARCHAR(255)    NULL COMMENT '租金包含的费用，JSON 数组' AFTER `room_name`,
+  ADD COLUMN `available_from` DATETIME(3)     NULL COMMENT '可入住日期' AFTER `utilities`,
+  ADD KEY `idx_houses_parent` (`parent_id`);
+
+CREATE TABLE IF NOT EXISTS `leases` (
+  `id`               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`       DATETIME(3)     NULL,
+  `updated_at`       DATETIME(3)     NULL,
+  `deleted_at`       DATETIME(3)     NULL,
+  `house_id`         BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `landlord_id`      BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '房东',
+  `tenant_id`        BIGINT UNSIGNED NOT NULL COMMENT '租客',
+  `monthly_rent`     BIGINT          NOT NULL DEFAULT 0 COMMENT '月租金（元）',
+  `deposit_months`   TINYINT         NOT NULL DEFAULT 0 COMMENT '押几个月',
+  `pay_months`       TINYINT         NOT NULL DEFAULT 1 COMMENT '付几个月',
+  `deposit`          BIGINT          NOT NULL DEFAULT 0 COMMENT '押金（元）',
+  `start_date`       DATETIME(3)     NOT NULL COMMENT '起租日',
+  `months`           INT             NOT NULL COMMENT '租期（月）',
+  `end_date`         DATETIME(3)     NOT NULL COMMENT '到期日，不含当天',
+  `status`           TINYINT         NOT NULL DEFAULT 0 COMMENT '0生效中 1已到期 2已解约',
+  `renewed_from`     BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '续租前的租约',
+  `renewal_id`       BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '续租后的租约',
+  `terminated_at`    DATETIME(3)     NULL COMMENT '提前解约日',
+  `penalty`          BIGINT          NOT NULL DEFAULT 0 COMMENT '违约金（元）',
+  `terminate_reason` VARCHAR(255)    NOT NULL DEFAULT '' COMMENT '解约原因',
+  PRIMARY KEY (`id`),
+  KEY `idx_leases_house` (`house_id`),
+  KEY `idx_leases_tenant` (`tenant_id`),
+  KEY `idx_leases_landlord` (`landlord_id`),
+  KEY `idx_leases_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='租约';
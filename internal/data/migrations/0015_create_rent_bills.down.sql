@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `rent_bills`;
+ALTER TABLE `leases` DROP COLUMN `charges`;
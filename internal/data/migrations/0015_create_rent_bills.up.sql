@@ -0,0 +1,31 @@
+ALTER TABLE `leases`
+  ADD COLUMN `charges` VARCHAR(512) NULL COMMENT '随租金收取的固定费用，JSON 数组' AFTER `terminate_reason`;
+
+CREATE TABLE IF NOT EXISTS `rent_bills` (
+  `id`           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `created_at`   DATETIME(3)     NULL,
+  `updated_at`   DATETIME(3)     NULL,
+  `deleted_at`   DATETIME(3)     NULL,
+  `lease_id`     BIGINT UNSIGNED NOT NULL COMMENT '租约',
+  `house_id`     BIGINT UNSIGNED NOT NULL COMMENT '房源',
+  `tenant_id`    BIGINT UNSIGNED NOT NULL COMMENT '租客',
+  `landlord_id`  BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '房东',
+  `kind`         VARCHAR(16)     NOT NULL COMMENT 'rent租金 utility固定费用',
+  `seq`          INT             NOT NULL COMMENT '付款计划的第几期',
+  `period_start` DATETIME(3)     NOT NULL COMMENT '计费开始',
+  `period_end`   DATETIME(3)     NOT NULL COMMENT '计费结束，不含当天',
+  `due_date`     DATETIME(3)     NOT NULL COMMENT '应付日',
+  `amount`       BIGINT          NOT NULL DEFAULT 0 COMMENT '应付（元）',
+  `items`        VARCHAR(512)    NULL COMMENT '费用明细，JSON 数组',
+  `late_fee`     BIGINT          NOT NULL DEFAULT 0 COMMENT '滞纳金（元）',
+  `status`       TINYINT         NOT NULL DEFAULT 0 COMMENT '0待支付 1已支付 2已逾期 3已取消',
+  `paid_amount`  BIGINT          NOT NULL DEFAULT 0 COMMENT '实收（元）',
+  `paid_at`      DATETIME(3)     NULL COMMENT '收款时间',
+  `note`         VARCHAR(255)    NOT NULL DEFAULT '' COMMENT '收款备注',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_rent_bills_period` (`lease_id`, `kind`, `seq`),
+  KEY `idx_rent_bills_tenant` (`tenant_id`),
+  KEY `idx_rent_bills_landlord` (`landlord_id`),
+  KEY `idx_rent_bills_status_due` (`status`, `due_date`),
+  KEY `idx_rent_bills_deleted_at` (`deleted_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='房租账单';
@@ -0,0 +1,12 @@
+// Package migrations embeds the versioned SQL schema migrations.
+//
+// Every change is a pair of files named <version>_<name>.up.sql and
+// <version>_<name>.down.sql. Versions are sequential and never reused;
+// an applied file must not be edited, add a new version instead.
+// Statements are separated by a semicolon at the end of a line.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
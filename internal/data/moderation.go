@@ -0,0 +1,148 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+	"anjuke/internal/data/imagehash"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultImageTimeout bounds an image download when data.moderation sets
+// no image_timeout.
+const defaultImageTimeout = 5 * time.Second
+
+type ModerationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewModerationRepo(data *Data, logger log.Logger) biz.ModerationRepo {
+	return &ModerationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *ModerationRepo) GetFingerprint(ctx context.Context, houseID uint) (*biz.ListingFingerprint, error) {
+	var fp biz.ListingFingerprint
+	err := r.data.DB(ctx).Where("house_id = ?", houseID).Take(&fp).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询房源指纹失败: %v", err)
+	}
+	return &fp, nil
+}
+
+// SaveFingerprint upserts on the unique house_id key.
+func (r *ModerationRepo) SaveFingerprint(ctx context.Context, fp *biz.ListingFingerprint) error {
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"community_id", "fingerprint", "images", "image_hashes", "updated_at"}),
+	}).Create(fp).Error
+	if err != nil {
+		return fmt.Errorf("保存房源指纹失败: %v", err)
+	}
+	return nil
+}
+
+// DeleteFingerprint deletes for good, so the listing can be fingerprinted
+// again when it is back on sale.
+func (r *ModerationRepo) DeleteFingerprint(ctx context.Context, houseID uint) error {
+	if err := r.data.DB(ctx).Unscoped().Where("house_id = ?", houseID).Delete(&biz.ListingFingerprint{}).Error; err != nil {
+		return fmt.Errorf("删除房源指纹失败: %v", err)
+	}
+	return nil
+}
+
+func (r *ModerationRepo) ListFingerprints(ctx context.Context, communityID uint) ([]*biz.ListingFingerprint, error) {
+	var list []*biz.ListingFingerprint
+	if err := r.data.DB(ctx).Where("community_id = ?", communityID).Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询房源指纹失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *ModerationRepo) CreateModeration(ctx context.Context, m *biz.ModerationItem) (*biz.ModerationItem, error) {
+	if err := r.data.DB(ctx).Create(m).Error; err != nil {
+		return nil, fmt.Errorf("加入审核队列失败: %v", err)
+	}
+	return m, nil
+}
+
+func (r *ModerationRepo) UpdateModeration(ctx context.Context, m *biz.ModerationItem) (*biz.ModerationItem, error) {
+	if err := r.data.DB(ctx).Save(m).Error; err != nil {
+		return nil, fmt.Errorf("更新审核记录失败: %v", err)
+	}
+	return m, nil
+}
+
+func (r *ModerationRepo) GetModeration(ctx context.Context, id uint) (*biz.ModerationItem, error) {
+	var m biz.ModerationItem
+	err := r.data.DB(ctx).Take(&m, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询审核记录失败: %v", err)
+	}
+	return &m, nil
+}
+
+func (r *ModerationRepo) LatestModeration(ctx context.Context, houseID uint) (*biz.ModerationItem, error) {
+	var m biz.ModerationItem
+	err := r.data.DB(ctx).Where("house_id = ?", houseID).Order("id DESC").Take(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询审核记录失败: %v", err)
+	}
+	return &m, nil
+}
+
+func (r *ModerationRepo) ListModeration(ctx context.Context, status int32, offset, limit int) ([]*biz.ModerationItem, int64, error) {
+	var total int64
+	if err := r.data.DB(ctx).Model(&biz.ModerationItem{}).Where("status = ?", status).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("查询审核队列失败: %v", err)
+	}
+	var list []*biz.ModerationItem
+	if err := r.data.DB(ctx).Where("status = ?", status).Order("id").Offset(offset).Limit(limit).Find(&list).Error; err != nil {
+		return nil, 0, fmt.Errorf("查询审核队列失败: %v", err)
+	}
+	return list, total, nil
+}
+
+// NewModerationRules returns the detector thresholds: the built-in defaults
+// overridden by every item set in data.moderation.
+func NewModerationRules(c *conf.Data) *biz.ModerationRules {
+	r := biz.DefaultModerationRules()
+	m := c.GetModeration()
+	if m.GetMaxPriceDeviation() != 0 {
+		r.MaxPriceDeviation = m.GetMaxPriceDeviation()
+	}
+	if m.GetMinPriceSamples() != 0 {
+		r.MinPriceSamples = int(m.GetMinPriceSamples())
+	}
+	// 距离可以配置为0，只认完全相同的图片
+	if m.MaxImageDistance != nil {
+		r.MaxImageDistance = int(m.GetMaxImageDistance())
+	}
+	return r
+}
+
+// NewImageHasher downloads listing images over HTTP to hash them.
+func NewImageHasher(c *conf.Data) biz.ImageHasher {
+	timeout := c.GetModeration().GetImageTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultImageTimeout
+	}
+	return imagehash.New(timeout)
+}
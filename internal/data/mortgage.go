@@ -0,0 +1,46 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+)
+
+// NewMortgageRates returns the calculator's rate tables: the built-in
+// defaults overridden by every item set in data.mortgage.
+func NewMortgageRates(c *conf.Data) *biz.MortgageRates {
+	r := biz.DefaultMortgageRates()
+	m := c.GetMortgage()
+	for _, o := range []struct {
+		dst *float64
+		v   float64
+	}{
+		{&r.CommercialFirst, m.GetCommercialFirst()},
+		{&r.CommercialSecond, m.GetCommercialSecond()},
+		{&r.FundFirst, m.GetFundFirst()},
+		{&r.FundSecond, m.GetFundSecond()},
+		{&r.DownPaymentFirst, m.GetDownPaymentFirst()},
+		{&r.DownPaymentSecond, m.GetDownPaymentSecond()},
+		{&r.VATRate, m.GetVatRate()},
+		{&r.AgencyFeeRate, m.GetAgencyFeeRate()},
+	} {
+		if o.v != 0 {
+			*o.dst = o.v
+		}
+	}
+	if m.GetFundLimit() != 0 {
+		r.FundLimit = m.GetFundLimit()
+	}
+	if m.GetMaxYears() != 0 {
+		r.MaxYears = m.GetMaxYears()
+	}
+	if m.GetVatExemptYears() != 0 {
+		r.VATExemptYears = m.GetVatExemptYears()
+	}
+	if len(m.GetDeedTax()) > 0 {
+		r.DeedTax = nil
+		for _, b := range m.GetDeedTax() {
+			r.DeedTax = append(r.DeedTax, biz.DeedTaxBracket{Home: b.GetHome(), MaxArea: b.GetMaxArea(), Rate: b.GetRate()})
+		}
+	}
+	return r
+}
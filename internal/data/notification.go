@@ -0,0 +1,37 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type NotificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &NotificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *NotificationRepo) CreateNotification(ctx context.Context, n *biz.Notification) (*biz.Notification, error) {
+	if err := r.data.DB(ctx).Create(n).Error; err != nil {
+		return nil, fmt.Errorf("创建通知失败: %v", err)
+	}
+	return n, nil
+}
+
+func (r *NotificationRepo) ListNotifications(ctx context.Context, userID uint, limit int) ([]*biz.Notification, error) {
+	var list []*biz.Notification
+	err := r.data.DB(ctx).Where("user_id = ?", userID).Order("id DESC").Limit(limit).Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询通知失败: %v", err)
+	}
+	return list, nil
+}
@@ -0,0 +1,166 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// outboxInterval is how often the relay polls for unpublished events.
+	outboxInterval = time.Second
+	// outboxBatch is the most events published per poll.
+	outboxBatch = 100
+	// outboxRetention is how long published events are kept for debugging.
+	outboxRetention = 7 * 24 * time.Hour
+)
+
+// outboxEvent is a row of the outbox table.
+type outboxEvent struct {
+	ID          uint   `gorm:"primaryKey"`
+	EventID     string `gorm:"size:32;uniqueIndex"`
+	Topic       string `gorm:"size:64"`
+	EventKey    string `gorm:"size:64"`
+	Payload     []byte
+	OccurredAt  time.Time
+	PublishedAt *time.Time
+	Attempts    int32
+	LastError   string `gorm:"size:512"`
+}
+
+func (outboxEvent) TableName() string { return "outbox_events" }
+
+func (o *outboxEvent) event() *biz.Event {
+	return &biz.Event{ID: o.EventID, Topic: o.Topic, Key: o.EventKey, Payload: o.Payload, OccurredAt: o.OccurredAt}
+}
+
+// writeOutbox stores events with tx, which must be the transaction of the
+// business change that raised them.
+func writeOutbox(tx *gorm.DB, events []*biz.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	rows := make([]*outboxEvent, 0, len(events))
+	for _, e := range events {
+		rows = append(rows, &outboxEvent{
+			EventID:    e.ID,
+			Topic:      e.Topic,
+			EventKey:   e.Key,
+			Payload:    e.Payload,
+			OccurredAt: e.OccurredAt,
+		})
+	}
+	return tx.Create(&rows).Error
+}
+
+// OutboxRelay publishes outbox events in order and marks them published. It
+// implements transport.Server so the kratos app starts and stops it with the
+// other servers; several instances can run at once.
+type OutboxRelay struct {
+	data *Data
+	pub  biz.EventPublisher
+	log  *log.Helper
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewOutboxRelay new an OutboxRelay.
+func NewOutboxRelay(data *Data, pub biz.EventPublisher, logger log.Logger) *OutboxRelay {
+	return &OutboxRelay{data: data, pub: pub, log: log.NewHelper(logger), stop: make(chan struct{})}
+}
+
+// Start polls the outbox until Stop is called or ctx is done.
+func (r *OutboxRelay) Start(ctx context.Context) error {
+	ticker := time.NewTicker(outboxInterval)
+	defer ticker.Stop()
+	lastPurge := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.stop:
+			return nil
+		case <-ticker.C:
+		}
+		// 一批发满说明还有积压，立即继续
+		for {
+			n, err := r.RelayOnce(ctx)
+			if err != nil {
+				r.log.Errorf("outbox relay: %v", err)
+			}
+			if err != nil || n < outboxBatch {
+				break
+			}
+		}
+		if time.Since(lastPurge) > time.Hour {
+			r.purge(ctx)
+			lastPurge = time.Now()
+		}
+	}
+}
+
+// Stop stops the polling loop after the current batch.
+func (r *OutboxRelay) Stop(context.Context) error {
+	r.stopOnce.Do(func() { close(r.stop) })
+	return nil
+}
+
+// RelayOnce publishes one batch and returns how many events were published.
+// Rows are locked with SKIP LOCKED so concurrent relays never publish the same
+// batch; a publish failure stops the batch to keep events in order.
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	var n int
+	var pubErr error
+	err := r.data.Primary(ctx).Transaction(func(tx *gorm.DB) error {
+		var rows []*outboxEvent
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL").Order("id").Limit(outboxBatch).Find(&rows).Error
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := r.pub.Publish(ctx, row.event()); err != nil {
+				msg := err.Error()
+				if len(msg) > 512 {
+					msg = msg[:512]
+				}
+				if uerr := tx.Model(row).Updates(map[string]interface{}{
+					"attempts":   gorm.Expr("attempts + 1"),
+					"last_error": msg,
+				}).Error; uerr != nil {
+					return uerr
+				}
+				// 提交已发布的行和重试次数，剩下的下次再发
+				pubErr = fmt.Errorf("发布事件 %s(%s) 失败: %v", row.Topic, row.EventID, err)
+				return nil
+			}
+			if err := tx.Model(row).Update("published_at", time.Now()).Error; err != nil {
+				return err
+			}
+			n++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, pubErr
+}
+
+// purge deletes events published longer ago than outboxRetention.
+func (r *OutboxRelay) purge(ctx context.Context) {
+	err := r.data.Primary(ctx).
+		Where("published_at < ?", time.Now().Add(-outboxRetention)).
+		Delete(&outboxEvent{}).Error
+	if err != nil {
+		r.log.Errorf("outbox purge: %v", err)
+	}
+}
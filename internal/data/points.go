@@ -2,6 +2,9 @@ package data
 
 import (
 	"anjuke/internal/biz"
+	"context"
+	"fmt"
+
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -16,3 +19,31 @@ func NewPointsRepo(data *Data, logger log.Logger) biz.PointsRepo {
 		log:  log.NewHelper(logger),
 	}
 }
+
+func (r *PointsRepo) CreateRecord(ctx context.Context, p *biz.PointsRecord) (*biz.PointsRecord, error) {
+	if err := r.data.DB(ctx).Create(p).Error; err != nil {
+		return nil, fmt.Errorf("记录积分失败: %v", err)
+	}
+	return p, nil
+}
+
+func (r *PointsRepo) Balance(ctx context.Context, userID uint) (int64, error) {
+	var balance int64
+	err := r.data.DB(ctx).Model(&biz.PointsRecord{}).
+		Where("user_id = ?", userID).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&balance).Error
+	if err != nil {
+		return 0, fmt.Errorf("查询积分失败: %v", err)
+	}
+	return balance, nil
+}
+
+func (r *PointsRepo) ListRecords(ctx context.Context, userID uint) ([]*biz.PointsRecord, error) {
+	var list []*biz.PointsRecord
+	err := r.data.DB(ctx).Where("user_id = ?", userID).Order("id DESC").Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询积分记录失败: %v", err)
+	}
+	return list, nil
+}
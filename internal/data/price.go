@@ -0,0 +1,83 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type PriceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPriceRepo(data *Data, logger log.Logger) biz.PriceRepo {
+	return &PriceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *PriceRepo) CreatePriceChange(ctx context.Context, c *biz.PriceChange) (*biz.PriceChange, error) {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Create(c).Error; err != nil {
+			return err
+		}
+		e, err := biz.NewEvent(&biz.HousePriceChangedEvent{HouseID: c.HouseID, OldPrice: c.OldPrice, NewPrice: c.NewPrice})
+		if err != nil {
+			return err
+		}
+		return writeOutbox(r.data.DB(ctx), []*biz.Event{e})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("记录调价失败: %v", err)
+	}
+	return c, nil
+}
+
+func (r *PriceRepo) ListPriceChanges(ctx context.Context, houseID uint) ([]*biz.PriceChange, error) {
+	var list []*biz.PriceChange
+	if err := r.data.DB(ctx).Where("house_id = ?", houseID).Order("id").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询调价记录失败: %v", err)
+	}
+	return list, nil
+}
+
+// SavePriceAlert upserts on the unique (user_id, house_id) key, then reads
+// the row back since MySQL reports no ID for an update.
+func (r *PriceRepo) SavePriceAlert(ctx context.Context, a *biz.PriceAlert) (*biz.PriceAlert, error) {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		err := r.data.DB(ctx).Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"drop_percent", "base_price", "updated_at"}),
+		}).Create(&biz.PriceAlert{UserID: a.UserID, HouseID: a.HouseID, DropPercent: a.DropPercent, BasePrice: a.BasePrice}).Error
+		if err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Where("user_id = ? AND house_id = ?", a.UserID, a.HouseID).Take(a).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("保存降价提醒失败: %v", err)
+	}
+	return a, nil
+}
+
+func (r *PriceRepo) DeletePriceAlert(ctx context.Context, userID, houseID uint) error {
+	// 物理删除，以免软删除的行占住唯一键
+	err := r.data.DB(ctx).Unscoped().Where("user_id = ? AND house_id = ?", userID, houseID).Delete(&biz.PriceAlert{}).Error
+	if err != nil {
+		return fmt.Errorf("取消降价提醒失败: %v", err)
+	}
+	return nil
+}
+
+func (r *PriceRepo) ListPriceAlerts(ctx context.Context, houseID uint) ([]*biz.PriceAlert, error) {
+	var list []*biz.PriceAlert
+	err := r.data.DB(ctx).Where("house_id = ?", houseID).Order("id").Find(&list).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询降价提醒失败: %v", err)
+	}
+	return list, nil
+}
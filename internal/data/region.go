@@ -0,0 +1,50 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"anjuke/internal/data/regiondata"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type RegionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRegionRepo(data *Data, logger log.Logger) biz.RegionRepo {
+	return &RegionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *RegionRepo) ListRegions(ctx context.Context) ([]*biz.Region, error) {
+	var list []*biz.Region
+	if err := r.data.DB(ctx).Order("id").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询区域失败: %v", err)
+	}
+	return list, nil
+}
+
+// ListStations returns the bundled stations.
+func (r *RegionRepo) ListStations(context.Context) ([]*biz.Station, error) {
+	return regiondata.LoadStations()
+}
+
+// SeedRegions inserts the bundled regions; several instances may seed at
+// once since existing IDs are skipped.
+func (r *RegionRepo) SeedRegions(ctx context.Context) (int, error) {
+	list, err := regiondata.Load()
+	if err != nil {
+		return 0, err
+	}
+	res := r.data.Primary(ctx).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(list, 500)
+	if res.Error != nil {
+		return 0, fmt.Errorf("导入区域失败: %v", res.Error)
+	}
+	return int(res.RowsAffected), nil
+}
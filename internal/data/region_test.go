@@ -0,0 +1,52 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"anjuke/internal/biz"
+	"anjuke/internal/data/regiondata"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestRegionRepo_SeedExisting(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&biz.Region{}); err != nil {
+		t.Fatal(err)
+	}
+	bundled, err := regiondata.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 旧版本导入过的库：只有前一半区域，其中一个改过名
+	old := bundled[:len(bundled)/2]
+	renamed := *old[0]
+	renamed.ShortName = "改过"
+	if err := db.Create(append([]*biz.Region{&renamed}, old[1:]...)).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	uc := biz.NewRegionUsecase(NewRegionRepo(&Data{db: db}, log.DefaultLogger), log.DefaultLogger)
+	ctx := context.Background()
+	list, err := uc.Regions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != len(bundled) {
+		t.Errorf("Regions() = %d, want the %d bundled ones after startup", len(list), len(bundled))
+	}
+	path, err := uc.Path(ctx, renamed.ID)
+	if err != nil || path[len(path)-1].ShortName != "改过" {
+		t.Errorf("Path() = %v, %v, want the existing region kept", path, err)
+	}
+
+	// 运行中删掉的区域在手动刷新时补回
+	last := bundled[len(bundled)-1]
+	if err := db.Delete(&biz.Region{}, last.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if n, err := uc.Reload(ctx); err != nil || n != len(bundled) {
+		t.Errorf("Reload() = %d, %v, want %d", n, err, len(bundled))
+	}
+}
@@ -0,0 +1,72 @@
+// Package regiondata bundles the region tree that a fresh database is seeded
+// with: provinces, cities and districts by GB/T 2260 code, plus the business
+// areas of the main cities.
+//
+// regions.csv has the columns id,parent_id,level,name,short_name. Business
+// areas use the district code followed by a three digit sequence as ID. Add
+// rows with new IDs only; existing databases import them on the next seed.
+//
+// stations.csv lists the main subway stations, which are read from the
+// bundle directly and never stored, with the columns
+// name,lines,region_id,longitude,latitude; lines are separated by "|",
+// region_id is the district and the coordinates are WGS-84.
+package regiondata
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"anjuke/internal/biz"
+)
+
+var (
+	//go:embed regions.csv
+	regionsCSV []byte
+	//go:embed stations.csv
+	stationsCSV []byte
+)
+
+// Load parses the bundled dataset.
+func Load() ([]*biz.Region, error) {
+	records, err := csv.NewReader(bytes.NewReader(regionsCSV)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("解析区域数据失败: %v", err)
+	}
+	list := make([]*biz.Region, 0, len(records))
+	for i, rec := range records[1:] {
+		id, err1 := strconv.ParseUint(rec[0], 10, 64)
+		parent, err2 := strconv.ParseUint(rec[1], 10, 64)
+		level, err3 := strconv.ParseInt(rec[2], 10, 32)
+		if err1 != nil || err2 != nil || err3 != nil {
+			return nil, fmt.Errorf("区域数据第 %d 行格式错误: %v", i+2, rec)
+		}
+		list = append(list, &biz.Region{ID: uint(id), ParentID: uint(parent), Level: int32(level), Name: rec[3], ShortName: rec[4]})
+	}
+	return list, nil
+}
+
+// LoadStations parses the bundled subway stations.
+func LoadStations() ([]*biz.Station, error) {
+	records, err := csv.NewReader(bytes.NewReader(stationsCSV)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("解析地铁站数据失败: %v", err)
+	}
+	list := make([]*biz.Station, 0, len(records))
+	for i, rec := range records[1:] {
+		region, err := strconv.ParseUint(rec[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("地铁站数据第 %d 行格式错误: %v", i+2, rec)
+		}
+		lng, err1 := strconv.ParseFloat(rec[3], 64)
+		lat, err2 := strconv.ParseFloat(rec[4], 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("地铁站数据第 %d 行坐标错误: %v", i+2, rec)
+		}
+		list = append(list, &biz.Station{Name: rec[0], Lines: strings.Split(rec[1], "|"), RegionID: uint(region), Longitude: lng, Latitude: lat})
+	}
+	return list, nil
+}
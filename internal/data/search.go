@@ -0,0 +1,14 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+	"anjuke/internal/data/search"
+)
+
+// NewHouseSearcher opens the listing index embedded in this instance. Every
+// instance keeps its own copy, fed by a consumer group of its own named
+// after data.search.instance, which outlives redeploys unlike the hostname.
+func NewHouseSearcher(c *conf.Data) (biz.HouseSearcher, func(), error) {
+	return search.Open(c.GetSearch().GetPath(), "search@"+c.GetSearch().GetInstance())
+}
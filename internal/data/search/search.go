@@ -0,0 +1,313 @@
+// Package search is the embedded Bleve implementation of biz.HouseSearcher.
+//
+// Chinese text is split into overlapping character bigrams (CJK bigram
+// analysis), which needs no dictionary and matches any substring of two
+// characters or more. Layout synonyms such as 两室/2室/二室/两房 are reduced to
+// one spelling after bigramming, at index and at query time alike.
+package search
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"anjuke/internal/biz"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/registry"
+	"github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+)
+
+const (
+	analyzerName = "anjuke_zh"
+	layoutName   = "anjuke_layout"
+)
+
+var (
+	// layoutNumerals spells the room counts the way layouts are indexed.
+	layoutNumerals = map[string]string{
+		"1": "一", "2": "两", "3": "三", "4": "四", "5": "五", "6": "六", "7": "七", "8": "八", "9": "九",
+		"一": "一", "二": "两", "两": "两", "三": "三", "四": "四", "五": "五", "六": "六", "七": "七", "八": "八", "九": "九",
+	}
+	// layoutUnits maps the units of a layout to their canonical form; 房 as
+	// in 两房 means bedrooms.
+	layoutUnits = map[string]string{"室": "室", "房": "室", "厅": "厅", "卫": "卫"}
+)
+
+// layoutFilter runs after the bigram filter and rewrites the tokens of a
+// layout to one spelling: a digit followed by a unit, which the tokenizer
+// splits, is merged into one token spanning both, so 2室, 二室 and 两房 all
+// become 两室. Offsets keep pointing at the original text for highlighting.
+type layoutFilter struct{}
+
+func (layoutFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	out := make(analysis.TokenStream, 0, len(input))
+	for i := 0; i < len(input); i++ {
+		tok := input[i]
+		if tok.Type == analysis.Numeric && i+1 < len(input) {
+			next := input[i+1]
+			num, unit := layoutNumerals[string(tok.Term)], layoutUnits[string(next.Term)]
+			if num != "" && unit != "" && next.Start == tok.End {
+				out = append(out, &analysis.Token{
+					Term:     []byte(num + unit),
+					Start:    tok.Start,
+					End:      next.End,
+					Position: tok.Position,
+					Type:     analysis.Ideographic,
+				})
+				i++
+				continue
+			}
+		}
+		if r := []rune(string(tok.Term)); tok.Type == analysis.Double && len(r) == 2 {
+			if num, unit := layoutNumerals[string(r[0])], layoutUnits[string(r[1])]; num != "" && unit != "" {
+				tok.Term = []byte(num + unit)
+			}
+		}
+		out = append(out, tok)
+	}
+	return out
+}
+
+func init() {
+	if err := registry.RegisterTokenFilter(layoutName, func(map[string]interface{}, *registry.Cache) (analysis.TokenFilter, error) {
+		return layoutFilter{}, nil
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// document is the indexed form of a listing. Text fields are stored for
+// highlighting; the rest are only filtered on.
+type document struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Community   string   `json:"community"`
+	Tags        []string `json:"tags"`
+	Regions     []string `json:"regions"`
+	ListingType float64  `json:"listing_type"`
+	Status      float64  `json:"status"`
+	Rooms       float64  `json:"rooms"`
+	Price       float64  `json:"price"`
+	Updated     float64  `json:"updated"`
+}
+
+func newDocument(d *biz.HouseDocument) *document {
+	doc := &document{
+		Title:       d.Title,
+		Description: d.Description,
+		Community:   d.CommunityName,
+		Tags:        d.Tags,
+		ListingType: float64(d.ListingType),
+		Status:      float64(d.Status),
+		Rooms:       float64(d.Rooms),
+		Price:       float64(d.Price),
+		Updated:     float64(d.UpdatedAt.Unix()),
+	}
+	for _, id := range d.RegionIDs {
+		doc.Regions = append(doc.Regions, strconv.FormatUint(uint64(id), 10))
+	}
+	return doc
+}
+
+func newMapping() (mapping.IndexMapping, error) {
+	im := bleve.NewIndexMapping()
+	if err := im.AddCustomAnalyzer(analyzerName, map[string]interface{}{
+		"type":          custom.Name,
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{cjk.WidthName, lowercase.Name, cjk.BigramName, layoutName},
+	}); err != nil {
+		return nil, err
+	}
+	im.DefaultAnalyzer = analyzerName
+
+	text := func(store bool) *mapping.FieldMapping {
+		fm := bleve.NewTextFieldMapping()
+		fm.Analyzer = analyzerName
+		fm.Store = store
+		fm.IncludeTermVectors = store
+		return fm
+	}
+	numeric := bleve.NewNumericFieldMapping()
+	numeric.Store = false
+	keyword := bleve.NewKeywordFieldMapping()
+	keyword.Store = false
+
+	dm := bleve.NewDocumentStaticMapping()
+	dm.AddFieldMappingsAt("title", text(true))
+	dm.AddFieldMappingsAt("description", text(true))
+	dm.AddFieldMappingsAt("community", text(true))
+	dm.AddFieldMappingsAt("tags", text(false))
+	dm.AddFieldMappingsAt("regions", keyword)
+	for _, f := range []string{"listing_type", "status", "rooms", "price", "updated"} {
+		dm.AddFieldMappingsAt(f, numeric)
+	}
+	im.DefaultMapping = dm
+	return im, nil
+}
+
+// HouseIndex is a Bleve listing index.
+type HouseIndex struct {
+	index    bleve.Index
+	consumer string
+}
+
+// Open opens the index at path, creating it when missing; an empty path
+// gives an index in memory. consumer is returned by Consumer.
+func Open(path, consumer string) (*HouseIndex, func(), error) {
+	im, err := newMapping()
+	if err != nil {
+		return nil, nil, err
+	}
+	var index bleve.Index
+	switch _, statErr := os.Stat(path); {
+	case path == "":
+		index, err = bleve.NewMemOnly(im)
+	case statErr == nil:
+		index, err = bleve.Open(path)
+	default:
+		index, err = bleve.New(path, im)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return &HouseIndex{index: index, consumer: consumer}, func() { index.Close() }, nil
+}
+
+func (x *HouseIndex) Consumer() string {
+	return x.consumer
+}
+
+func (x *HouseIndex) Index(_ context.Context, docs ...*biz.HouseDocument) error {
+	b := x.index.NewBatch()
+	for _, d := range docs {
+		if err := b.Index(docID(d.ID), newDocument(d)); err != nil {
+			return err
+		}
+	}
+	return x.index.Batch(b)
+}
+
+func (x *HouseIndex) Delete(_ context.Context, ids ...uint) error {
+	b := x.index.NewBatch()
+	for _, id := range ids {
+		b.Delete(docID(id))
+	}
+	return x.index.Batch(b)
+}
+
+// Search matches every whitespace-separated word of the keyword against the
+// title, community, tags and description, in that order of weight. Without
+// a keyword the newest listings come first.
+func (x *HouseIndex) Search(ctx context.Context, q *biz.HouseQuery) (*biz.HouseSearchResult, error) {
+	var must []query.Query
+	for _, word := range strings.Fields(q.Keyword) {
+		var fields []query.Query
+		for _, f := range []struct {
+			name  string
+			boost float64
+		}{{"title", 3}, {"community", 3}, {"tags", 2}, {"description", 1}} {
+			mq := bleve.NewMatchQuery(word)
+			mq.SetField(f.name)
+			mq.SetBoost(f.boost)
+			fields = append(fields, mq)
+		}
+		must = append(must, bleve.NewDisjunctionQuery(fields...))
+	}
+	if q.RegionID != 0 {
+		tq := bleve.NewTermQuery(strconv.FormatUint(uint64(q.RegionID), 10))
+		tq.SetField("regions")
+		must = append(must, tq)
+	}
+	if q.ListingType != nil {
+		must = append(must, numericRange("listing_type", float64(*q.ListingType), float64(*q.ListingType)))
+	}
+	if q.Rooms > 0 {
+		must = append(must, numericRange("rooms", float64(q.Rooms), float64(q.Rooms)))
+	}
+	if q.MinPrice > 0 || q.MaxPrice > 0 {
+		must = append(must, numericRange("price", float64(q.MinPrice), float64(q.MaxPrice)))
+	}
+
+	var qq query.Query = bleve.NewMatchAllQuery()
+	if len(must) > 0 {
+		qq = bleve.NewConjunctionQuery(must...)
+	}
+	// 只返回在售房源。用排除而不是等于：升级前建的索引没有 status 字段，那些文档入索引时都是在售
+	bq := bleve.NewBooleanQuery()
+	bq.AddMust(qq)
+	bq.AddMustNot(numericBelow("status", float64(biz.HouseOnSale)), numericAbove("status", float64(biz.HouseOnSale)))
+	qq = bq
+	req := bleve.NewSearchRequestOptions(qq, q.PageSize, (q.Page-1)*q.PageSize, false)
+	if q.Keyword != "" {
+		req.SortBy([]string{"-_score", "-updated"})
+		req.Highlight = bleve.NewHighlightWithStyle(html.Name)
+		req.Highlight.Fields = []string{"title", "description", "community"}
+	} else {
+		req.SortBy([]string{"-updated", "-_id"})
+	}
+	found, err := x.index.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &biz.HouseSearchResult{Total: found.Total}
+	for _, h := range found.Hits {
+		id, err := strconv.ParseUint(h.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		hit := &biz.HouseHit{ID: uint(id), Score: h.Score}
+		for field, fragments := range h.Fragments {
+			// 未命中的字段也会返回开头的片段
+			if len(fragments) == 0 || !strings.Contains(fragments[0], "<mark>") {
+				continue
+			}
+			if hit.Highlights == nil {
+				hit.Highlights = map[string]string{}
+			}
+			hit.Highlights[field] = fragments[0]
+		}
+		res.Hits = append(res.Hits, hit)
+	}
+	return res, nil
+}
+
+func docID(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// numericBelow matches field < v.
+func numericBelow(field string, v float64) query.Query {
+	exclusive := false
+	q := bleve.NewNumericRangeInclusiveQuery(nil, &v, nil, &exclusive)
+	q.SetField(field)
+	return q
+}
+
+// numericAbove matches field > v.
+func numericAbove(field string, v float64) query.Query {
+	exclusive := false
+	q := bleve.NewNumericRangeInclusiveQuery(&v, nil, &exclusive, nil)
+	q.SetField(field)
+	return q
+}
+
+// numericRange matches min <= field <= max; a max of 0 leaves it open.
+func numericRange(field string, min, max float64) query.Query {
+	inclusive := true
+	upper := &max
+	if max <= 0 {
+		upper = nil
+	}
+	q := bleve.NewNumericRangeInclusiveQuery(&min, upper, &inclusive, &inclusive)
+	q.SetField(field)
+	return q
+}
@@ -0,0 +1,53 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type StatsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewStatsRepo(data *Data, logger log.Logger) biz.StatsRepo {
+	return &StatsRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ReplaceMonth hard-deletes the month before inserting, so the unique key
+// never meets a soft-deleted row.
+func (r *StatsRepo) ReplaceMonth(ctx context.Context, month string, rows []*biz.MarketStats) error {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Unscoped().Where("month = ?", month).Delete(&biz.MarketStats{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return r.data.DB(ctx).CreateInBatches(rows, 500).Error
+	})
+	if err != nil {
+		return fmt.Errorf("写入行情统计失败: %v", err)
+	}
+	return nil
+}
+
+func (r *StatsRepo) ListStats(ctx context.Context, communityID uint, city, district, from, to string) ([]*biz.MarketStats, error) {
+	db := r.data.DB(ctx).Where("month BETWEEN ? AND ?", from, to)
+	if communityID != 0 {
+		db = db.Where("scope = ? AND community_id = ?", biz.ScopeCommunity, communityID)
+	} else {
+		db = db.Where("scope = ? AND city = ? AND district = ?", biz.ScopeDistrict, city, district)
+	}
+	var list []*biz.MarketStats
+	if err := db.Order("month").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询行情统计失败: %v", err)
+	}
+	return list, nil
+}
@@ -2,7 +2,12 @@ package data
 
 import (
 	"anjuke/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 type TransactionRepo struct {
@@ -16,3 +21,35 @@ func NewTransactionRepo(data *Data, logger log.Logger) biz.TransactionRepo {
 		log:  log.NewHelper(logger),
 	}
 }
+
+func (r *TransactionRepo) CreateDeal(ctx context.Context, d *biz.Deal) (*biz.Deal, error) {
+	if err := r.data.DB(ctx).Create(d).Error; err != nil {
+		return nil, fmt.Errorf("创建交易失败: %v", err)
+	}
+	return d, nil
+}
+
+func (r *TransactionRepo) GetDeal(ctx context.Context, id uint) (*biz.Deal, error) {
+	var d biz.Deal
+	err := r.data.DB(ctx).Take(&d, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询交易失败: %v", err)
+	}
+	return &d, nil
+}
+
+func (r *TransactionRepo) UpdateDeal(ctx context.Context, d *biz.Deal, events ...*biz.Event) (*biz.Deal, error) {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Save(d).Error; err != nil {
+			return err
+		}
+		return writeOutbox(r.data.DB(ctx), events)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("更新交易失败: %v", err)
+	}
+	return d, nil
+}
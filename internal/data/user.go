@@ -2,46 +2,75 @@ package data
 
 import (
 	"anjuke/internal/biz"
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 type UserRepo struct {
-	data *Data
-	log  *log.Helper
+	data  *Data
+	cache *Cache[biz.User]
+	log   *log.Helper
 }
 
 // NewGreeterRepo .
 func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
 	return &UserRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:  data,
+		cache: NewCache[biz.User](data.rdb, "user:mobile", data.cache, logger),
+		log:   log.NewHelper(logger),
 	}
 }
 
 // todo:用户添加
-//func (u UserRepo) CreateUser(ctx context.Context, user *biz.User) (*biz.User, error) {
-//	//TODO implement me
-//	if user.Mobile == "" {
-//		return nil, fmt.Errorf("手机号不能为空")
-//	}
-//
-//	err := u.data.db.Debug().WithContext(ctx).Create(user).Error
-//	if err != nil {
-//		return nil, fmt.Errorf("创建用户失败: %v", err)
-//	}
-//	return user, nil
-//}
+func (u UserRepo) CreateUser(ctx context.Context, user *biz.User) (*biz.User, error) {
+	if user.Mobile == "" {
+		return nil, fmt.Errorf("手机号不能为空")
+	}
+
+	err := u.data.DB(ctx).Create(user).Error
+	if err != nil {
+		return nil, fmt.Errorf("创建用户失败: %v", err)
+	}
+	// 注册前登录会缓存“不存在”
+	u.cache.Delete(ctx, user.Mobile)
+	return user, nil
+}
 
 // todo：根据phone查询用户
-//func (u UserRepo) GetUser(ctx context.Context, phone string) (*biz.User, error) {
-//	var user biz.User
-//	err := u.data.db.Debug().WithContext(ctx).Where("mobile = ?", phone).Limit(1).Find(&user).Error
-//
-//	if errors.Is(err, gorm.ErrRecordNotFound) {
-//		return nil, nil // 明确返回nil表示用户不存在
-//	}
-//	if err != nil {
-//		return nil, fmt.Errorf("查询用户失败: %v", err)
-//	}
-//	return &user, nil
-//}
+// 密码不进缓存，校验密码用 GetPassword
+func (u UserRepo) GetUser(ctx context.Context, phone string) (*biz.User, error) {
+	return u.cache.Get(ctx, phone, func(ctx context.Context) (*biz.User, error) {
+		user, err := u.getUser(ForcePrimary(ctx), phone)
+		if user != nil {
+			user.Password = ""
+		}
+		return user, err
+	})
+}
+
+// GetPassword reads the password from the primary, empty when the user
+// does not exist.
+func (u UserRepo) GetPassword(ctx context.Context, phone string) (string, error) {
+	user, err := u.getUser(ForcePrimary(ctx), phone)
+	if err != nil || user == nil {
+		return "", err
+	}
+	return user.Password, nil
+}
+
+func (u UserRepo) getUser(ctx context.Context, phone string) (*biz.User, error) {
+	var user biz.User
+	err := u.data.DB(ctx).Where("mobile = ?", phone).Take(&user).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil // 明确返回nil表示用户不存在
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %v", err)
+	}
+	return &user, nil
+}
@@ -0,0 +1,41 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestUserRepo_CacheWithoutPassword(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&biz.User{}); err != nil {
+		t.Fatal(err)
+	}
+	mr, rdb := newTestRedis(t)
+	repo := NewUserRepo(&Data{db: db, rdb: rdb}, log.DefaultLogger)
+	ctx := context.Background()
+	if _, err := repo.CreateUser(ctx, &biz.User{Mobile: "13800000000", NickName: "张三", Password: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		u, err := repo.GetUser(ctx, "13800000000")
+		if err != nil || u == nil || u.NickName != "张三" || u.Password != "" {
+			t.Errorf("GetUser() = %+v, %v, want the user without the password", u, err)
+		}
+	}
+	cached, err := mr.Get("cache:user:mobile:13800000000")
+	if err != nil || strings.Contains(cached, "secret") {
+		t.Errorf("cached user = %s, %v, want it without the password", cached, err)
+	}
+	if p, err := repo.GetPassword(ctx, "13800000000"); err != nil || p != "secret" {
+		t.Errorf("GetPassword() = %q, %v", p, err)
+	}
+	if p, err := repo.GetPassword(ctx, "13900000000"); err != nil || p != "" {
+		t.Errorf("GetPassword() of a missing user = %q, %v", p, err)
+	}
+}
@@ -0,0 +1,94 @@
+package data
+
+import (
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type ValuationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewValuationRepo(data *Data, logger log.Logger) biz.ValuationRepo {
+	return &ValuationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SaveSale upserts on the unique deal_id key, so a redelivered deal event
+// records the sale once.
+func (r *ValuationRepo) SaveSale(ctx context.Context, s *biz.SaleRecord) error {
+	err := r.data.DB(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"price", "unit_price", "sold_at", "updated_at"}),
+	}).Create(s).Error
+	if err != nil {
+		return fmt.Errorf("记录成交失败: %v", err)
+	}
+	return nil
+}
+
+func (r *ValuationRepo) ListSales(ctx context.Context, communityID uint, city, district string, since time.Time, limit int) ([]*biz.SaleRecord, error) {
+	db := r.data.DB(ctx).Where("sold_at >= ?", since)
+	if communityID != 0 {
+		db = db.Where("community_id = ?", communityID)
+	} else {
+		db = db.Where("city = ? AND district = ?", city, district)
+	}
+	var list []*biz.SaleRecord
+	if err := db.Order("sold_at DESC").Limit(limit).Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询成交记录失败: %v", err)
+	}
+	return list, nil
+}
+
+func (r *ValuationRepo) ListSalesBetween(ctx context.Context, from, to time.Time) ([]*biz.SaleRecord, error) {
+	var list []*biz.SaleRecord
+	if err := r.data.DB(ctx).Where("sold_at >= ? AND sold_at < ?", from, to).Order("sold_at").Find(&list).Error; err != nil {
+		return nil, fmt.Errorf("查询成交记录失败: %v", err)
+	}
+	return list, nil
+}
+
+// NewValuationRules returns the valuation coefficients: the built-in
+// defaults overridden by every item set in data.valuation.
+func NewValuationRules(c *conf.Data) *biz.ValuationRules {
+	r := biz.DefaultValuationRules()
+	v := c.GetValuation()
+	if v.GetSaleMonths() != 0 {
+		r.SaleMonths = v.GetSaleMonths()
+	}
+	if v.GetMinComparables() != 0 {
+		r.MinComparables = int(v.GetMinComparables())
+	}
+	if v.GetMaxComparables() != 0 {
+		r.MaxComparables = int(v.GetMaxComparables())
+	}
+	if v.GetListingWeight() != 0 {
+		r.ListingWeight = v.GetListingWeight()
+	}
+	// 以下系数可以配置为0，以是否出现判断
+	if v.ListingDiscount != nil {
+		r.ListingDiscount = v.GetListingDiscount()
+	}
+	if v.AreaStep != nil {
+		r.AreaStep = v.GetAreaStep()
+	}
+	if v.AgeStep != nil {
+		r.AgeStep = v.GetAgeStep()
+	}
+	for k, adj := range v.GetFloorAdjust() {
+		r.FloorAdjust[k] = adj
+	}
+	for k, adj := range v.GetOrientationAdjust() {
+		r.OrientationAdjust[k] = adj
+	}
+	return r
+}
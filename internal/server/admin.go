@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// AdminTokenHeader carries the operator token; gRPC clients send it as the
+// x-admin-token metadata.
+const AdminTokenHeader = "X-Admin-Token"
+
+// ErrAdminUnauthorized is returned for an operator-only operation called
+// without the right token.
+var ErrAdminUnauthorized = errors.Unauthorized("ADMIN_UNAUTHORIZED", "该接口仅限运营人员调用")
+
+// adminPathPrefix marks the HTTP routes of operator-only operations.
+const adminPathPrefix = "/admin/"
+
+// Admin restricts to callers holding the operator token every operation
+// routed under /admin/, over both transports, and those listed in
+// conf.Server.Admin. Without a token they are closed to everyone.
+type Admin struct {
+	operations map[string]bool
+	token      []byte
+	log        *log.Helper
+}
+
+// NewAdmin new an Admin. Validate guarantees a token when operations are
+// listed.
+func NewAdmin(c *conf.Server, logger log.Logger) *Admin {
+	ops := adminOperations()
+	for _, op := range c.GetAdmin().GetOperations() {
+		ops[op] = true
+	}
+	return &Admin{operations: ops, token: []byte(c.GetAdmin().GetToken()), log: log.NewHelper(logger)}
+}
+
+// Middleware rejects calls to the listed operations whose token is missing
+// or wrong.
+func (a *Admin) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || !a.operations[tr.Operation()] {
+				return handler(ctx, req)
+			}
+			token := tr.RequestHeader().Get(AdminTokenHeader)
+			if len(a.token) == 0 || subtle.ConstantTimeCompare([]byte(token), a.token) != 1 {
+				a.log.WithContext(ctx).Warnf("admin: rejected %s", tr.Operation())
+				return nil, ErrAdminUnauthorized
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// adminOperations returns the operations of the registered services with an
+// HTTP binding under /admin/, so a new admin RPC is guarded without being
+// listed in the config.
+func adminOperations() map[string]bool {
+	ops := map[string]bool{}
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			sd := fd.Services().Get(i)
+			for j := 0; j < sd.Methods().Len(); j++ {
+				md := sd.Methods().Get(j)
+				rule, _ := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if adminRule(rule) {
+					ops["/"+string(sd.FullName())+"/"+string(md.Name())] = true
+				}
+			}
+		}
+		return true
+	})
+	return ops
+}
+
+// adminRule reports whether rule or one of its additional bindings routes
+// under /admin/.
+func adminRule(rule *annotations.HttpRule) bool {
+	if rule == nil {
+		return false
+	}
+	for _, path := range []string{rule.GetGet(), rule.GetPost(), rule.GetPut(), rule.GetDelete(), rule.GetPatch(), rule.GetCustom().GetPath()} {
+		if strings.HasPrefix(path, adminPathPrefix) {
+			return true
+		}
+	}
+	for _, b := range rule.GetAdditionalBindings() {
+		if adminRule(b) {
+			return true
+		}
+	}
+	return false
+}
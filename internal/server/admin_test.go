@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+func TestAdmin(t *testing.T) {
+	const createCommunity = "/api.community.v7.Community/CreateCommunity"
+	a := NewAdmin(&conf.Server{Admin: &conf.Server_Admin{
+		Operations: []string{createCommunity},
+		Token:      "0123456789abcdef",
+	}}, log.DefaultLogger)
+	h := a.Middleware()(func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+
+	tests := []struct {
+		name      string
+		operation string
+		token     string
+		wantErr   bool
+	}{
+		{"operator", createCommunity, "0123456789abcdef", false},
+		{"no token", createCommunity, "", true},
+		{"wrong token", createCommunity, "0123456789abcdeX", true},
+		{"token prefix", createCommunity, "0123456789", true},
+		{"public operation", "/api.community.v7.Community/GetCommunity", "", false},
+		// 路由在 /admin/ 下的接口没有列出也要令牌
+		{"admin route", "/api.transaction.v4.Transaction/RunBilling", "", true},
+		{"admin route operator", "/api.transaction.v4.Transaction/RunBilling", "0123456789abcdef", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestTransport(tt.operation)
+			if tt.token != "" {
+				tr.header.Set(AdminTokenHeader, tt.token)
+			}
+			_, err := h(transport.NewServerContext(context.Background(), tr), nil)
+			if tt.wantErr && errors.Reason(err) != "ADMIN_UNAUTHORIZED" || !tt.wantErr && err != nil {
+				t.Errorf("error = %v, want rejected %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdmin_NoToken(t *testing.T) {
+	a := NewAdmin(&conf.Server{}, log.DefaultLogger)
+	h := a.Middleware()(func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+	tr := newTestTransport("/api.house.v3.House/ReviewListing")
+	if _, err := h(transport.NewServerContext(context.Background(), tr), nil); errors.Reason(err) != "ADMIN_UNAUTHORIZED" {
+		t.Errorf("error = %v, want admin routes closed without a token", err)
+	}
+}
+
+func TestAdminOperations(t *testing.T) {
+	ops := adminOperations()
+	for _, op := range []string{
+		"/api.community.v7.Community/CreateCommunity",
+		"/api.house.v3.House/ListModerationQueue",
+		"/api.house.v3.House/ReviewListing",
+		"/api.house.v3.House/CheckListing",
+		"/api.transaction.v4.Transaction/RunBilling",
+		"/api.region.v8.Region/ReloadRegions",
+		"/api.stats.v10.Stats/RefreshMarketStats",
+		"/api.content.v11.Content/DeleteWord",
+	} {
+		if !ops[op] {
+			t.Errorf("adminOperations() is missing %s", op)
+		}
+	}
+	for _, op := range []string{"/api.house.v3.House/GetHouse", "/api.content.v11.Content/CheckContent"} {
+		if ops[op] {
+			t.Errorf("adminOperations() has the public %s", op)
+		}
+	}
+}
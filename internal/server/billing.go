@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// billingHour is the local hour of the daily billing run, early enough for
+// the bills due today to be issued before tenants wake up.
+const billingHour = 1
+
+// BillingScheduler runs the rent billing every day at billingHour. Like
+// StatsScheduler it runs on every instance and the usecase's lock lets one
+// of them do the work.
+type BillingScheduler struct {
+	uc       *biz.BillingUsecase
+	log      *log.Helper
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewBillingScheduler new a BillingScheduler.
+func NewBillingScheduler(uc *biz.BillingUsecase, logger log.Logger) *BillingScheduler {
+	return &BillingScheduler{uc: uc, log: log.NewHelper(logger), stop: make(chan struct{})}
+}
+
+// Start runs the billing daily until Stop is called or ctx is done.
+func (s *BillingScheduler) Start(ctx context.Context) error {
+	for {
+		timer := time.NewTimer(time.Until(nextDailyRun(time.Now(), billingHour)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-s.stop:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+			s.run(ctx)
+		}
+	}
+}
+
+// Stop stops the loop.
+func (s *BillingScheduler) Stop(context.Context) error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return nil
+}
+
+func (s *BillingScheduler) run(ctx context.Context) {
+	_, err := s.uc.Run(ctx, time.Now())
+	switch {
+	case errors.Is(err, biz.ErrBillingRunning):
+		s.log.Info("billing: run by another instance")
+	case err != nil:
+		s.log.Errorf("billing: %v", err)
+	}
+}
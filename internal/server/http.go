@@ -1,10 +1,15 @@
 package server
 
 import (
+	v7 "anjuke/api/community/v7"
+	v11 "anjuke/api/content/v11"
 	v6 "anjuke/api/customer/v6"
+	v9 "anjuke/api/favorite/v9"
 	v1 "anjuke/api/helloworld/v1"
 	v3 "anjuke/api/house/v3"
 	v5 "anjuke/api/points/v5"
+	v8 "anjuke/api/region/v8"
+	v10 "anjuke/api/stats/v10"
 	v4 "anjuke/api/transaction/v4"
 	v2 "anjuke/api/user/v2"
 	"anjuke/internal/conf"
@@ -16,10 +21,13 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, user *service.UserService, house *service.HouseService, transaction *service.TransactionService, points *service.PointsService, customer *service.CustomerService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, user *service.UserService, house *service.HouseService, transaction *service.TransactionService, points *service.PointsService, customer *service.CustomerService, community *service.CommunityService, region *service.RegionService, favorite *service.FavoriteService, stats *service.StatsService, content *service.ContentService, limiter *RateLimiter, admin *Admin, idempotency *Idempotency, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			limiter.Middleware(),
+			admin.Middleware(),
+			idempotency.Middleware(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -38,5 +46,10 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, user *servic
 	v4.RegisterTransactionHTTPServer(srv, transaction)
 	v5.RegisterPointsHTTPServer(srv, points)
 	v6.RegisterCustomerHTTPServer(srv, customer)
+	v7.RegisterCommunityHTTPServer(srv, community)
+	v8.RegisterRegionHTTPServer(srv, region)
+	v9.RegisterFavoriteHTTPServer(srv, favorite)
+	v10.RegisterStatsHTTPServer(srv, stats)
+	v11.RegisterContentHTTPServer(srv, content)
 	return srv
 }
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// IdempotencyHeader carries the client-chosen key; gRPC clients send it as
+// the idempotency-key metadata.
+const IdempotencyHeader = "Idempotency-Key"
+
+const (
+	// defaultIdempotencyTTL applies when conf.Server.Idempotency.Ttl is unset.
+	defaultIdempotencyTTL = 24 * time.Hour
+	// defaultProcessingTTL applies when conf.Server.Idempotency.ProcessingTtl
+	// is unset. It only has to outlive the handler: if the process dies
+	// mid-request the key frees itself after this long.
+	defaultProcessingTTL = time.Minute
+)
+
+var (
+	// ErrIdempotencyKeyReused is a key sent again with a different payload.
+	ErrIdempotencyKeyReused = errors.BadRequest("IDEMPOTENCY_KEY_REUSED", "幂等键已用于不同的请求")
+	// ErrIdempotencyInProgress is a retry arriving while the first call runs.
+	ErrIdempotencyInProgress = errors.Conflict("IDEMPOTENCY_IN_PROGRESS", "相同幂等键的请求正在处理中，请稍后重试")
+	// ErrIdempotencyUnavailable is returned while Redis is down; the request
+	// is refused rather than risking a duplicate.
+	ErrIdempotencyUnavailable = errors.ServiceUnavailable("IDEMPOTENCY_UNAVAILABLE", "幂等服务暂不可用，请稍后重试")
+)
+
+// idempotencyRecord is stored under each key: the request fingerprint and,
+// once the first call succeeded, its encoded reply.
+type idempotencyRecord struct {
+	Hash  string `json:"hash"`
+	Done  bool   `json:"done"`
+	Reply []byte `json:"reply,omitempty"`
+}
+
+// Idempotency deduplicates retries of the operations listed in
+// conf.Server.Idempotency. Keys are scoped per operation and client
+// address, resolved through the trusted proxies like the rate limiter
+// does; the request body is never trusted to say who the caller is.
+// Requests without the header pass through unchanged.
+type Idempotency struct {
+	operations map[string]bool
+	ttl        time.Duration
+	processing time.Duration
+	proxies    atomic.Pointer[[]*net.IPNet]
+	rdb        *redis.Client
+	log        *log.Helper
+}
+
+// NewIdempotency new an Idempotency sharing the data layer's Redis client.
+// Trusted proxies follow config reloads.
+func NewIdempotency(c *conf.Server, dc *conf.Dynamic, rdb *redis.Client, logger log.Logger) *Idempotency {
+	ops := map[string]bool{}
+	for _, op := range c.GetIdempotency().GetOperations() {
+		ops[op] = true
+	}
+	ttl := defaultIdempotencyTTL
+	if d := c.GetIdempotency().GetTtl(); d != nil {
+		ttl = d.AsDuration()
+	}
+	processing := defaultProcessingTTL
+	if d := c.GetIdempotency().GetProcessingTtl(); d != nil {
+		processing = d.AsDuration()
+	}
+	i := &Idempotency{
+		operations: ops,
+		ttl:        ttl,
+		processing: processing,
+		rdb:        rdb,
+		log:        log.NewHelper(logger),
+	}
+	configure := func(bc *conf.Bootstrap) {
+		proxies := trustedProxies(bc)
+		i.proxies.Store(&proxies)
+	}
+	configure(dc.Current())
+	dc.Subscribe(configure)
+	return i
+}
+
+// Middleware runs the first request for a key and replays its reply to
+// identical retries, marking them with the Idempotent-Replayed reply header.
+func (i *Idempotency) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || !i.operations[tr.Operation()] {
+				return handler(ctx, req)
+			}
+			key := tr.RequestHeader().Get(IdempotencyHeader)
+			msg, ok := req.(proto.Message)
+			if key == "" || !ok {
+				return handler(ctx, req)
+			}
+			b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+			if err != nil {
+				return nil, err
+			}
+			sum := sha256.Sum256(b)
+			hash := hex.EncodeToString(sum[:])
+			rkey := fmt.Sprintf("idempotency:%s:%s:%s", tr.Operation(), clientIP(ctx, tr, *i.proxies.Load()), key)
+
+			// 处理中只短暂占用键，写回结果时才按 ttl 保存
+			pending, _ := json.Marshal(idempotencyRecord{Hash: hash})
+			first, err := i.rdb.SetNX(ctx, rkey, pending, i.processing).Result()
+			if err != nil {
+				i.log.WithContext(ctx).Errorf("idempotency: redis: %v", err)
+				return nil, ErrIdempotencyUnavailable
+			}
+			if !first {
+				return i.replay(ctx, tr, rkey, hash)
+			}
+
+			// 写回结果不受请求取消影响，否则重试会再执行一次
+			bg := context.WithoutCancel(ctx)
+			defer func() {
+				// panic 交给外层的 recovery 处理，这里只释放键
+				if r := recover(); r != nil {
+					i.release(bg, rkey)
+					panic(r)
+				}
+			}()
+			reply, err := handler(ctx, req)
+			if err != nil {
+				// 失败不占用幂等键，客户端可以用同一个键重试
+				i.release(bg, rkey)
+				return nil, err
+			}
+			if m, ok := reply.(proto.Message); ok {
+				body, err := proto.Marshal(m)
+				if err == nil {
+					rec, _ := json.Marshal(idempotencyRecord{Hash: hash, Done: true, Reply: body})
+					err = i.rdb.Set(bg, rkey, rec, i.ttl).Err()
+				}
+				if err != nil {
+					i.log.WithContext(ctx).Errorf("idempotency: store %s: %v", rkey, err)
+				}
+			}
+			return reply, nil
+		}
+	}
+}
+
+func (i *Idempotency) release(ctx context.Context, rkey string) {
+	if err := i.rdb.Del(ctx, rkey).Err(); err != nil {
+		i.log.WithContext(ctx).Errorf("idempotency: release %s: %v", rkey, err)
+	}
+}
+
+func (i *Idempotency) replay(ctx context.Context, tr transport.Transporter, rkey, hash string) (interface{}, error) {
+	b, err := i.rdb.Get(ctx, rkey).Bytes()
+	if err == redis.Nil {
+		// 首次请求失败后刚释放了键
+		return nil, ErrIdempotencyInProgress
+	}
+	if err != nil {
+		i.log.WithContext(ctx).Errorf("idempotency: redis: %v", err)
+		return nil, ErrIdempotencyUnavailable
+	}
+	var rec idempotencyRecord
+	if err := json.Unmarshal(b, &rec); err != nil {
+		return nil, err
+	}
+	if rec.Hash != hash {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if !rec.Done {
+		return nil, ErrIdempotencyInProgress
+	}
+	reply, err := newReply(tr.Operation())
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(rec.Reply, reply); err != nil {
+		return nil, err
+	}
+	tr.ReplyHeader().Set("Idempotent-Replayed", "true")
+	return reply, nil
+}
+
+// newReply returns an empty reply message for an operation such as
+// /api.points.v5.Points/RedeemPoints, looked up in the proto registry.
+func newReply(operation string) (proto.Message, error) {
+	idx := strings.LastIndex(operation, "/")
+	if idx <= 0 {
+		return nil, fmt.Errorf("idempotency: invalid operation %q", operation)
+	}
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(operation[:idx], "/")))
+	if err != nil {
+		return nil, fmt.Errorf("idempotency: %s: %v", operation, err)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("idempotency: %s is not a service", d.FullName())
+	}
+	md := sd.Methods().ByName(protoreflect.Name(operation[idx+1:]))
+	if md == nil {
+		return nil, fmt.Errorf("idempotency: unknown method %s", operation)
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, fmt.Errorf("idempotency: %s: %v", operation, err)
+	}
+	return mt.New().Interface(), nil
+}
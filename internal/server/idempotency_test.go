@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "anjuke/api/points/v5"
+	"anjuke/internal/conf"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const redeemOperation = "/api.points.v5.Points/RedeemPoints"
+
+func TestIdempotency(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	c := &conf.Server{Idempotency: &conf.Server_Idempotency{
+		Operations:    []string{redeemOperation},
+		Ttl:           durationpb.New(time.Hour),
+		ProcessingTtl: durationpb.New(10 * time.Second),
+	}}
+	i := NewIdempotency(c, conf.NewDynamic(&conf.Bootstrap{Server: c}), rdb, log.DefaultLogger)
+
+	var calls atomic.Int64
+	var block chan struct{}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		n := calls.Add(1)
+		if block != nil {
+			<-block
+		}
+		r := req.(*pb.RedeemPointsRequest)
+		switch r.Reason {
+		case "fail":
+			return nil, errors.BadRequest("POINTS_INSUFFICIENT", "积分不足")
+		case "panic":
+			panic("boom")
+		}
+		return &pb.RedeemPointsReply{Record: &pb.PointsRecord{Id: uint64(n), Amount: -r.Amount}}, nil
+	}
+	h := i.Middleware()(handler)
+	doFrom := func(addr, key string, req *pb.RedeemPointsRequest) (*pb.RedeemPointsReply, *testTransport, error) {
+		tr := newTestTransport(redeemOperation)
+		tr.header.Set(IdempotencyHeader, key)
+		// 伪造的转发头不影响隔离
+		tr.header.Set("X-Forwarded-For", "198.51.100.1")
+		a, _ := net.ResolveTCPAddr("tcp", addr)
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: a})
+		reply, err := h(transport.NewServerContext(ctx, tr), req)
+		if err != nil {
+			return nil, tr, err
+		}
+		return reply.(*pb.RedeemPointsReply), tr, nil
+	}
+	do := func(key string, req *pb.RedeemPointsRequest) (*pb.RedeemPointsReply, *testTransport, error) {
+		return doFrom("203.0.113.7:5000", key, req)
+	}
+	const scope = "idempotency:" + redeemOperation + ":203.0.113.7:"
+
+	t.Run("replay", func(t *testing.T) {
+		req := &pb.RedeemPointsRequest{UserId: 1, Amount: 100}
+		first, _, err := do("k1", req)
+		if err != nil {
+			t.Fatalf("first call error = %v", err)
+		}
+		if ttl := mr.TTL(scope + "k1"); ttl != time.Hour {
+			t.Errorf("stored reply ttl = %v, want the full ttl", ttl)
+		}
+		again, tr, err := do("k1", req)
+		if err != nil {
+			t.Fatalf("retry error = %v", err)
+		}
+		if again.Record.Id != first.Record.Id || tr.reply.Get("Idempotent-Replayed") != "true" {
+			t.Errorf("retry = %v, want the first reply %v replayed", again, first)
+		}
+		if _, _, err := do("k1", &pb.RedeemPointsRequest{UserId: 1, Amount: 200}); errors.Reason(err) != "IDEMPOTENCY_KEY_REUSED" {
+			t.Errorf("other payload error = %v, want IDEMPOTENCY_KEY_REUSED", err)
+		}
+		// 另一个客户端用了相同的键和请求体，也拿不到别人的结果
+		other, tr, err := doFrom("203.0.113.8:5000", "k1", req)
+		if err != nil || other.Record.Id == first.Record.Id || tr.reply.Get("Idempotent-Replayed") != "" {
+			t.Errorf("other caller = %v, %v, want its own call", other, err)
+		}
+	})
+
+	t.Run("in flight", func(t *testing.T) {
+		block = make(chan struct{})
+		defer func() { block = nil }()
+		req := &pb.RedeemPointsRequest{UserId: 1, Amount: 100}
+		done := make(chan error, 1)
+		go func() {
+			_, _, err := do("k2", req)
+			done <- err
+		}()
+		rkey := scope + "k2"
+		for deadline := time.Now().Add(5 * time.Second); !mr.Exists(rkey); time.Sleep(time.Millisecond) {
+			if time.Now().After(deadline) {
+				t.Fatal("first call did not take the key")
+			}
+		}
+		if ttl := mr.TTL(rkey); ttl != 10*time.Second {
+			t.Errorf("pending ttl = %v, want the processing ttl", ttl)
+		}
+		// 处理中的重试不阻塞等待，直接返回 409
+		go func() {
+			_, _, err := do("k2", req)
+			done <- err
+		}()
+		if err := <-done; errors.Reason(err) != "IDEMPOTENCY_IN_PROGRESS" {
+			t.Errorf("concurrent retry error = %v, want IDEMPOTENCY_IN_PROGRESS", err)
+		}
+		close(block)
+		if err := <-done; err != nil {
+			t.Errorf("first call error = %v", err)
+		}
+	})
+
+	t.Run("processing expired", func(t *testing.T) {
+		// 进程在处理中崩溃：占用的键在 processing_ttl 后释放
+		rkey := scope + "k3"
+		pending := `{"hash":"x"}`
+		mr.Set(rkey, pending)
+		mr.SetTTL(rkey, 10*time.Second)
+		if _, _, err := do("k3", &pb.RedeemPointsRequest{UserId: 1, Amount: 100}); errors.Reason(err) != "IDEMPOTENCY_KEY_REUSED" {
+			t.Errorf("retry before expiry error = %v", err)
+		}
+		mr.FastForward(10 * time.Second)
+		if _, _, err := do("k3", &pb.RedeemPointsRequest{UserId: 1, Amount: 100}); err != nil {
+			t.Errorf("retry after the processing ttl error = %v", err)
+		}
+	})
+
+	for _, reason := range []string{"fail", "panic"} {
+		t.Run(reason+" releases", func(t *testing.T) {
+			req := &pb.RedeemPointsRequest{UserId: 1, Amount: 100, Reason: reason}
+			call := func() (err error) {
+				defer func() {
+					if r := recover(); r != nil {
+						err = fmt.Errorf("panic: %v", r)
+					}
+				}()
+				_, _, err = do("k4-"+reason, req)
+				return err
+			}
+			// 失败或 panic 后键被释放，同一个键的重试会再次执行
+			for n := 1; n <= 2; n++ {
+				before := calls.Load()
+				if err := call(); err == nil || errors.Reason(err) == "IDEMPOTENCY_IN_PROGRESS" || calls.Load() != before+1 {
+					t.Errorf("call #%d error = %v, want the handler run", n, err)
+				}
+			}
+		})
+	}
+}
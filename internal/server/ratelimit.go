@@ -0,0 +1,307 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"anjuke/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// Rate limit dimensions accepted in conf.Server.RateLimit.Policy.Key.
+const (
+	LimitByIP     = "ip"
+	LimitByUser   = "user"
+	LimitByMobile = "mobile"
+)
+
+// FeatureRateLimitDryRun is the feature flag that logs requests over a
+// policy instead of rejecting them, to try new limits on live traffic.
+const FeatureRateLimitDryRun = "rate_limit_dry_run"
+
+const (
+	// rateLimitCooldown is how long the limiter stays on the local fallback
+	// after a Redis error before trying Redis again.
+	rateLimitCooldown = 5 * time.Second
+	// localWindowMaxKeys bounds the fallback's memory; expired keys are swept
+	// once the map grows past it.
+	localWindowMaxKeys = 10000
+)
+
+// ErrRateLimited is returned when a policy is exceeded. It maps to HTTP 429
+// and gRPC ResourceExhausted; metadata retry_after holds the wait in seconds.
+var ErrRateLimited = errors.New(http.StatusTooManyRequests, "RATE_LIMITED", "请求过于频繁，请稍后再试")
+
+// rateLimitScript is a sliding-window log: one sorted-set member per accepted
+// request, scored by its time in ms. It returns 0 when the request is allowed,
+// otherwise the ms until the oldest request leaves the window.
+var rateLimitScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], 0, now - window)
+if redis.call('ZCARD', KEYS[1]) < tonumber(ARGV[3]) then
+  redis.call('ZADD', KEYS[1], now, ARGV[4])
+  redis.call('PEXPIRE', KEYS[1], window)
+  return 0
+end
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+return math.max(tonumber(oldest[2]) + window - now, 1)
+`)
+
+// RateLimiter enforces the per-operation policies of conf.Server.RateLimit.
+// Counters live in Redis so all instances share them; while Redis is
+// unreachable each instance falls back to its own in-process window.
+// Policies and trusted proxies follow config reloads.
+type RateLimiter struct {
+	dc       *conf.Dynamic
+	settings atomic.Pointer[rateLimitSettings]
+	rdb      *redis.Client
+	local    *localWindow
+	log      *log.Helper
+
+	// downUntil is the unix ms until which Redis is skipped.
+	downUntil atomic.Int64
+}
+
+// NewRateLimiter new a RateLimiter sharing the data layer's Redis client.
+func NewRateLimiter(dc *conf.Dynamic, rdb *redis.Client, logger log.Logger) *RateLimiter {
+	l := &RateLimiter{
+		dc:    dc,
+		rdb:   rdb,
+		local: &localWindow{hits: map[string]*localHits{}},
+		log:   log.NewHelper(logger),
+	}
+	l.configure(dc.Current())
+	dc.Subscribe(l.configure)
+	return l
+}
+
+// rateLimitSettings is conf.Server.RateLimit prepared for lookups.
+type rateLimitSettings struct {
+	policies map[string][]*conf.Server_RateLimit_Policy
+	proxies  []*net.IPNet
+}
+
+// configure indexes the policies of bc by operation and parses its trusted
+// proxies.
+func (l *RateLimiter) configure(bc *conf.Bootstrap) {
+	c := bc.GetServer().GetRateLimit()
+	s := &rateLimitSettings{policies: map[string][]*conf.Server_RateLimit_Policy{}}
+	for _, p := range c.GetPolicies() {
+		s.policies[p.Operation] = append(s.policies[p.Operation], p)
+	}
+	s.proxies = trustedProxies(bc)
+	l.settings.Store(s)
+}
+
+// trustedProxies parses conf.Server.RateLimit.TrustedProxies; Validate has
+// already rejected malformed ones.
+func trustedProxies(bc *conf.Bootstrap) []*net.IPNet {
+	var res []*net.IPNet
+	for _, cidr := range bc.GetServer().GetRateLimit().GetTrustedProxies() {
+		if _, n, err := net.ParseCIDR(cidr); err == nil {
+			res = append(res, n)
+		}
+	}
+	return res
+}
+
+// Middleware rejects requests over any policy of their operation and sets
+// the Retry-After reply header.
+func (l *RateLimiter) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			s := l.settings.Load()
+			for _, p := range s.policies[tr.Operation()] {
+				id := limitID(ctx, tr, p.Key, req, s.proxies)
+				if id == "" {
+					continue
+				}
+				key := fmt.Sprintf("ratelimit:%s:%s:%s", p.Operation, p.Key, id)
+				if wait := l.allow(ctx, key, p, time.Now().UnixMilli()); wait > 0 {
+					if l.dc.Enabled(FeatureRateLimitDryRun) {
+						l.log.WithContext(ctx).Infof("rate limit: dry run: %s over %d/%s", key, p.Limit, p.GetWindow().AsDuration())
+						continue
+					}
+					secs := strconv.FormatInt(int64((wait+time.Second-1)/time.Second), 10)
+					tr.ReplyHeader().Set("Retry-After", secs)
+					return nil, ErrRateLimited.WithMetadata(map[string]string{"retry_after": secs})
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// allow records a hit for key at now (unix ms) and returns how long to wait
+// when the policy is exceeded, zero otherwise.
+func (l *RateLimiter) allow(ctx context.Context, key string, p *conf.Server_RateLimit_Policy, now int64) time.Duration {
+	window := p.GetWindow().AsDuration().Milliseconds()
+	if now >= l.downUntil.Load() {
+		member := strconv.FormatInt(now, 10) + "-" + strconv.FormatInt(rand.Int63(), 36)
+		wait, err := rateLimitScript.Run(ctx, l.rdb, []string{key}, now, window, p.Limit, member).Int64()
+		if err == nil {
+			if l.downUntil.Swap(0) != 0 {
+				l.log.WithContext(ctx).Info("rate limit: redis recovered")
+			}
+			return time.Duration(wait) * time.Millisecond
+		}
+		if l.downUntil.Swap(now+rateLimitCooldown.Milliseconds()) == 0 {
+			l.log.WithContext(ctx).Warnf("rate limit: redis unavailable, using local limiter: %v", err)
+		}
+	}
+	return time.Duration(l.local.allow(key, int(p.Limit), window, now)) * time.Millisecond
+}
+
+// limitID extracts the value a policy is keyed by, empty when the request
+// does not carry it.
+func limitID(ctx context.Context, tr transport.Transporter, by string, req interface{}, proxies []*net.IPNet) string {
+	switch by {
+	case LimitByIP:
+		return clientIP(ctx, tr, proxies)
+	case LimitByUser:
+		return messageField(req, "user_id")
+	case LimitByMobile:
+		return messageField(req, "mobile")
+	}
+	return ""
+}
+
+// clientIP returns the peer address of the request. Only when the peer is
+// one of the trusted proxies are the forwarding headers believed: the client
+// is the right-most X-Forwarded-For entry that is not a trusted proxy
+// itself, since every hop appends the address it saw and anything further
+// left may be forged.
+func clientIP(ctx context.Context, tr transport.Transporter, proxies []*net.IPNet) string {
+	var addr string
+	if ht, ok := tr.(*khttp.Transport); ok {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	if !trusted(addr, proxies) {
+		return addr
+	}
+	if xff := tr.RequestHeader().Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			if hop := strings.TrimSpace(hops[i]); net.ParseIP(hop) != nil && !trusted(hop, proxies) {
+				return hop
+			}
+		}
+	}
+	if ip := strings.TrimSpace(tr.RequestHeader().Get("X-Real-IP")); net.ParseIP(ip) != nil {
+		return ip
+	}
+	return addr
+}
+
+func trusted(addr string, proxies []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range proxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// messageField returns the named scalar field of a proto request as a
+// string. Names match ignoring case and underscores, since older protos use
+// Mobile while newer ones use user_id.
+func messageField(req interface{}, name string) string {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	r := m.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if normalizeField(string(fd.Name())) != normalizeField(name) {
+			continue
+		}
+		if fd.IsList() || fd.IsMap() || fd.Message() != nil || !r.Has(fd) {
+			return ""
+		}
+		return fmt.Sprint(r.Get(fd).Interface())
+	}
+	return ""
+}
+
+func normalizeField(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// localWindow is the in-process fallback with the same sliding-window
+// semantics as rateLimitScript.
+type localWindow struct {
+	mu   sync.Mutex
+	hits map[string]*localHits
+}
+
+type localHits struct {
+	window int64
+	times  []int64
+}
+
+func (w *localWindow) allow(key string, limit int, window, now int64) int64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	h, ok := w.hits[key]
+	if !ok {
+		if len(w.hits) >= localWindowMaxKeys {
+			w.sweep(now)
+		}
+		h = &localHits{window: window}
+		w.hits[key] = h
+	}
+	i := 0
+	for i < len(h.times) && h.times[i] <= now-window {
+		i++
+	}
+	h.times = h.times[i:]
+	if len(h.times) < limit {
+		h.times = append(h.times, now)
+		return 0
+	}
+	if wait := h.times[0] + window - now; wait > 0 {
+		return wait
+	}
+	return 1
+}
+
+// sweep drops keys whose every hit has left the window.
+func (w *localWindow) sweep(now int64) {
+	for k, h := range w.hits {
+		if len(h.times) == 0 || h.times[len(h.times)-1] <= now-h.window {
+			delete(w.hits, k)
+		}
+	}
+}
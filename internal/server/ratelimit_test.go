@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"anjuke/internal/conf"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func testLimiter(t *testing.T, rc *conf.Server_RateLimit) (*RateLimiter, *miniredis.Miniredis, *conf.Dynamic) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { rdb.Close() })
+	dc := conf.NewDynamic(&conf.Bootstrap{Server: &conf.Server{RateLimit: rc}})
+	return NewRateLimiter(dc, rdb, log.DefaultLogger), mr, dc
+}
+
+func TestRateLimiter_Allow(t *testing.T) {
+	p := &conf.Server_RateLimit_Policy{Operation: "/op", Key: LimitByIP, Limit: 2, Window: durationpb.New(time.Minute)}
+	l, mr, _ := testLimiter(t, &conf.Server_RateLimit{Policies: []*conf.Server_RateLimit_Policy{p}})
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+
+	steps := []struct {
+		name string
+		at   int64
+		want time.Duration
+	}{
+		{"first", now, 0},
+		{"second", now + 1000, 0},
+		{"over", now + 2000, 58 * time.Second},
+		{"first left the window", now + 60000, 0},
+		{"over again", now + 60500, 500 * time.Millisecond},
+	}
+	for _, s := range steps {
+		if got := l.allow(ctx, "k", p, s.at); got != s.want {
+			t.Errorf("%s: allow() = %v, want %v", s.name, got, s.want)
+		}
+	}
+	if n, _ := mr.ZMembers("k"); len(n) != 2 {
+		t.Errorf("window = %v, want the 2 accepted requests", n)
+	}
+	if l.allow(ctx, "other", p, now+60500) != 0 {
+		t.Error("allow() of another key limited")
+	}
+
+	t.Run("fallback", func(t *testing.T) {
+		mr.Close()
+		at := now + 120000
+		for i, want := range []bool{true, true, false} {
+			if got := l.allow(ctx, "k", p, at+int64(i)) == 0; got != want {
+				t.Errorf("allow() #%d without redis = %v, want %v", i+1, got, want)
+			}
+		}
+		if got := l.downUntil.Load(); got != at+rateLimitCooldown.Milliseconds() {
+			t.Errorf("downUntil = %d, want cooldown from the first failure", got)
+		}
+		// 冷却期内不再访问 Redis，过后重新尝试
+		if err := mr.Restart(); err != nil {
+			t.Fatal(err)
+		}
+		if l.allow(ctx, "k", p, at+10) == 0 {
+			t.Error("allow() during cooldown used redis")
+		}
+		if l.allow(ctx, "k", p, at+rateLimitCooldown.Milliseconds()) != 0 || l.downUntil.Load() != 0 {
+			t.Error("allow() after cooldown did not go back to redis")
+		}
+	})
+}
+
+func TestLocalWindow(t *testing.T) {
+	w := &localWindow{hits: map[string]*localHits{}}
+	if w.allow("k", 1, 1000, 0) != 0 {
+		t.Error("allow() first = limited")
+	}
+	if got := w.allow("k", 1, 1000, 400); got != 600 {
+		t.Errorf("allow() over = %d, want 600", got)
+	}
+	if w.allow("k", 1, 1000, 1000) != 0 {
+		t.Error("allow() after the window = limited")
+	}
+	for i := 0; i < localWindowMaxKeys; i++ {
+		w.allow(string(rune(i)), 1, 1000, 0)
+	}
+	w.allow("new", 1, 1000, 5000)
+	if len(w.hits) != 1 {
+		t.Errorf("keys after sweep = %d, want only the new one", len(w.hits))
+	}
+}
+
+type testTransport struct {
+	operation string
+	header    http.Header
+	reply     http.Header
+}
+
+func newTestTransport(operation string) *testTransport {
+	return &testTransport{operation: operation, header: http.Header{}, reply: http.Header{}}
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return tr.operation }
+func (tr *testTransport) RequestHeader() transport.Header { return headerCarrier(tr.header) }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier(tr.reply) }
+
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+
+func TestClientIP(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	tests := []struct {
+		name   string
+		peer   string
+		header map[string]string
+		want   string
+	}{
+		{"peer", "203.0.113.7:5000", nil, "203.0.113.7"},
+		{"spoofed forwarded for", "203.0.113.7:5000", map[string]string{"X-Forwarded-For": "1.2.3.4"}, "203.0.113.7"},
+		{"spoofed real ip", "203.0.113.7:5000", map[string]string{"X-Real-IP": "1.2.3.4"}, "203.0.113.7"},
+		{"trusted proxy", "10.0.0.2:5000", map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.9, 10.0.0.3"}, "198.51.100.9"},
+		{"trusted real ip", "10.0.0.2:5000", map[string]string{"X-Real-IP": "198.51.100.9"}, "198.51.100.9"},
+		{"trusted without header", "10.0.0.2:5000", nil, "10.0.0.2"},
+		{"garbage forwarded for", "10.0.0.2:5000", map[string]string{"X-Forwarded-For": "unknown"}, "10.0.0.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+			tr := newTestTransport("/op")
+			for k, v := range tt.header {
+				tr.header.Set(k, v)
+			}
+			if got := clientIP(ctx, tr, []*net.IPNet{proxies}); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_Middleware(t *testing.T) {
+	p := &conf.Server_RateLimit_Policy{Operation: "/op", Key: LimitByIP, Limit: 1, Window: durationpb.New(time.Minute)}
+	l, _, dc := testLimiter(t, &conf.Server_RateLimit{Policies: []*conf.Server_RateLimit_Policy{p}})
+	addr, _ := net.ResolveTCPAddr("tcp", "203.0.113.7:5000")
+	ctx := transport.NewServerContext(peer.NewContext(context.Background(), &peer.Peer{Addr: addr}), newTestTransport("/op"))
+	h := l.Middleware()(func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+
+	if _, err := h(ctx, nil); err != nil {
+		t.Fatalf("first request error = %v", err)
+	}
+	if _, err := h(ctx, nil); errors.Reason(err) != "RATE_LIMITED" {
+		t.Errorf("second request error = %v, want RATE_LIMITED", err)
+	}
+
+	dry := &conf.Bootstrap{Server: &conf.Server{RateLimit: &conf.Server_RateLimit{Policies: []*conf.Server_RateLimit_Policy{p}}}, Features: map[string]bool{FeatureRateLimitDryRun: true}}
+	dc.Update(dry)
+	if _, err := h(ctx, nil); err != nil {
+		t.Errorf("dry run error = %v, want the request let through", err)
+	}
+
+	// 去掉策略后立即生效
+	dc.Update(&conf.Bootstrap{Server: &conf.Server{RateLimit: &conf.Server_RateLimit{}}})
+	if _, err := h(ctx, nil); err != nil {
+		t.Errorf("after removing the policy error = %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"anjuke/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// statsHour is the local hour of the daily market stats rollup.
+const statsHour = 3
+
+// StatsScheduler aggregates the market stats every day at statsHour. It runs
+// on every instance; the usecase's lock lets one of them do the work.
+type StatsScheduler struct {
+	uc       *biz.StatsUsecase
+	log      *log.Helper
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewStatsScheduler new a StatsScheduler.
+func NewStatsScheduler(uc *biz.StatsUsecase, logger log.Logger) *StatsScheduler {
+	return &StatsScheduler{uc: uc, log: log.NewHelper(logger), stop: make(chan struct{})}
+}
+
+// Start runs the rollup daily until Stop is called or ctx is done.
+func (s *StatsScheduler) Start(ctx context.Context) error {
+	for {
+		timer := time.NewTimer(time.Until(nextDailyRun(time.Now(), statsHour)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-s.stop:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+			s.run(ctx)
+		}
+	}
+}
+
+// Stop stops the loop.
+func (s *StatsScheduler) Stop(context.Context) error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return nil
+}
+
+// run aggregates yesterday's month, which on the 1st closes the month just
+// over, and then the current month.
+func (s *StatsScheduler) run(ctx context.Context) {
+	now := time.Now()
+	yesterday := now.AddDate(0, 0, -1)
+	months := []time.Time{yesterday}
+	if yesterday.Month() != now.Month() {
+		months = append(months, now)
+	}
+	for _, t := range months {
+		err := s.uc.Aggregate(ctx, t)
+		switch {
+		case errors.Is(err, biz.ErrStatsRunning):
+			s.log.Infof("market stats of %s: aggregated by another instance", t.Format(biz.StatsMonthLayout))
+		case err != nil:
+			s.log.Errorf("market stats of %s: %v", t.Format(biz.StatsMonthLayout), err)
+		}
+	}
+}
+
+// nextDailyRun returns the first time the clock strikes hour after now.
+func nextDailyRun(now time.Time, hour int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
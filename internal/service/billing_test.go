@@ -0,0 +1,245 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	housepb "anjuke/api/house/v3"
+	pb "anjuke/api/transaction/v4"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestTransactionService_Billing(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		client := pb.NewTransactionClient(env.GRPC)
+		sign, terminate, listBills, markPaid, run := call(client.SignLease), call(client.TerminateLease), call(client.ListBills), call(client.MarkBillPaid), call(client.RunBilling)
+		if transport == "http" {
+			client := pb.NewTransactionHTTPClient(env.HTTP)
+			sign, terminate, listBills, markPaid, run = call(client.SignLease), call(client.TerminateLease), call(client.ListBills), call(client.MarkBillPaid), call(client.RunBilling)
+		}
+		ctx := context.Background()
+
+		houses := housepb.NewHouseClient(env.GRPC)
+		var ids []uint64
+		for _, h := range []*housepb.HouseInfo{
+			{Title: "徐汇 一室户", OwnerId: 5, City: "上海", District: "徐汇", ListingType: 1, Area: 40, MonthlyRent: 5000, Utilities: []string{"水费"}},
+			{Title: "静安 两室", OwnerId: 6, City: "上海", District: "静安", ListingType: 1, Area: 70, MonthlyRent: 3000},
+			{Title: "浦东 一室", OwnerId: 6, City: "上海", District: "浦东", ListingType: 1, Area: 35, MonthlyRent: 4000},
+		} {
+			reply, err := houses.CreateHouse(ctx, &housepb.CreateHouseRequest{House: h})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			ids = append(ids, reply.House.Id)
+		}
+
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+		date := func(t time.Time) string { return t.Format("2006-01-02") }
+		parse := func(s string) time.Time {
+			t, _ := time.ParseInLocation("2006-01-02", s, time.Local)
+			return t
+		}
+		// 默认规则：宽限3天，之后每天0.05%，最多20%
+		lateFee := func(amount int64, due string) int64 {
+			late := math.Round(today.Sub(parse(due).AddDate(0, 0, 3)).Hours() / 24)
+			if late <= 0 {
+				return 0
+			}
+			return int64(math.Min(math.Round(float64(amount)*0.05/100*late), math.Round(float64(amount)*0.2)))
+		}
+
+		// 40天前起租、按月付：签约即出前两期的账单
+		signed, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[0], TenantId: 7, StartDate: date(today.AddDate(0, 0, -40)), Months: 12,
+			Charges: []*pb.LeaseCharge{{Name: "网费", Monthly: 100}, {Name: "物业费", Monthly: 300}}})
+		if err != nil {
+			t.Fatalf("SignLease() error = %v", err)
+		}
+		lease := signed.Lease
+		if len(lease.Charges) != 2 || lease.Charges[1].Name != "物业费" {
+			t.Errorf("SignLease() charges = %v", lease.Charges)
+		}
+		// 14个月前签的一年租约已到期
+		expiring, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[1], TenantId: 8, StartDate: date(today.AddDate(0, -14, 0)), Months: 12})
+		if err != nil {
+			t.Fatalf("SignLease() error = %v", err)
+		}
+
+		bills, err := listBills(ctx, &pb.ListBillsRequest{TenantId: 7})
+		if err != nil {
+			t.Fatalf("ListBills() error = %v", err)
+		}
+		if len(bills.Bills) != 4 {
+			t.Fatalf("ListBills() = %v, want rent and charges of 2 periods", bills.Bills)
+		}
+		byKey := map[string]*pb.BillInfo{}
+		for _, b := range bills.Bills {
+			byKey[fmt.Sprintf("%s%d", b.Kind, b.Seq)] = b
+			if b.DueDate != lease.Schedule[b.Seq-1].DueDate || b.LandlordId != 5 {
+				t.Errorf("bill = %v, want due on %s", b, lease.Schedule[b.Seq-1].DueDate)
+			}
+			want := int64(5000)
+			if b.Kind == "utility" {
+				want = 400
+				if len(b.Items) != 2 || b.Items[0].Name != "网费" || b.Items[0].Monthly != 100 {
+					t.Errorf("bill items = %v, want 网费 and 物业费", b.Items)
+				}
+			}
+			if b.Amount != want || b.Status != 2 || b.LateFee != lateFee(want, b.DueDate) {
+				t.Errorf("bill = %v, want %d overdue with late fee %d", b, want, lateFee(want, b.DueDate))
+			}
+		}
+		if bills.Bills[0].Seq != 2 || bills.Bills[3].Seq != 1 {
+			t.Errorf("ListBills() = %v, want the latest due first", bills.Bills)
+		}
+
+		t.Run("run", func(t *testing.T) {
+			reply, err := run(ctx, &pb.RunBillingRequest{})
+			if err != nil {
+				t.Fatalf("RunBilling() error = %v", err)
+			}
+			if reply.Issued != 0 || reply.Overdue != 16 || reply.Expired != 1 {
+				t.Errorf("RunBilling() = %v, want 16 overdue and 1 expired", reply)
+			}
+			l, err := client.GetLease(ctx, &pb.GetLeaseRequest{Id: expiring.Lease.Id})
+			if err != nil {
+				t.Fatalf("GetLease() error = %v", err)
+			}
+			if l.Lease.Status != 1 {
+				t.Errorf("lease status = %d, want expired", l.Lease.Status)
+			}
+			h, err := houses.GetHouse(ctx, &housepb.GetHouseRequest{Id: ids[1]})
+			if err != nil {
+				t.Fatalf("GetHouse() error = %v", err)
+			}
+			if h.House.Status != 0 {
+				t.Errorf("house status = %d, want back on the market", h.House.Status)
+			}
+			overdue := int32(2)
+			list, err := listBills(ctx, &pb.ListBillsRequest{LeaseId: expiring.Lease.Id, Status: &overdue})
+			if err != nil {
+				t.Fatalf("ListBills() error = %v", err)
+			}
+			if len(list.Bills) != 12 || list.Bills[11].LateFee != 600 {
+				t.Errorf("ListBills() = %v, want 12 overdue bills, the first charged the maximum late fee", list.Bills)
+			}
+			again, err := run(ctx, &pb.RunBillingRequest{})
+			if err != nil {
+				t.Fatalf("RunBilling() error = %v", err)
+			}
+			if again.Expired != 0 || again.Issued != 0 {
+				t.Errorf("RunBilling() again = %v, want nothing new", again)
+			}
+		})
+
+		t.Run("receipt", func(t *testing.T) {
+			rent, utility := byKey["rent1"], byKey["utility1"]
+			if _, err := markPaid(ctx, &pb.MarkBillPaidRequest{Id: rent.Id, LandlordId: 6}); errors.Reason(err) != "BILL_FORBIDDEN" {
+				t.Errorf("MarkBillPaid() by another landlord error = %v, want BILL_FORBIDDEN", err)
+			}
+			paid, err := markPaid(ctx, &pb.MarkBillPaidRequest{Id: rent.Id, LandlordId: 5, Note: " 微信转账 "})
+			if err != nil {
+				t.Fatalf("MarkBillPaid() error = %v", err)
+			}
+			b := paid.Bill
+			if b.Status != 1 || b.LateFee != rent.LateFee || b.PaidAmount != 5000+rent.LateFee || b.PaidAt == 0 || b.Note != "微信转账" {
+				t.Errorf("MarkBillPaid() = %v, want paid with the late fee", b)
+			}
+			if _, err := markPaid(ctx, &pb.MarkBillPaidRequest{Id: rent.Id, LandlordId: 5}); errors.Reason(err) != "BILL_STATE" {
+				t.Errorf("MarkBillPaid() twice error = %v, want BILL_STATE", err)
+			}
+			waived, err := markPaid(ctx, &pb.MarkBillPaidRequest{Id: utility.Id, LandlordId: 5, WaiveLateFee: true})
+			if err != nil {
+				t.Fatalf("MarkBillPaid() error = %v", err)
+			}
+			if waived.Bill.LateFee != 0 || waived.Bill.PaidAmount != 400 {
+				t.Errorf("MarkBillPaid() = %v, want the late fee waived", waived.Bill)
+			}
+			paidStatus := int32(1)
+			list, err := listBills(ctx, &pb.ListBillsRequest{LandlordId: 5, Status: &paidStatus})
+			if err != nil {
+				t.Fatalf("ListBills() error = %v", err)
+			}
+			if len(list.Bills) != 2 {
+				t.Errorf("ListBills() paid = %v, want 2", list.Bills)
+			}
+		})
+
+		t.Run("terminate", func(t *testing.T) {
+			// 提前解约：未付的最后一期按天重算
+			second := parse(lease.Schedule[1].Start)
+			end := second.AddDate(0, 0, 10)
+			if _, err := terminate(ctx, &pb.TerminateLeaseRequest{Id: lease.Id, Date: date(end)}); err != nil {
+				t.Fatalf("TerminateLease() error = %v", err)
+			}
+			part := 10 / math.Round(second.AddDate(0, 1, 0).Sub(second).Hours()/24)
+			list, err := listBills(ctx, &pb.ListBillsRequest{LeaseId: lease.Id})
+			if err != nil {
+				t.Fatalf("ListBills() error = %v", err)
+			}
+			for _, b := range list.Bills {
+				if b.Seq != 2 {
+					continue
+				}
+				want := int64(math.Round(5000 * part))
+				if b.Kind == "utility" {
+					want = int64(math.Round(100*part) + math.Round(300*part))
+				}
+				if b.Amount != want || b.PeriodEnd != date(end) {
+					t.Errorf("bill = %v, want %d up to %s", b, want, date(end))
+				}
+			}
+
+			// 起租前解约：已出的账单取消
+			upcoming, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[2], TenantId: 9, StartDate: date(today.AddDate(0, 0, 3)), Months: 6})
+			if err != nil {
+				t.Fatalf("SignLease() error = %v", err)
+			}
+			if _, err := terminate(ctx, &pb.TerminateLeaseRequest{Id: upcoming.Lease.Id, Date: upcoming.Lease.StartDate}); err != nil {
+				t.Fatalf("TerminateLease() error = %v", err)
+			}
+			list, err = listBills(ctx, &pb.ListBillsRequest{TenantId: 9})
+			if err != nil {
+				t.Fatalf("ListBills() error = %v", err)
+			}
+			if len(list.Bills) != 1 || list.Bills[0].Status != 3 || list.Bills[0].DueDate != upcoming.Lease.StartDate {
+				t.Errorf("ListBills() = %v, want the first rent issued and then canceled", list.Bills)
+			}
+		})
+
+		tests := []struct {
+			name       string
+			do         func() error
+			wantReason string
+		}{
+			{"charge included in rent", func() error {
+				_, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[0], TenantId: 9, StartDate: date(today), Months: 6, Charges: []*pb.LeaseCharge{{Name: "水费", Monthly: 50}}})
+				return err
+			}, "LEASE_INVALID"},
+			{"unknown charge", func() error {
+				_, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[0], TenantId: 9, StartDate: date(today), Months: 6, Charges: []*pb.LeaseCharge{{Name: "停车费", Monthly: 300}}})
+				return err
+			}, "LEASE_INVALID"},
+			{"list without filter", func() error {
+				_, err := listBills(ctx, &pb.ListBillsRequest{})
+				return err
+			}, "BILL_INVALID"},
+			{"unknown bill", func() error {
+				_, err := markPaid(ctx, &pb.MarkBillPaidRequest{Id: 404, LandlordId: 5})
+				return err
+			}, "BILL_NOT_FOUND"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.do(); errors.Reason(err) != tt.wantReason {
+					t.Errorf("error = %v, want reason %s", err, tt.wantReason)
+				}
+			})
+		}
+	})
+}
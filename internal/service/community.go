@@ -0,0 +1,144 @@
+package service
+
+import (
+	"anjuke/internal/biz"
+	"context"
+
+	pb "anjuke/api/community/v7"
+)
+
+type CommunityService struct {
+	pb.UnimplementedCommunityServer
+	v7uc *biz.CommunityUsecase
+}
+
+func NewCommunityService(v7uc *biz.CommunityUsecase) *CommunityService {
+	return &CommunityService{
+		v7uc: v7uc,
+	}
+}
+
+func (s *CommunityService) CreateCommunity(ctx context.Context, req *pb.CreateCommunityRequest) (*pb.CreateCommunityReply, error) {
+	c, err := s.v7uc.CreateCommunity(ctx, community(req.GetCommunity()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateCommunityReply{Community: communityInfo(c)}, nil
+}
+
+func (s *CommunityService) UpdateCommunity(ctx context.Context, req *pb.UpdateCommunityRequest) (*pb.UpdateCommunityReply, error) {
+	c, err := s.v7uc.UpdateCommunity(ctx, community(req.GetCommunity()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateCommunityReply{Community: communityInfo(c)}, nil
+}
+
+func (s *CommunityService) DeleteCommunity(ctx context.Context, req *pb.DeleteCommunityRequest) (*pb.DeleteCommunityReply, error) {
+	if err := s.v7uc.DeleteCommunity(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteCommunityReply{}, nil
+}
+
+func (s *CommunityService) CreateBuilding(ctx context.Context, req *pb.CreateBuildingRequest) (*pb.CreateBuildingReply, error) {
+	in := req.GetBuilding()
+	b := &biz.Building{
+		CommunityID: uint(in.GetCommunityId()),
+		Name:        in.GetName(),
+		Floors:      in.GetFloors(),
+	}
+	for _, u := range in.GetUnits() {
+		b.Units = append(b.Units, &biz.Unit{Name: u.GetName(), Households: u.GetHouseholds()})
+	}
+	b, err := s.v7uc.CreateBuilding(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateBuildingReply{Building: buildingInfo(b)}, nil
+}
+
+func (s *CommunityService) DeleteBuilding(ctx context.Context, req *pb.DeleteBuildingRequest) (*pb.DeleteBuildingReply, error) {
+	if err := s.v7uc.DeleteBuilding(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteBuildingReply{}, nil
+}
+
+func (s *CommunityService) GetCommunity(ctx context.Context, req *pb.GetCommunityRequest) (*pb.GetCommunityReply, error) {
+	c, err := s.v7uc.GetCommunityDetail(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetCommunityReply{Community: communityInfo(c)}, nil
+}
+
+func (s *CommunityService) SearchCommunities(ctx context.Context, req *pb.SearchCommunitiesRequest) (*pb.SearchCommunitiesReply, error) {
+	list, err := s.v7uc.SearchCommunities(ctx, req.City, req.Keyword, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.SearchCommunitiesReply{}
+	for _, c := range list {
+		reply.Communities = append(reply.Communities, communityInfo(c))
+	}
+	return reply, nil
+}
+
+func community(in *pb.CommunityInfo) *biz.Community {
+	c := &biz.Community{
+		Name:            in.GetName(),
+		Aliases:         in.GetAliases(),
+		City:            in.GetCity(),
+		District:        in.GetDistrict(),
+		BusinessArea:    in.GetBusinessArea(),
+		RegionID:        uint(in.GetRegionId()),
+		Address:         in.GetAddress(),
+		Longitude:       in.GetLongitude(),
+		Latitude:        in.GetLatitude(),
+		BuildYear:       in.GetBuildYear(),
+		PropertyCompany: in.GetPropertyCompany(),
+		PropertyFee:     in.GetPropertyFee(),
+		GreeningRate:    in.GetGreeningRate(),
+		PlotRatio:       in.GetPlotRatio(),
+	}
+	c.ID = uint(in.GetId())
+	return c
+}
+
+func communityInfo(c *biz.Community) *pb.CommunityInfo {
+	info := &pb.CommunityInfo{
+		Id:              uint64(c.ID),
+		Name:            c.Name,
+		Aliases:         c.Aliases,
+		City:            c.City,
+		District:        c.District,
+		BusinessArea:    c.BusinessArea,
+		Address:         c.Address,
+		Longitude:       c.Longitude,
+		Latitude:        c.Latitude,
+		BuildYear:       c.BuildYear,
+		PropertyCompany: c.PropertyCompany,
+		PropertyFee:     c.PropertyFee,
+		GreeningRate:    c.GreeningRate,
+		PlotRatio:       c.PlotRatio,
+		RegionId:        uint64(c.RegionID),
+	}
+	for _, b := range c.Buildings {
+		info.Buildings = append(info.Buildings, buildingInfo(b))
+	}
+	return info
+}
+
+func buildingInfo(b *biz.Building) *pb.BuildingInfo {
+	info := &pb.BuildingInfo{
+		Id:          uint64(b.ID),
+		CommunityId: uint64(b.CommunityID),
+		Name:        b.Name,
+		Floors:      b.Floors,
+	}
+	for _, u := range b.Units {
+		info.Units = append(info.Units, &pb.UnitInfo{Id: uint64(u.ID), Name: u.Name, Households: u.Households})
+	}
+	return info
+}
@@ -0,0 +1,152 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "anjuke/api/community/v7"
+	housepb "anjuke/api/house/v3"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestCommunityService(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		grpcClient, httpClient := pb.NewCommunityClient(env.GRPC), pb.NewCommunityHTTPClient(env.HTTP)
+		createCommunity, getCommunity := call(grpcClient.CreateCommunity), call(grpcClient.GetCommunity)
+		searchCommunities, createBuilding := call(grpcClient.SearchCommunities), call(grpcClient.CreateBuilding)
+		deleteCommunity := call(grpcClient.DeleteCommunity)
+		createHouse := call(housepb.NewHouseClient(env.GRPC).CreateHouse)
+		if transport == "http" {
+			createCommunity, getCommunity = call(httpClient.CreateCommunity), call(httpClient.GetCommunity)
+			searchCommunities, createBuilding = call(httpClient.SearchCommunities), call(httpClient.CreateBuilding)
+			deleteCommunity = call(httpClient.DeleteCommunity)
+			createHouse = call(housepb.NewHouseHTTPClient(env.HTTP).CreateHouse)
+		}
+		ctx := context.Background()
+
+		tests := []struct {
+			name       string
+			community  *pb.CommunityInfo
+			wantReason string
+		}{
+			{"ok", &pb.CommunityInfo{Name: "仁恒河滨城", Aliases: []string{"仁恒", " ", "仁恒河滨城"}, City: "上海", District: "浦东", BuildYear: 2004}, ""},
+			{"longer name", &pb.CommunityInfo{Name: "仁恒河滨城二期", City: "上海", District: "浦东"}, ""},
+			{"other city", &pb.CommunityInfo{Name: "仁恒滨河湾", City: "南京"}, ""},
+			{"alias only", &pb.CommunityInfo{Name: "滨江花园", Aliases: []string{"仁恒滨江"}, City: "上海"}, ""},
+			{"no name", &pb.CommunityInfo{City: "上海"}, "COMMUNITY_INVALID"},
+			{"no city", &pb.CommunityInfo{Name: "无城市"}, "COMMUNITY_INVALID"},
+		}
+		// 不带运营令牌的调用被拒绝
+		if _, err := createCommunity(testutil.Anonymous(ctx), &pb.CreateCommunityRequest{Community: tests[0].community}); errors.Reason(err) != "ADMIN_UNAUTHORIZED" {
+			t.Errorf("anonymous CreateCommunity() error = %v, want ADMIN_UNAUTHORIZED", err)
+		}
+		ids := map[string]uint64{}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := createCommunity(ctx, &pb.CreateCommunityRequest{Community: tt.community})
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("CreateCommunity() error = %v, want reason %s", err, tt.wantReason)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("CreateCommunity() error = %v", err)
+				}
+				ids[tt.community.Name] = reply.Community.Id
+			})
+		}
+
+		t.Run("aliases normalized", func(t *testing.T) {
+			got, err := getCommunity(ctx, &pb.GetCommunityRequest{Id: ids["仁恒河滨城"]})
+			if err != nil {
+				t.Fatalf("GetCommunity() error = %v", err)
+			}
+			if len(got.Community.Aliases) != 1 || got.Community.Aliases[0] != "仁恒" {
+				t.Errorf("Aliases = %q, want [仁恒]", got.Community.Aliases)
+			}
+		})
+
+		t.Run("search", func(t *testing.T) {
+			for _, tc := range []struct {
+				keyword, city string
+				want          []string
+			}{
+				{"仁恒河滨城", "上海", []string{"仁恒河滨城", "仁恒河滨城二期"}},
+				{"仁恒", "上海", []string{"仁恒河滨城", "仁恒河滨城二期", "滨江花园"}},
+				{"仁恒", "", []string{"仁恒河滨城", "仁恒滨河湾", "仁恒河滨城二期", "滨江花园"}},
+				{"不存在", "", nil},
+			} {
+				got, err := searchCommunities(ctx, &pb.SearchCommunitiesRequest{Keyword: tc.keyword, City: tc.city})
+				if err != nil {
+					t.Fatalf("SearchCommunities(%q) error = %v", tc.keyword, err)
+				}
+				var names []string
+				for _, c := range got.Communities {
+					names = append(names, c.Name)
+				}
+				if len(names) != len(tc.want) {
+					t.Fatalf("SearchCommunities(%q, %q) = %q, want %q", tc.keyword, tc.city, names, tc.want)
+				}
+				for i := range names {
+					if names[i] != tc.want[i] {
+						t.Errorf("SearchCommunities(%q, %q) = %q, want %q", tc.keyword, tc.city, names, tc.want)
+						break
+					}
+				}
+			}
+		})
+
+		t.Run("buildings", func(t *testing.T) {
+			_, err := createBuilding(ctx, &pb.CreateBuildingRequest{Building: &pb.BuildingInfo{CommunityId: 404, Name: "1号楼"}})
+			if errors.Reason(err) != "COMMUNITY_NOT_FOUND" {
+				t.Fatalf("CreateBuilding() error = %v, want COMMUNITY_NOT_FOUND", err)
+			}
+			_, err = createBuilding(ctx, &pb.CreateBuildingRequest{Building: &pb.BuildingInfo{
+				CommunityId: ids["仁恒河滨城"],
+				Name:        "3号楼",
+				Floors:      18,
+				Units:       []*pb.UnitInfo{{Name: "1单元", Households: 36}, {Name: "2单元", Households: 36}},
+			}})
+			if err != nil {
+				t.Fatalf("CreateBuilding() error = %v", err)
+			}
+			got, err := getCommunity(ctx, &pb.GetCommunityRequest{Id: ids["仁恒河滨城"]})
+			if err != nil {
+				t.Fatalf("GetCommunity() error = %v", err)
+			}
+			if len(got.Community.Buildings) != 1 || len(got.Community.Buildings[0].Units) != 2 {
+				t.Errorf("Buildings = %v, want 3号楼 with 2 units", got.Community.Buildings)
+			}
+		})
+
+		t.Run("house references community", func(t *testing.T) {
+			reply, err := createHouse(ctx, &housepb.CreateHouseRequest{House: &housepb.HouseInfo{
+				Title: "河滨城 3室", CommunityId: ids["仁恒河滨城"], Rooms: 3, Area: 120, Price: 9600000,
+			}})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			h := reply.House
+			if h.CommunityName != "仁恒河滨城" || h.City != "上海" || h.District != "浦东" || h.BuildYear != 2004 {
+				t.Errorf("CreateHouse() = %v, want location from community", h)
+			}
+			_, err = createHouse(ctx, &housepb.CreateHouseRequest{House: &housepb.HouseInfo{CommunityId: 404, Area: 50, Price: 1000000}})
+			if errors.Reason(err) != "COMMUNITY_NOT_FOUND" {
+				t.Errorf("CreateHouse() error = %v, want COMMUNITY_NOT_FOUND", err)
+			}
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			if _, err := deleteCommunity(ctx, &pb.DeleteCommunityRequest{Id: ids["仁恒河滨城"]}); err != nil {
+				t.Fatalf("DeleteCommunity() error = %v", err)
+			}
+			_, err := getCommunity(ctx, &pb.GetCommunityRequest{Id: ids["仁恒河滨城"]})
+			if !errors.IsNotFound(err) || errors.Reason(err) != "COMMUNITY_NOT_FOUND" {
+				t.Errorf("GetCommunity() error = %v, want COMMUNITY_NOT_FOUND", err)
+			}
+		})
+	})
+}
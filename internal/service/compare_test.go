@@ -0,0 +1,164 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	communitypb "anjuke/api/community/v7"
+	pb "anjuke/api/house/v3"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestHouseService_CompareHouses(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		grpcClient, httpClient := pb.NewHouseClient(env.GRPC), pb.NewHouseHTTPClient(env.HTTP)
+		compareHouses, addCompare := call(grpcClient.CompareHouses), call(grpcClient.AddCompareHouse)
+		removeCompare, getBasket := call(grpcClient.RemoveCompareHouse), call(grpcClient.GetCompareBasket)
+		if transport == "http" {
+			compareHouses, addCompare = call(httpClient.CompareHouses), call(httpClient.AddCompareHouse)
+			removeCompare, getBasket = call(httpClient.RemoveCompareHouse), call(httpClient.GetCompareBasket)
+		}
+		ctx := context.Background()
+
+		communities := communitypb.NewCommunityClient(env.GRPC)
+		var communityIDs []uint64
+		for _, c := range []*communitypb.CommunityInfo{
+			// 陆家嘴站附近
+			{Name: "仁恒河滨城", City: "上海", District: "浦东", Longitude: 121.505, Latitude: 31.240, BuildYear: 2010, PropertyFee: 3.5},
+			// 附近没有地铁站
+			{Name: "临港新苑", City: "上海", District: "浦东", Longitude: 121.900, Latitude: 30.900, BuildYear: 2018, PropertyFee: 2},
+		} {
+			reply, err := communities.CreateCommunity(ctx, &communitypb.CreateCommunityRequest{Community: c})
+			if err != nil {
+				t.Fatalf("CreateCommunity() error = %v", err)
+			}
+			communityIDs = append(communityIDs, reply.Community.Id)
+		}
+
+		houses := pb.NewHouseClient(env.GRPC)
+		var ids []uint64
+		for _, h := range []*pb.HouseInfo{
+			{Title: "河滨城 两室", CommunityId: communityIDs[0], Rooms: 2, Area: 80, Price: 8000000, Floor: 12, TotalFloors: 30},
+			{Title: "临港 三室", CommunityId: communityIDs[1], Rooms: 3, Area: 100, Price: 6000000},
+			{Title: "老公房 一室", City: "上海", District: "浦东", Rooms: 1, Area: 60, Price: 6000000},
+			{Title: "备选一", City: "上海", District: "浦东", Area: 50, Price: 5000000},
+			{Title: "备选二", City: "上海", District: "浦东", Area: 50, Price: 5000000},
+		} {
+			reply, err := houses.CreateHouse(ctx, &pb.CreateHouseRequest{House: h})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			ids = append(ids, reply.House.Id)
+		}
+
+		t.Run("rows", func(t *testing.T) {
+			reply, err := compareHouses(ctx, &pb.CompareHousesRequest{HouseIds: ids[:3]})
+			if err != nil {
+				t.Fatalf("CompareHouses() error = %v", err)
+			}
+			if len(reply.Houses) != 3 || reply.Houses[0].Id != ids[0] {
+				t.Fatalf("CompareHouses() houses = %v, want %v in order", reply.Houses, ids[:3])
+			}
+			rows := map[string]*pb.CompareRow{}
+			for _, r := range reply.Rows {
+				rows[r.Key] = r
+				if len(r.Values) != 3 {
+					t.Errorf("row %s = %q, want 3 values", r.Key, r.Values)
+				}
+			}
+			age := time.Now().Year() - 2010
+			tests := []struct {
+				key, want0 string
+				best       string
+			}{
+				{"price", "800万", "[1 2]"},
+				{"unit_price", "100000元/㎡", "[1]"},
+				{"area", "80㎡", "[1]"},
+				{"layout", "2室0厅0卫", "[]"},
+				{"floor", "12/30层", "[]"},
+				{"age", fmt.Sprintf("%d年", age), "[1]"},
+				{"property_fee", "3.5元/㎡·月", "[1]"},
+				{"subway", "陆家嘴", "[]"}, // 只有一套房源有地铁距离，不比较
+			}
+			for _, tt := range tests {
+				r := rows[tt.key]
+				if r == nil {
+					t.Errorf("row %s missing", tt.key)
+					continue
+				}
+				if !strings.HasPrefix(r.Values[0], tt.want0) || fmt.Sprint(r.Best) != tt.best {
+					t.Errorf("row %s = %q best %v, want %q best %s", tt.key, r.Values, r.Best, tt.want0, tt.best)
+				}
+			}
+			if v := rows["subway"].Values; v[1] != "" || v[2] != "" {
+				t.Errorf("subway = %q, want only the first house near a station", v)
+			}
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			for _, req := range []*pb.CompareHousesRequest{
+				{HouseIds: ids[:1]},
+				{HouseIds: []uint64{ids[0], ids[0]}},
+				{HouseIds: ids},
+			} {
+				if _, err := compareHouses(ctx, req); errors.Reason(err) != "COMPARE_INVALID" {
+					t.Errorf("CompareHouses(%v) error = %v, want COMPARE_INVALID", req.HouseIds, err)
+				}
+			}
+			if _, err := compareHouses(ctx, &pb.CompareHousesRequest{HouseIds: []uint64{ids[0], ids[4] + 100}}); errors.Reason(err) != "HOUSE_NOT_FOUND" {
+				t.Errorf("CompareHouses() error = %v, want HOUSE_NOT_FOUND", err)
+			}
+		})
+
+		t.Run("basket", func(t *testing.T) {
+			steps := []struct {
+				name       string
+				add        bool
+				houseID    uint64
+				wantReason string
+				want       int // 对比栏中的房源数
+			}{
+				{"add", true, ids[0], "", 1},
+				{"add again", true, ids[0], "", 1},
+				{"add second", true, ids[1], "", 2},
+				{"add third", true, ids[2], "", 3},
+				{"add fourth", true, ids[3], "", 4},
+				{"full", true, ids[4], "COMPARE_BASKET_FULL", 4},
+				{"unknown house", true, ids[4] + 100, "HOUSE_NOT_FOUND", 4},
+				{"remove", false, ids[3], "", 3},
+			}
+			for _, s := range steps {
+				req := &pb.CompareBasketRequest{UserId: 7, HouseId: s.houseID}
+				var err error
+				if s.add {
+					_, err = addCompare(ctx, req)
+				} else {
+					_, err = removeCompare(ctx, req)
+				}
+				if errors.Reason(err) != s.wantReason && !(s.wantReason == "" && err == nil) {
+					t.Fatalf("%s: error = %v, want reason %q", s.name, err, s.wantReason)
+				}
+				basket, err := getBasket(ctx, &pb.GetCompareBasketRequest{UserId: 7})
+				if err != nil {
+					t.Fatalf("GetCompareBasket() error = %v", err)
+				}
+				if len(basket.HouseIds) != s.want {
+					t.Errorf("%s: basket = %v, want %d houses", s.name, basket.HouseIds, s.want)
+				}
+			}
+
+			reply, err := compareHouses(ctx, &pb.CompareHousesRequest{UserId: 7})
+			if err != nil {
+				t.Fatalf("CompareHouses(basket) error = %v", err)
+			}
+			if len(reply.Houses) != 3 || reply.Houses[0].Id != ids[0] || reply.Houses[2].Id != ids[2] {
+				t.Errorf("CompareHouses(basket) = %v, want the basket in the order added", reply.Houses)
+			}
+		})
+	})
+}
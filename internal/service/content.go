@@ -0,0 +1,77 @@
+package service
+
+import (
+	"anjuke/internal/biz"
+	"context"
+
+	pb "anjuke/api/content/v11"
+)
+
+type ContentService struct {
+	pb.UnimplementedContentServer
+	v11uc *biz.ContentUsecase
+}
+
+func NewContentService(v11uc *biz.ContentUsecase) *ContentService {
+	return &ContentService{
+		v11uc: v11uc,
+	}
+}
+
+func (s *ContentService) CheckContent(ctx context.Context, req *pb.CheckContentRequest) (*pb.CheckContentReply, error) {
+	res, err := s.v11uc.Filter(ctx, req.Scene, req.Text)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.CheckContentReply{Text: res.Text, Action: res.Action}
+	for _, h := range res.Hits {
+		reply.Hits = append(reply.Hits, &pb.ContentHit{
+			Kind:     h.Kind,
+			Text:     h.Text,
+			Category: h.Category,
+			Action:   h.Action,
+			Start:    int32(h.Start),
+			End:      int32(h.End),
+		})
+	}
+	return reply, nil
+}
+
+func (s *ContentService) ListWords(ctx context.Context, req *pb.ListWordsRequest) (*pb.ListWordsReply, error) {
+	list, err := s.v11uc.ListWords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListWordsReply{}
+	for _, w := range list {
+		reply.Words = append(reply.Words, &pb.WordInfo{Word: w.Word, Action: w.Action, Category: w.Category})
+	}
+	return reply, nil
+}
+
+func (s *ContentService) AddWords(ctx context.Context, req *pb.AddWordsRequest) (*pb.AddWordsReply, error) {
+	words := make([]*biz.SensitiveWord, 0, len(req.Words))
+	for _, w := range req.Words {
+		words = append(words, &biz.SensitiveWord{Word: w.Word, Action: w.Action, Category: w.Category})
+	}
+	n, err := s.v11uc.SaveWords(ctx, words)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddWordsReply{Count: int32(n)}, nil
+}
+
+func (s *ContentService) DeleteWord(ctx context.Context, req *pb.DeleteWordRequest) (*pb.DeleteWordReply, error) {
+	if err := s.v11uc.DeleteWord(ctx, req.Word); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteWordReply{}, nil
+}
+
+func (s *ContentService) ReloadWords(ctx context.Context, req *pb.ReloadWordsRequest) (*pb.ReloadWordsReply, error) {
+	n, err := s.v11uc.Reload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReloadWordsReply{Count: int32(n)}, nil
+}
@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	communitypb "anjuke/api/community/v7"
+	pb "anjuke/api/content/v11"
+	customerpb "anjuke/api/customer/v6"
+	housepb "anjuke/api/house/v3"
+	userpb "anjuke/api/user/v2"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestContentService(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		client := pb.NewContentClient(env.GRPC)
+		check, add, listWords, deleteWord := call(client.CheckContent), call(client.AddWords), call(client.ListWords), call(client.DeleteWord)
+		if transport == "http" {
+			client := pb.NewContentHTTPClient(env.HTTP)
+			check, add, listWords, deleteWord = call(client.CheckContent), call(client.AddWords), call(client.ListWords), call(client.DeleteWord)
+		}
+		ctx := context.Background()
+
+		added, err := add(ctx, &pb.AddWordsRequest{Words: []*pb.WordInfo{
+			{Word: "代办贷款", Category: "违规"},
+			{Word: "傻瓜", Action: "mask", Category: "辱骂"},
+			{Word: "学区名额", Action: "review", Category: "承诺"},
+		}})
+		if err != nil {
+			t.Fatalf("AddWords() error = %v", err)
+		}
+		if added.Count != 3 {
+			t.Errorf("AddWords() count = %d, want 3", added.Count)
+		}
+		// 已有的词更新分类
+		if _, err := add(ctx, &pb.AddWordsRequest{Words: []*pb.WordInfo{{Word: "ＳＢ", Action: "mask", Category: "辱骂"}, {Word: "代办贷款", Category: "金融"}}}); err != nil {
+			t.Fatalf("AddWords() error = %v", err)
+		}
+		words, err := listWords(ctx, &pb.ListWordsRequest{})
+		if err != nil {
+			t.Fatalf("ListWords() error = %v", err)
+		}
+		if len(words.Words) != 4 {
+			t.Errorf("ListWords() = %v, want 4 words", words.Words)
+		}
+		actions, categories := map[string]string{}, map[string]string{}
+		for _, w := range words.Words {
+			actions[w.Word], categories[w.Word] = w.Action, w.Category
+		}
+		if actions["代办贷款"] != "block" || categories["代办贷款"] != "金融" || actions["学区名额"] != "review" {
+			t.Errorf("ListWords() = %v, want block by default and the category updated", words.Words)
+		}
+
+		tests := []struct {
+			name       string
+			scene      string
+			text       string
+			wantText   string
+			wantAction string
+			wantKinds  []string
+		}{
+			{"clean", "listing", "南北通透 满五唯一", "南北通透 满五唯一", "", nil},
+			{"split word", "listing", "可代.办 贷-款", "可代.办 贷-款", "block", []string{"word"}},
+			{"mask word", "listing", "房东是个傻瓜", "房东是个**", "mask", []string{"word"}},
+			{"full-width word", "listing", "别当Sb", "别当**", "mask", []string{"word"}},
+			{"review word", "listing", "送学区名额", "送学区名额", "review", []string{"word"}},
+			{"review masked outside listing", "customer", "送学区名额", "送****", "mask", []string{"word"}},
+			{"spaced phone", "listing", "电话1 3 8-0013-8000", "电话***************", "mask", []string{"phone"}},
+			{"chinese digits", "listing", "打一三九零零一三八零零零", "打***********", "mask", []string{"phone"}},
+			{"wechat", "listing", "加V：abc_123 看房", "加V：******* 看房", "mask", []string{"wechat"}},
+			{"short number", "listing", "2室1厅 建筑面积89平 总价560万", "2室1厅 建筑面积89平 总价560万", "", nil},
+			{"phone in profile", "profile", "叫我13800138000", "叫我13800138000", "block", []string{"phone"}},
+			{"phone in customer", "customer", "手机13800138000", "手机13800138000", "", nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := check(ctx, &pb.CheckContentRequest{Scene: tt.scene, Text: tt.text})
+				if err != nil {
+					t.Fatalf("CheckContent() error = %v", err)
+				}
+				if reply.Text != tt.wantText || reply.Action != tt.wantAction {
+					t.Errorf("CheckContent() = %q %q, want %q %q", reply.Text, reply.Action, tt.wantText, tt.wantAction)
+				}
+				var kinds []string
+				for _, h := range reply.Hits {
+					kinds = append(kinds, h.Kind)
+				}
+				if len(kinds) != len(tt.wantKinds) {
+					t.Fatalf("CheckContent() hits = %v, want %v", reply.Hits, tt.wantKinds)
+				}
+				for i := range kinds {
+					if kinds[i] != tt.wantKinds[i] {
+						t.Errorf("CheckContent() hits = %v, want %v", reply.Hits, tt.wantKinds)
+					}
+				}
+			})
+		}
+
+		houses := housepb.NewHouseClient(env.GRPC)
+		t.Run("house", func(t *testing.T) {
+			community, err := communitypb.NewCommunityClient(env.GRPC).CreateCommunity(ctx, &communitypb.CreateCommunityRequest{Community: &communitypb.CommunityInfo{
+				Name: "仁恒河滨城", City: "上海", District: "浦东", BuildYear: 2010,
+			}})
+			if err != nil {
+				t.Fatalf("CreateCommunity() error = %v", err)
+			}
+			_, err = houses.CreateHouse(ctx, &housepb.CreateHouseRequest{House: &housepb.HouseInfo{Title: "急售 可代办贷款", OwnerId: 1, Area: 90, Price: 5000000}})
+			if errors.Reason(err) != "CONTENT_BLOCKED" || errors.FromError(err).Metadata["field"] != "title" {
+				t.Errorf("CreateHouse() error = %v, want the title blocked", err)
+			}
+			reply, err := houses.CreateHouse(ctx, &housepb.CreateHouseRequest{House: &housepb.HouseInfo{
+				Title: "南北通透两室", Description: "业主电话13800138000，微信:zhang_fang88", OwnerId: 1, Area: 90, Price: 5000000,
+			}})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			if want := "业主电话***********，微信:************"; reply.House.Description != want {
+				t.Errorf("description = %q, want %q", reply.House.Description, want)
+			}
+
+			reply, err = houses.CreateHouse(ctx, &housepb.CreateHouseRequest{House: &housepb.HouseInfo{
+				Title: "对口名校 送学区名额", OwnerId: 1, CommunityId: community.Community.Id, Area: 90, Price: 5000000,
+			}})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			queue, err := houses.ListModerationQueue(ctx, &housepb.ListModerationQueueRequest{})
+			if err != nil {
+				t.Fatalf("ListModerationQueue() error = %v", err)
+			}
+			if queue.Total != 1 || queue.Items[0].HouseId != reply.House.Id || queue.Items[0].Reasons[0].Kind != "content" {
+				t.Errorf("ListModerationQueue() = %v, want house %d queued for its content", queue.Items, reply.House.Id)
+			}
+		})
+
+		t.Run("profile and customer", func(t *testing.T) {
+			users := userpb.NewUserClient(env.GRPC)
+			_, err := users.CreateUser(ctx, &userpb.CreateUserRequest{Mobile: "13900000009", NickName: "加我 138 0013 8000", Password: "secret"})
+			if errors.Reason(err) != "CONTENT_BLOCKED" {
+				t.Errorf("CreateUser() error = %v, want CONTENT_BLOCKED", err)
+			}
+			customers := customerpb.NewCustomerClient(env.GRPC)
+			reply, err := customers.CreateCustomer(ctx, &customerpb.CreateCustomerRequest{Customer: &customerpb.CustomerInfo{
+				Name: "王先生", Mobile: "13700000009", Remark: "备用电话13800138000，别叫他傻瓜",
+			}})
+			if err != nil {
+				t.Fatalf("CreateCustomer() error = %v", err)
+			}
+			if want := "备用电话13800138000，别叫他**"; reply.Customer.Remark != want {
+				t.Errorf("remark = %q, want %q", reply.Customer.Remark, want)
+			}
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			// 不带运营令牌不能改词库
+			if _, err := deleteWord(testutil.Anonymous(ctx), &pb.DeleteWordRequest{Word: "傻瓜"}); errors.Reason(err) != "ADMIN_UNAUTHORIZED" {
+				t.Errorf("anonymous DeleteWord() error = %v, want ADMIN_UNAUTHORIZED", err)
+			}
+			if _, err := add(testutil.Anonymous(ctx), &pb.AddWordsRequest{}); errors.Reason(err) != "ADMIN_UNAUTHORIZED" {
+				t.Errorf("anonymous AddWords() error = %v, want ADMIN_UNAUTHORIZED", err)
+			}
+			if _, err := deleteWord(ctx, &pb.DeleteWordRequest{Word: "傻瓜"}); err != nil {
+				t.Fatalf("DeleteWord() error = %v", err)
+			}
+			reply, err := check(ctx, &pb.CheckContentRequest{Scene: "listing", Text: "房东是个傻瓜"})
+			if err != nil {
+				t.Fatalf("CheckContent() error = %v", err)
+			}
+			if reply.Action != "" {
+				t.Errorf("CheckContent() = %v, want the deleted word passed", reply)
+			}
+		})
+
+		errTests := []struct {
+			name       string
+			do         func() error
+			wantReason string
+		}{
+			{"unknown scene", func() error {
+				_, err := check(ctx, &pb.CheckContentRequest{Scene: "chat", Text: "你好"})
+				return err
+			}, "CONTENT_INVALID"},
+			{"unknown action", func() error {
+				_, err := add(ctx, &pb.AddWordsRequest{Words: []*pb.WordInfo{{Word: "中介勿扰", Action: "warn"}}})
+				return err
+			}, "WORD_INVALID"},
+			{"empty word", func() error {
+				_, err := add(ctx, &pb.AddWordsRequest{Words: []*pb.WordInfo{{Word: " ，"}}})
+				return err
+			}, "WORD_INVALID"},
+			{"delete unknown", func() error {
+				_, err := deleteWord(ctx, &pb.DeleteWordRequest{Word: "不存在"})
+				return err
+			}, "WORD_NOT_FOUND"},
+		}
+		for _, tt := range errTests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.do(); errors.Reason(err) != tt.wantReason {
+					t.Errorf("error = %v, want reason %s", err, tt.wantReason)
+				}
+			})
+		}
+	})
+}
@@ -19,5 +19,38 @@ func NewCustomerService(v6uc *biz.CustomerUsecase) *CustomerService {
 }
 
 func (s *CustomerService) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CreateCustomerReply, error) {
-	return &pb.CreateCustomerReply{}, nil
+	in := req.GetCustomer()
+	c, err := s.v6uc.CreateCustomer(ctx, &biz.Customer{
+		AgentID:   uint(in.GetAgentId()),
+		UserID:    uint(in.GetUserId()),
+		Name:      in.GetName(),
+		Mobile:    in.GetMobile(),
+		District:  in.GetDistrict(),
+		RegionID:  uint(in.GetRegionId()),
+		Rooms:     in.GetRooms(),
+		BudgetMin: in.GetBudgetMin(),
+		BudgetMax: in.GetBudgetMax(),
+		Remark:    in.GetRemark(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateCustomerReply{Customer: customerInfo(c)}, nil
+}
+
+func customerInfo(c *biz.Customer) *pb.CustomerInfo {
+	return &pb.CustomerInfo{
+		Id:        uint64(c.ID),
+		AgentId:   uint64(c.AgentID),
+		UserId:    uint64(c.UserID),
+		Name:      c.Name,
+		Mobile:    c.Mobile,
+		Stage:     c.Stage,
+		District:  c.District,
+		Rooms:     c.Rooms,
+		BudgetMin: c.BudgetMin,
+		BudgetMax: c.BudgetMax,
+		Remark:    c.Remark,
+		RegionId:  uint64(c.RegionID),
+	}
 }
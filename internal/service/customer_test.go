@@ -0,0 +1,48 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "anjuke/api/customer/v6"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestCustomerService_CreateCustomer(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		createCustomer := call(pb.NewCustomerClient(env.GRPC).CreateCustomer)
+		if transport == "http" {
+			createCustomer = call(pb.NewCustomerHTTPClient(env.HTTP).CreateCustomer)
+		}
+
+		tests := []struct {
+			name       string
+			customer   *pb.CustomerInfo
+			wantReason string
+		}{
+			{"ok", &pb.CustomerInfo{AgentId: 3, Name: "王先生", Mobile: "13700000001", District: "浦东", Rooms: 2, BudgetMin: 4000000, BudgetMax: 6000000}, ""},
+			{"public pool", &pb.CustomerInfo{Name: "李女士", Mobile: "13700000002"}, ""},
+			{"missing mobile", &pb.CustomerInfo{Name: "赵先生"}, "CUSTOMER_INVALID"},
+			{"budget reversed", &pb.CustomerInfo{Mobile: "13700000003", BudgetMin: 6000000, BudgetMax: 4000000}, "CUSTOMER_INVALID"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := createCustomer(context.Background(), &pb.CreateCustomerRequest{Customer: tt.customer})
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("CreateCustomer() error = %v, want reason %s", err, tt.wantReason)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("CreateCustomer() error = %v", err)
+				}
+				if reply.Customer.Id == 0 || reply.Customer.Stage != 0 || reply.Customer.Mobile != tt.customer.Mobile {
+					t.Errorf("CreateCustomer() = %v", reply.Customer)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"anjuke/internal/biz"
+	"context"
+
+	pb "anjuke/api/favorite/v9"
+)
+
+type FavoriteService struct {
+	pb.UnimplementedFavoriteServer
+	v9uc *biz.FavoriteUsecase
+}
+
+func NewFavoriteService(v9uc *biz.FavoriteUsecase) *FavoriteService {
+	return &FavoriteService{
+		v9uc: v9uc,
+	}
+}
+
+func (s *FavoriteService) AddFavorite(ctx context.Context, req *pb.AddFavoriteRequest) (*pb.AddFavoriteReply, error) {
+	f, err := s.v9uc.AddFavorite(ctx, &biz.Favorite{
+		UserID:  uint(req.UserId),
+		HouseID: uint(req.HouseId),
+		Folder:  req.Folder,
+		Note:    req.Note,
+	}, req.PriceDropPercent)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddFavoriteReply{Favorite: favoriteInfo(f)}, nil
+}
+
+func (s *FavoriteService) RemoveFavorite(ctx context.Context, req *pb.RemoveFavoriteRequest) (*pb.RemoveFavoriteReply, error) {
+	if err := s.v9uc.RemoveFavorite(ctx, uint(req.UserId), uint(req.HouseId)); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveFavoriteReply{}, nil
+}
+
+func (s *FavoriteService) ListFavorites(ctx context.Context, req *pb.ListFavoritesRequest) (*pb.ListFavoritesReply, error) {
+	list, folders, err := s.v9uc.ListFavorites(ctx, uint(req.UserId), req.Folder)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListFavoritesReply{}
+	for _, f := range list {
+		reply.Favorites = append(reply.Favorites, favoriteInfo(f))
+	}
+	for _, f := range folders {
+		reply.Folders = append(reply.Folders, &pb.FolderInfo{Name: f.Name, Count: f.Count})
+	}
+	return reply, nil
+}
+
+func (s *FavoriteService) ListBrowseHistory(ctx context.Context, req *pb.ListBrowseHistoryRequest) (*pb.ListBrowseHistoryReply, error) {
+	list, err := s.v9uc.ListBrowseHistory(ctx, uint(req.UserId), int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListBrowseHistoryReply{}
+	for _, r := range list {
+		reply.Records = append(reply.Records, &pb.BrowseRecordInfo{House: houseInfo(r.House), ViewedAt: r.ViewedAt.Unix()})
+	}
+	return reply, nil
+}
+
+func favoriteInfo(f *biz.Favorite) *pb.FavoriteInfo {
+	info := &pb.FavoriteInfo{
+		Id:        uint64(f.ID),
+		HouseId:   uint64(f.HouseID),
+		Folder:    f.Folder,
+		Note:      f.Note,
+		CreatedAt: f.CreatedAt.Unix(),
+	}
+	if f.House != nil {
+		info.House = houseInfo(f.House)
+	}
+	return info
+}
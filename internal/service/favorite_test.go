@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "anjuke/api/favorite/v9"
+	housepb "anjuke/api/house/v3"
+	userpb "anjuke/api/user/v2"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFavoriteService(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		grpcClient, httpClient := pb.NewFavoriteClient(env.GRPC), pb.NewFavoriteHTTPClient(env.HTTP)
+		addFavorite, removeFavorite := call(grpcClient.AddFavorite), call(grpcClient.RemoveFavorite)
+		listFavorites, listHistory := call(grpcClient.ListFavorites), call(grpcClient.ListBrowseHistory)
+		getHouse := call(housepb.NewHouseClient(env.GRPC).GetHouse)
+		if transport == "http" {
+			addFavorite, removeFavorite = call(httpClient.AddFavorite), call(httpClient.RemoveFavorite)
+			listFavorites, listHistory = call(httpClient.ListFavorites), call(httpClient.ListBrowseHistory)
+			getHouse = call(housepb.NewHouseHTTPClient(env.HTTP).GetHouse)
+		}
+		ctx := context.Background()
+
+		houses := housepb.NewHouseClient(env.GRPC)
+		var ids []uint64
+		for _, title := range []string{"仁恒河滨城 2室1厅", "静安 三室两厅"} {
+			reply, err := houses.CreateHouse(ctx, &housepb.CreateHouseRequest{House: &housepb.HouseInfo{
+				Title: title, City: "上海", District: "浦东", Rooms: 2, Area: 80, Price: 8000000,
+			}})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			ids = append(ids, reply.House.Id)
+		}
+		a, b := ids[0], ids[1]
+
+		tests := []struct {
+			name       string
+			req        *pb.AddFavoriteRequest
+			wantReason string
+			wantFolder string
+		}{
+			{"with folder and alert", &pb.AddFavoriteRequest{UserId: 7, HouseId: a, Folder: " 学区 ", Note: "周末看房", PriceDropPercent: proto.Int32(5)}, "", "学区"},
+			{"default folder", &pb.AddFavoriteRequest{UserId: 8, HouseId: a}, "", "默认"},
+			{"second house", &pb.AddFavoriteRequest{UserId: 7, HouseId: b}, "", "默认"},
+			// 不传降价幅度时保留收藏时设置的提醒
+			{"update note", &pb.AddFavoriteRequest{UserId: 7, HouseId: a, Folder: "学区", Note: "已约周六"}, "", "学区"},
+			{"no user", &pb.AddFavoriteRequest{HouseId: a}, "FAVORITE_INVALID", ""},
+			{"long folder", &pb.AddFavoriteRequest{UserId: 7, HouseId: a, Folder: strings.Repeat("学", 21)}, "FAVORITE_INVALID", ""},
+			{"unknown house", &pb.AddFavoriteRequest{UserId: 7, HouseId: b + 100}, "HOUSE_NOT_FOUND", ""},
+			{"invalid alert", &pb.AddFavoriteRequest{UserId: 9, HouseId: a, PriceDropPercent: proto.Int32(100)}, "PRICE_ALERT_INVALID", ""},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := addFavorite(ctx, tt.req)
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("AddFavorite() error = %v, want reason %s", err, tt.wantReason)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("AddFavorite() error = %v", err)
+				}
+				if reply.Favorite.Folder != tt.wantFolder || reply.Favorite.Note != tt.req.Note || reply.Favorite.House.GetId() != tt.req.HouseId {
+					t.Errorf("AddFavorite() = %v, want folder %s", reply.Favorite, tt.wantFolder)
+				}
+			})
+		}
+
+		t.Run("list", func(t *testing.T) {
+			reply, err := listFavorites(ctx, &pb.ListFavoritesRequest{UserId: 7})
+			if err != nil {
+				t.Fatalf("ListFavorites() error = %v", err)
+			}
+			if len(reply.Favorites) != 2 || reply.Favorites[0].HouseId != b || reply.Favorites[1].Note != "已约周六" {
+				t.Errorf("ListFavorites() = %v, want house %d first and the updated note", reply.Favorites, b)
+			}
+			if len(reply.Folders) != 2 || reply.Folders[0].Name != "学区" || reply.Folders[0].Count != 1 {
+				t.Errorf("folders = %v, want 学区 and 默认 with 1 each", reply.Folders)
+			}
+			reply, err = listFavorites(ctx, &pb.ListFavoritesRequest{UserId: 7, Folder: "学区"})
+			if err != nil {
+				t.Fatalf("ListFavorites() error = %v", err)
+			}
+			if len(reply.Favorites) != 1 || reply.Favorites[0].House.GetTitle() != "仁恒河滨城 2室1厅" {
+				t.Errorf("ListFavorites(学区) = %v, want house %d", reply.Favorites, a)
+			}
+		})
+
+		t.Run("favorite count", func(t *testing.T) {
+			got, err := getHouse(ctx, &housepb.GetHouseRequest{Id: a})
+			if err != nil {
+				t.Fatalf("GetHouse() error = %v", err)
+			}
+			if got.House.FavoriteCount != 2 {
+				t.Errorf("FavoriteCount = %d, want 2", got.House.FavoriteCount)
+			}
+			search, err := houses.SearchHouses(ctx, &housepb.SearchHousesRequest{Keyword: "仁恒"})
+			if err != nil {
+				t.Fatalf("SearchHouses() error = %v", err)
+			}
+			if len(search.Hits) != 1 || search.Hits[0].House.FavoriteCount != 2 {
+				t.Errorf("SearchHouses() = %v, want favorite count 2", search.Hits)
+			}
+		})
+
+		// 收藏时设置的降价提醒在更新备注后仍然有效，随取消收藏一起取消
+		t.Run("price alert", func(t *testing.T) {
+			notifications := userpb.NewUserClient(env.GRPC)
+			if _, err := houses.ChangeHousePrice(ctx, &housepb.ChangeHousePriceRequest{Id: a, Price: 7200000}); err != nil {
+				t.Fatalf("ChangeHousePrice() error = %v", err)
+			}
+			if _, err := removeFavorite(ctx, &pb.RemoveFavoriteRequest{UserId: 7, HouseId: a}); err != nil {
+				t.Fatalf("RemoveFavorite() error = %v", err)
+			}
+			if _, err := houses.ChangeHousePrice(ctx, &housepb.ChangeHousePriceRequest{Id: a, Price: 6000000}); err != nil {
+				t.Fatalf("ChangeHousePrice() error = %v", err)
+			}
+			got, err := notifications.ListNotifications(ctx, &userpb.ListNotificationsRequest{UserId: 7})
+			if err != nil {
+				t.Fatalf("ListNotifications() error = %v", err)
+			}
+			if len(got.Notifications) != 1 {
+				t.Errorf("ListNotifications() = %v, want 1 before the favorite was removed", got.Notifications)
+			}
+			house, err := getHouse(ctx, &housepb.GetHouseRequest{Id: a})
+			if err != nil {
+				t.Fatalf("GetHouse() error = %v", err)
+			}
+			if house.House.FavoriteCount != 1 {
+				t.Errorf("FavoriteCount = %d, want 1 after removal", house.House.FavoriteCount)
+			}
+		})
+
+		t.Run("browse history", func(t *testing.T) {
+			for _, id := range []uint64{a, b, a} {
+				if _, err := getHouse(ctx, &housepb.GetHouseRequest{Id: id, UserId: 9}); err != nil {
+					t.Fatalf("GetHouse() error = %v", err)
+				}
+			}
+			reply, err := listHistory(ctx, &pb.ListBrowseHistoryRequest{UserId: 9})
+			if err != nil {
+				t.Fatalf("ListBrowseHistory() error = %v", err)
+			}
+			if len(reply.Records) != 2 || reply.Records[0].House.Id != a || reply.Records[1].House.Id != b {
+				t.Errorf("ListBrowseHistory() = %v, want houses %d, %d", reply.Records, a, b)
+			}
+			reply, err = listHistory(ctx, &pb.ListBrowseHistoryRequest{UserId: 9, Limit: 1})
+			if err != nil {
+				t.Fatalf("ListBrowseHistory() error = %v", err)
+			}
+			if len(reply.Records) != 1 {
+				t.Errorf("ListBrowseHistory(limit 1) = %v, want 1 record", reply.Records)
+			}
+			if reply, err := listHistory(ctx, &pb.ListBrowseHistoryRequest{UserId: 7}); err != nil || len(reply.Records) != 0 {
+				t.Errorf("ListBrowseHistory(user 7) = %v, %v, want none for anonymous views", reply, err)
+			}
+		})
+	})
+}
@@ -3,21 +3,385 @@ package service
 import (
 	"anjuke/internal/biz"
 	"context"
+	"time"
 
 	pb "anjuke/api/house/v3"
 )
 
 type HouseService struct {
 	pb.UnimplementedHouseServer
-	v3uc *biz.HouseUsecase
+	v3uc       *biz.HouseUsecase
+	search     *biz.SearchUsecase
+	suggest    *biz.SuggestUsecase
+	price      *biz.PriceUsecase
+	favorite   *biz.FavoriteUsecase
+	compare    *biz.CompareUsecase
+	valuation  *biz.ValuationUsecase
+	moderation *biz.ModerationUsecase
 }
 
-func NewHouseService(v3uc *biz.HouseUsecase) *HouseService {
+func NewHouseService(v3uc *biz.HouseUsecase, search *biz.SearchUsecase, suggest *biz.SuggestUsecase, price *biz.PriceUsecase, favorite *biz.FavoriteUsecase, compare *biz.CompareUsecase, valuation *biz.ValuationUsecase, moderation *biz.ModerationUsecase) *HouseService {
 	return &HouseService{
-		v3uc: v3uc,
+		v3uc:       v3uc,
+		search:     search,
+		suggest:    suggest,
+		price:      price,
+		favorite:   favorite,
+		compare:    compare,
+		valuation:  valuation,
+		moderation: moderation,
 	}
 }
 
 func (s *HouseService) CreateHouse(ctx context.Context, req *pb.CreateHouseRequest) (*pb.CreateHouseReply, error) {
-	return &pb.CreateHouseReply{}, nil
+	in := req.GetHouse()
+	from, err := biz.ParseLeaseDate(in.GetAvailableFrom())
+	if err != nil {
+		return nil, biz.ErrRentInvalid
+	}
+	var availableFrom *time.Time
+	if !from.IsZero() {
+		availableFrom = &from
+	}
+	h, err := s.v3uc.CreateHouse(ctx, &biz.House{
+		OwnerID:       uint(in.GetOwnerId()),
+		ListingType:   in.GetListingType(),
+		Title:         in.GetTitle(),
+		Description:   in.GetDescription(),
+		City:          in.GetCity(),
+		District:      in.GetDistrict(),
+		RegionID:      uint(in.GetRegionId()),
+		CommunityID:   uint(in.GetCommunityId()),
+		CommunityName: in.GetCommunityName(),
+		BuildingID:    uint(in.GetBuildingId()),
+		Rooms:         in.GetRooms(),
+		Halls:         in.GetHalls(),
+		Baths:         in.GetBaths(),
+		Area:          in.GetArea(),
+		Floor:         in.GetFloor(),
+		TotalFloors:   in.GetTotalFloors(),
+		Orientation:   in.GetOrientation(),
+		BuildYear:     in.GetBuildYear(),
+		Price:         in.GetPrice(),
+		Tags:          in.GetTags(),
+		Images:        in.GetImages(),
+		MonthlyRent:   in.GetMonthlyRent(),
+		DepositMonths: in.GetDepositMonths(),
+		PayMonths:     in.GetPayMonths(),
+		RentalMode:    in.GetRentalMode(),
+		ParentID:      uint(in.GetParentId()),
+		RoomName:      in.GetRoomName(),
+		Utilities:     in.GetUtilities(),
+		AvailableFrom: availableFrom,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateHouseReply{House: houseInfo(h)}, nil
+}
+
+func (s *HouseService) GetHouse(ctx context.Context, req *pb.GetHouseRequest) (*pb.GetHouseReply, error) {
+	h, err := s.v3uc.GetHouse(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	s.favorite.RecordView(ctx, uint(req.UserId), h.ID)
+	counts, err := s.favorite.FavoriteCounts(ctx, h.ID)
+	if err != nil {
+		return nil, err
+	}
+	rooms, err := s.v3uc.ListRooms(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	info := houseInfo(h)
+	info.FavoriteCount = counts[h.ID]
+	reply := &pb.GetHouseReply{House: info}
+	for _, r := range rooms {
+		reply.Rooms = append(reply.Rooms, houseInfo(r))
+	}
+	return reply, nil
+}
+
+func (s *HouseService) SearchHouses(ctx context.Context, req *pb.SearchHousesRequest) (*pb.SearchHousesReply, error) {
+	res, err := s.search.SearchHouses(ctx, &biz.HouseQuery{
+		Keyword:     req.Keyword,
+		RegionID:    uint(req.RegionId),
+		ListingType: req.ListingType,
+		Rooms:       req.Rooms,
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		Page:        int(req.Page),
+		PageSize:    int(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint, 0, len(res.Houses))
+	for _, h := range res.Houses {
+		ids = append(ids, h.ID)
+	}
+	counts, err := s.favorite.FavoriteCounts(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.SearchHousesReply{Total: res.Total}
+	for i, h := range res.Houses {
+		info := houseInfo(h)
+		info.FavoriteCount = counts[h.ID]
+		reply.Hits = append(reply.Hits, &pb.HouseHit{House: info, Highlights: res.Hits[i].Highlights})
+	}
+	return reply, nil
+}
+
+func (s *HouseService) Suggest(ctx context.Context, req *pb.SuggestRequest) (*pb.SuggestReply, error) {
+	list, err := s.suggest.Suggest(ctx, req.Keyword, req.City, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.SuggestReply{}
+	for _, sg := range list {
+		reply.Suggestions = append(reply.Suggestions, &pb.SuggestionInfo{
+			Kind:     sg.Kind,
+			Text:     sg.Text,
+			Id:       uint64(sg.ID),
+			RegionId: uint64(sg.RegionID),
+			City:     sg.City,
+			Hint:     sg.Hint,
+		})
+	}
+	return reply, nil
+}
+
+func (s *HouseService) ChangeHousePrice(ctx context.Context, req *pb.ChangeHousePriceRequest) (*pb.ChangeHousePriceReply, error) {
+	h, err := s.v3uc.ChangePrice(ctx, uint(req.Id), req.Price)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChangeHousePriceReply{House: houseInfo(h)}, nil
+}
+
+func (s *HouseService) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryReply, error) {
+	list, err := s.price.GetPriceHistory(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.GetPriceHistoryReply{}
+	for _, c := range list {
+		reply.Changes = append(reply.Changes, &pb.PriceChangeInfo{
+			OldPrice:  c.OldPrice,
+			NewPrice:  c.NewPrice,
+			ChangedAt: c.CreatedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+func (s *HouseService) SetPriceAlert(ctx context.Context, req *pb.SetPriceAlertRequest) (*pb.SetPriceAlertReply, error) {
+	a, err := s.price.SetPriceAlert(ctx, uint(req.UserId), uint(req.HouseId), req.DropPercent)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return &pb.SetPriceAlertReply{}, nil
+	}
+	return &pb.SetPriceAlertReply{DropPercent: a.DropPercent, BasePrice: a.BasePrice}, nil
+}
+
+func (s *HouseService) CompareHouses(ctx context.Context, req *pb.CompareHousesRequest) (*pb.CompareHousesReply, error) {
+	ids := make([]uint, 0, len(req.HouseIds))
+	for _, id := range req.HouseIds {
+		ids = append(ids, uint(id))
+	}
+	cmp, err := s.compare.CompareHouses(ctx, uint(req.UserId), ids)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.CompareHousesReply{}
+	for _, h := range cmp.Houses {
+		reply.Houses = append(reply.Houses, houseInfo(h))
+	}
+	for _, r := range cmp.Rows {
+		row := &pb.CompareRow{Key: r.Key, Label: r.Label, Values: r.Values}
+		for _, i := range r.Best {
+			row.Best = append(row.Best, int32(i))
+		}
+		reply.Rows = append(reply.Rows, row)
+	}
+	return reply, nil
+}
+
+func (s *HouseService) AddCompareHouse(ctx context.Context, req *pb.CompareBasketRequest) (*pb.CompareBasketReply, error) {
+	ids, err := s.compare.AddToBasket(ctx, uint(req.UserId), uint(req.HouseId))
+	if err != nil {
+		return nil, err
+	}
+	return compareBasketReply(ids), nil
+}
+
+func (s *HouseService) RemoveCompareHouse(ctx context.Context, req *pb.CompareBasketRequest) (*pb.CompareBasketReply, error) {
+	ids, err := s.compare.RemoveFromBasket(ctx, uint(req.UserId), uint(req.HouseId))
+	if err != nil {
+		return nil, err
+	}
+	return compareBasketReply(ids), nil
+}
+
+func (s *HouseService) GetCompareBasket(ctx context.Context, req *pb.GetCompareBasketRequest) (*pb.CompareBasketReply, error) {
+	ids, err := s.compare.Basket(ctx, uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	return compareBasketReply(ids), nil
+}
+
+func (s *HouseService) EstimatePrice(ctx context.Context, req *pb.EstimatePriceRequest) (*pb.EstimatePriceReply, error) {
+	v, err := s.valuation.EstimatePrice(ctx, &biz.ValuationRequest{
+		HouseID:     uint(req.HouseId),
+		CommunityID: uint(req.CommunityId),
+		City:        req.City,
+		District:    req.District,
+		Area:        req.Area,
+		Floor:       req.Floor,
+		TotalFloors: req.TotalFloors,
+		Orientation: req.Orientation,
+		BuildYear:   req.BuildYear,
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.EstimatePriceReply{
+		Price:      v.Price,
+		UnitPrice:  v.UnitPrice,
+		Low:        v.Low,
+		High:       v.High,
+		Confidence: v.Confidence,
+		Scope:      v.Scope,
+	}
+	for _, c := range v.Comparables {
+		reply.Comparables = append(reply.Comparables, &pb.Comparable{
+			Source:            c.Source,
+			HouseId:           uint64(c.HouseID),
+			Area:              c.Area,
+			Floor:             c.Floor,
+			TotalFloors:       c.TotalFloors,
+			Orientation:       c.Orientation,
+			BuildYear:         c.BuildYear,
+			Price:             c.Price,
+			UnitPrice:         c.UnitPrice,
+			AdjustedUnitPrice: c.AdjustedUnitPrice,
+			Weight:            c.Weight,
+			Date:              c.Date.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+func (s *HouseService) ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueRequest) (*pb.ListModerationQueueReply, error) {
+	list, total, err := s.moderation.ListQueue(ctx, req.Status, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListModerationQueueReply{Total: total}
+	for _, m := range list {
+		reply.Items = append(reply.Items, moderationItem(m))
+	}
+	return reply, nil
+}
+
+func (s *HouseService) ReviewListing(ctx context.Context, req *pb.ReviewListingRequest) (*pb.ReviewListingReply, error) {
+	m, err := s.moderation.Review(ctx, uint(req.Id), uint(req.ReviewerId), req.Approve, req.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReviewListingReply{Item: moderationItem(m)}, nil
+}
+
+func (s *HouseService) CheckListing(ctx context.Context, req *pb.CheckListingRequest) (*pb.CheckListingReply, error) {
+	if _, err := s.v3uc.GetHouse(ctx, uint(req.HouseId)); err != nil {
+		return nil, err
+	}
+	m, err := s.moderation.Check(ctx, uint(req.HouseId))
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return &pb.CheckListingReply{}, nil
+	}
+	return &pb.CheckListingReply{Queued: true, Item: moderationItem(m)}, nil
+}
+
+func moderationItem(m *biz.ModerationItem) *pb.ModerationItem {
+	item := &pb.ModerationItem{
+		Id:         uint64(m.ID),
+		HouseId:    uint64(m.HouseID),
+		Status:     m.Status,
+		Score:      m.Score,
+		ReviewerId: uint64(m.ReviewerID),
+		Note:       m.Note,
+		CreatedAt:  m.CreatedAt.Unix(),
+	}
+	if m.ReviewedAt != nil {
+		item.ReviewedAt = m.ReviewedAt.Unix()
+	}
+	if m.House != nil {
+		item.House = houseInfo(m.House)
+	}
+	for _, r := range m.Reasons {
+		reason := &pb.ModerationReason{Kind: r.Kind, Detail: r.Detail, Score: r.Score}
+		for _, id := range r.HouseIDs {
+			reason.HouseIds = append(reason.HouseIds, uint64(id))
+		}
+		item.Reasons = append(item.Reasons, reason)
+	}
+	return item
+}
+
+func compareBasketReply(ids []uint) *pb.CompareBasketReply {
+	reply := &pb.CompareBasketReply{}
+	for _, id := range ids {
+		reply.HouseIds = append(reply.HouseIds, uint64(id))
+	}
+	return reply
+}
+
+func houseInfo(h *biz.House) *pb.HouseInfo {
+	info := &pb.HouseInfo{
+		Id:            uint64(h.ID),
+		OwnerId:       uint64(h.OwnerID),
+		ListingType:   h.ListingType,
+		Title:         h.Title,
+		Description:   h.Description,
+		City:          h.City,
+		District:      h.District,
+		CommunityName: h.CommunityName,
+		Rooms:         h.Rooms,
+		Halls:         h.Halls,
+		Baths:         h.Baths,
+		Area:          h.Area,
+		Floor:         h.Floor,
+		TotalFloors:   h.TotalFloors,
+		Orientation:   h.Orientation,
+		BuildYear:     h.BuildYear,
+		Price:         h.Price,
+		UnitPrice:     h.UnitPrice,
+		Status:        h.Status,
+		CommunityId:   uint64(h.CommunityID),
+		RegionId:      uint64(h.RegionID),
+		Tags:          h.Tags,
+		BuildingId:    uint64(h.BuildingID),
+		Images:        h.Images,
+		MonthlyRent:   h.MonthlyRent,
+		DepositMonths: h.DepositMonths,
+		PayMonths:     h.PayMonths,
+		PaymentCycle:  h.PaymentCycle(),
+		RentalMode:    h.RentalMode,
+		ParentId:      uint64(h.ParentID),
+		RoomName:      h.RoomName,
+		Utilities:     h.Utilities,
+	}
+	if h.AvailableFrom != nil {
+		info.AvailableFrom = h.AvailableFrom.Format(biz.LeaseDateLayout)
+	}
+	return info
 }
@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "anjuke/api/house/v3"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestHouseService(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		createHouse, getHouse := call(pb.NewHouseClient(env.GRPC).CreateHouse), call(pb.NewHouseClient(env.GRPC).GetHouse)
+		if transport == "http" {
+			createHouse, getHouse = call(pb.NewHouseHTTPClient(env.HTTP).CreateHouse), call(pb.NewHouseHTTPClient(env.HTTP).GetHouse)
+		}
+		ctx := context.Background()
+
+		tests := []struct {
+			name          string
+			house         *pb.HouseInfo
+			wantReason    string
+			wantUnitPrice int64
+		}{
+			{"ok", &pb.HouseInfo{Title: "万科城市花园 2室1厅", City: "上海", District: "浦东", Rooms: 2, Area: 80, Price: 5200000}, "", 65000},
+			{"zero area", &pb.HouseInfo{Title: "无面积", Price: 1000000}, "HOUSE_INVALID", 0},
+			{"zero price", &pb.HouseInfo{Title: "无价格", Area: 50}, "HOUSE_INVALID", 0},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := createHouse(ctx, &pb.CreateHouseRequest{House: tt.house})
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("CreateHouse() error = %v, want reason %s", err, tt.wantReason)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("CreateHouse() error = %v", err)
+				}
+				if reply.House.UnitPrice != tt.wantUnitPrice {
+					t.Errorf("UnitPrice = %d, want %d", reply.House.UnitPrice, tt.wantUnitPrice)
+				}
+
+				got, err := getHouse(ctx, &pb.GetHouseRequest{Id: reply.House.Id})
+				if err != nil {
+					t.Fatalf("GetHouse() error = %v", err)
+				}
+				if got.House.Title != tt.house.Title || got.House.Status != 0 {
+					t.Errorf("GetHouse() = %v", got.House)
+				}
+			})
+		}
+
+		t.Run("not found", func(t *testing.T) {
+			_, err := getHouse(ctx, &pb.GetHouseRequest{Id: 404})
+			if !errors.IsNotFound(err) || errors.Reason(err) != "HOUSE_NOT_FOUND" {
+				t.Errorf("GetHouse() error = %v, want HOUSE_NOT_FOUND", err)
+			}
+		})
+	})
+}
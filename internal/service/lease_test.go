@@ -0,0 +1,208 @@
+package service_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	housepb "anjuke/api/house/v3"
+	pb "anjuke/api/transaction/v4"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestTransactionService_Lease(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		client := pb.NewTransactionClient(env.GRPC)
+		sign, get, list, renew, terminate := call(client.SignLease), call(client.GetLease), call(client.ListLeases), call(client.RenewLease), call(client.TerminateLease)
+		if transport == "http" {
+			client := pb.NewTransactionHTTPClient(env.HTTP)
+			sign, get, list, renew, terminate = call(client.SignLease), call(client.GetLease), call(client.ListLeases), call(client.RenewLease), call(client.TerminateLease)
+		}
+		ctx := context.Background()
+
+		houses := housepb.NewHouseClient(env.GRPC)
+		var ids []uint64
+		for _, h := range []*housepb.HouseInfo{
+			{Title: "徐汇 一室户", OwnerId: 5, City: "上海", District: "徐汇", ListingType: 1, Area: 40, MonthlyRent: 6500, DepositMonths: 1, PayMonths: 3},
+			{Title: "静安 两室", OwnerId: 5, City: "上海", District: "静安", ListingType: 1, Area: 70, MonthlyRent: 9000, DepositMonths: 2},
+			{Title: "浦东 三室", OwnerId: 6, City: "上海", District: "浦东", Area: 110, Price: 8000000},
+		} {
+			reply, err := houses.CreateHouse(ctx, &housepb.CreateHouseRequest{House: h})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			ids = append(ids, reply.House.Id)
+		}
+		houseStatus := func(id uint64) *housepb.HouseInfo {
+			reply, err := houses.GetHouse(ctx, &housepb.GetHouseRequest{Id: id})
+			if err != nil {
+				t.Fatalf("GetHouse() error = %v", err)
+			}
+			return reply.House
+		}
+
+		t.Run("schedule", func(t *testing.T) {
+			// 月末起租：每月的计租日不超过当月最后一天
+			reply, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[0], TenantId: 7, StartDate: "2025-01-31", Months: 7})
+			if err != nil {
+				t.Fatalf("SignLease() error = %v", err)
+			}
+			l := reply.Lease
+			if l.LandlordId != 5 || l.MonthlyRent != 6500 || l.Deposit != 6500 || l.PayMonths != 3 || l.EndDate != "2025-08-31" {
+				t.Errorf("SignLease() = %v, want the listing's terms", l)
+			}
+			want := []*pb.RentPeriod{
+				{Seq: 1, Start: "2025-01-31", End: "2025-04-30", DueDate: "2025-01-31", Amount: 19500},
+				{Seq: 2, Start: "2025-04-30", End: "2025-07-31", DueDate: "2025-04-30", Amount: 19500},
+				{Seq: 3, Start: "2025-07-31", End: "2025-08-31", DueDate: "2025-07-31", Amount: 6500},
+			}
+			if len(l.Schedule) != len(want) {
+				t.Fatalf("schedule = %v, want %v", l.Schedule, want)
+			}
+			for i, p := range l.Schedule {
+				if p.Seq != want[i].Seq || p.Start != want[i].Start || p.End != want[i].End || p.DueDate != want[i].DueDate || p.Amount != want[i].Amount {
+					t.Errorf("period %d = %v, want %v", i+1, p, want[i])
+				}
+			}
+			if h := houseStatus(ids[0]); h.Status != 3 {
+				t.Errorf("house status = %d, want rented", h.Status)
+			}
+			got, err := get(ctx, &pb.GetLeaseRequest{Id: l.Id})
+			if err != nil {
+				t.Fatalf("GetLease() error = %v", err)
+			}
+			if got.Lease.Status != 1 {
+				t.Errorf("GetLease() status = %d, want expired", got.Lease.Status)
+			}
+		})
+
+		now := time.Now()
+		start := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.Local)
+		date := func(t time.Time) string { return t.Format("2006-01-02") }
+		signed, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[1], TenantId: 8, StartDate: date(start), Months: 12, MonthlyRent: 8800})
+		if err != nil {
+			t.Fatalf("SignLease() error = %v", err)
+		}
+		lease := signed.Lease
+		if lease.Status != 0 || lease.Deposit != 17600 || lease.PayMonths != 1 || len(lease.Schedule) != 12 || lease.EndDate != date(start.AddDate(1, 0, 0)) {
+			t.Errorf("SignLease() = %v, want 12 monthly payments at the agreed rent", lease)
+		}
+
+		t.Run("renew", func(t *testing.T) {
+			reply, err := renew(ctx, &pb.RenewLeaseRequest{Id: lease.Id, Months: 6, MonthlyRent: 9200})
+			if err != nil {
+				t.Fatalf("RenewLease() error = %v", err)
+			}
+			r := reply.Lease
+			if r.RenewedFrom != lease.Id || r.StartDate != lease.EndDate || r.Months != 6 || r.MonthlyRent != 9200 || r.Deposit != 18400 || r.TenantId != 8 {
+				t.Errorf("RenewLease() = %v, want 6 more months from %s", r, lease.EndDate)
+			}
+			if _, err := renew(ctx, &pb.RenewLeaseRequest{Id: lease.Id, Months: 6}); errors.Reason(err) != "LEASE_STATE" {
+				t.Errorf("RenewLease() twice error = %v, want LEASE_STATE", err)
+			}
+			if _, err := terminate(ctx, &pb.TerminateLeaseRequest{Id: lease.Id}); errors.Reason(err) != "LEASE_STATE" {
+				t.Errorf("TerminateLease() of a renewed lease error = %v, want LEASE_STATE", err)
+			}
+			leases, err := list(ctx, &pb.ListLeasesRequest{TenantId: 8})
+			if err != nil {
+				t.Fatalf("ListLeases() error = %v", err)
+			}
+			if len(leases.Leases) != 2 || leases.Leases[0].Id != r.Id || leases.Leases[1].RenewalId != r.Id {
+				t.Errorf("ListLeases() = %v, want the renewal first", leases.Leases)
+			}
+
+			// 在续租起始日解约即取消续租，房源在原租约到期后可租
+			canceled, err := terminate(ctx, &pb.TerminateLeaseRequest{Id: r.Id, Date: r.StartDate, Reason: "不再续租"})
+			if err != nil {
+				t.Fatalf("TerminateLease() error = %v", err)
+			}
+			if canceled.Lease.Status != 2 || len(canceled.Lease.Schedule) != 0 {
+				t.Errorf("TerminateLease() = %v, want terminated before any rent", canceled.Lease)
+			}
+			if h := houseStatus(ids[1]); h.Status != 0 || h.AvailableFrom != lease.EndDate {
+				t.Errorf("house = status %d from %q, want on the market from %s", h.Status, h.AvailableFrom, lease.EndDate)
+			}
+		})
+
+		t.Run("terminate", func(t *testing.T) {
+			end := start.AddDate(0, 2, 10)
+			reply, err := terminate(ctx, &pb.TerminateLeaseRequest{Id: lease.Id, Date: date(end), Penalty: 8800, Reason: "工作调动"})
+			if err != nil {
+				t.Fatalf("TerminateLease() error = %v", err)
+			}
+			l := reply.Lease
+			if l.Status != 2 || l.TerminatedAt != date(end) || l.Penalty != 8800 || l.TerminateReason != "工作调动" {
+				t.Errorf("TerminateLease() = %v", l)
+			}
+			third := start.AddDate(0, 2, 0)
+			days := third.AddDate(0, 1, 0).Sub(third).Hours() / 24
+			partial := int64(math.Round(8800 * 10 / days))
+			if len(l.Schedule) != 3 || l.Schedule[2].End != date(end) || l.Schedule[2].Amount != partial {
+				t.Errorf("schedule = %v, want the third month charged %d for 10 days", l.Schedule, partial)
+			}
+			if h := houseStatus(ids[1]); h.Status != 0 || h.AvailableFrom != date(end) {
+				t.Errorf("house = status %d from %q, want on the market from %s", h.Status, h.AvailableFrom, date(end))
+			}
+			if _, err := terminate(ctx, &pb.TerminateLeaseRequest{Id: lease.Id}); errors.Reason(err) != "LEASE_STATE" {
+				t.Errorf("TerminateLease() twice error = %v, want LEASE_STATE", err)
+			}
+		})
+
+		tests := []struct {
+			name       string
+			do         func() error
+			wantReason string
+		}{
+			{"sale listing", func() error {
+				_, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[2], TenantId: 8, StartDate: date(start), Months: 12})
+				return err
+			}, "LEASE_INVALID"},
+			{"rented listing", func() error {
+				_, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[0], TenantId: 9, StartDate: date(start), Months: 12})
+				return err
+			}, "HOUSE_NOT_ON_SALE"},
+			{"no term", func() error {
+				_, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[1], TenantId: 9, StartDate: date(start)})
+				return err
+			}, "LEASE_INVALID"},
+			{"malformed date", func() error {
+				_, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[1], TenantId: 9, StartDate: "2025/07/01", Months: 12})
+				return err
+			}, "LEASE_INVALID"},
+			{"renew expired", func() error {
+				leases, err := list(ctx, &pb.ListLeasesRequest{HouseId: ids[0]})
+				if err != nil {
+					return err
+				}
+				_, err = renew(ctx, &pb.RenewLeaseRequest{Id: leases.Leases[0].Id, Months: 12})
+				return err
+			}, "LEASE_STATE"},
+			{"terminate after end", func() error {
+				l, err := sign(ctx, &pb.SignLeaseRequest{HouseId: ids[1], TenantId: 9, StartDate: date(start), Months: 3})
+				if err != nil {
+					return err
+				}
+				_, err = terminate(ctx, &pb.TerminateLeaseRequest{Id: l.Lease.Id, Date: date(start.AddDate(0, 3, 0))})
+				return err
+			}, "LEASE_INVALID"},
+			{"list without filter", func() error {
+				_, err := list(ctx, &pb.ListLeasesRequest{})
+				return err
+			}, "LEASE_INVALID"},
+			{"unknown lease", func() error {
+				_, err := get(ctx, &pb.GetLeaseRequest{Id: 404})
+				return err
+			}, "LEASE_NOT_FOUND"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.do(); errors.Reason(err) != tt.wantReason {
+					t.Errorf("error = %v, want reason %s", err, tt.wantReason)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package service_test
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	communitypb "anjuke/api/community/v7"
+	pb "anjuke/api/house/v3"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// photoServer serves generated listing photos: /a.png and its smaller copy
+// /a-small.png look alike, /b.png is a different picture.
+func photoServer(t *testing.T) *httptest.Server {
+	gradient := func(w, h int, fade bool) image.Image {
+		img := image.NewGray(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				v := x * 200 / w
+				if fade {
+					v = 200 - v
+				}
+				img.SetGray(x, y, color.Gray{Y: uint8(v + y*50/h)})
+			}
+		}
+		return img
+	}
+	photos := map[string]image.Image{
+		"/a.png":       gradient(320, 240, true),
+		"/a-small.png": gradient(160, 120, true),
+		"/b.png":       gradient(320, 240, false),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		img, ok := photos[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		png.Encode(w, img)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHouseService_Moderation(t *testing.T) {
+	photos := photoServer(t)
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		client := pb.NewHouseClient(env.GRPC)
+		list, review, check := call(client.ListModerationQueue), call(client.ReviewListing), call(client.CheckListing)
+		if transport == "http" {
+			client := pb.NewHouseHTTPClient(env.HTTP)
+			list, review, check = call(client.ListModerationQueue), call(client.ReviewListing), call(client.CheckListing)
+		}
+		ctx := context.Background()
+
+		communities := communitypb.NewCommunityClient(env.GRPC)
+		var communityIDs []uint64
+		for _, name := range []string{"仁恒河滨城", "世茂滨江花园"} {
+			reply, err := communities.CreateCommunity(ctx, &communitypb.CreateCommunityRequest{Community: &communitypb.CommunityInfo{
+				Name: name, City: "上海", District: "浦东", BuildYear: 2010,
+			}})
+			if err != nil {
+				t.Fatalf("CreateCommunity() error = %v", err)
+			}
+			communityIDs = append(communityIDs, reply.Community.Id)
+		}
+		building, err := communities.CreateBuilding(ctx, &communitypb.CreateBuildingRequest{Building: &communitypb.BuildingInfo{
+			CommunityId: communityIDs[0], Name: "3号楼", Floors: 30,
+		}})
+		if err != nil {
+			t.Fatalf("CreateBuilding() error = %v", err)
+		}
+		buildingID := building.Building.Id
+
+		houses := pb.NewHouseClient(env.GRPC)
+		var ids []uint64
+		for _, h := range []*pb.HouseInfo{
+			{Title: "河滨城 两室", OwnerId: 1, BuildingId: buildingID, Floor: 5, Rooms: 2, Halls: 1, Baths: 1, Area: 90, Price: 8550000, Images: []string{photos.URL + "/b.png"}},
+			{Title: "河滨城 三室", OwnerId: 1, BuildingId: buildingID, Floor: 8, Rooms: 3, Halls: 2, Baths: 2, Area: 100, Price: 9500000},
+			{Title: "河滨城 小两室", OwnerId: 1, BuildingId: buildingID, Floor: 12, Rooms: 2, Halls: 1, Baths: 1, Area: 80, Price: 8000000, Images: []string{photos.URL + "/a.png"}},
+			// 另一个经纪人发布同一套房，面积四舍五入后相同
+			{Title: "急售 河滨城两室", OwnerId: 2, BuildingId: buildingID, Floor: 5, Rooms: 2, Halls: 1, Baths: 1, Area: 90.3, Price: 8600000, Images: []string{photos.URL + "/b.png"}},
+			// 低价引流，盗用第三套的图片
+			{Title: "捡漏 河滨城两室", OwnerId: 3, BuildingId: buildingID, Floor: 15, Rooms: 2, Halls: 1, Baths: 1, Area: 90, Price: 4500000,
+				Images: []string{photos.URL + "/a-small.png", photos.URL + "/missing.png"}},
+		} {
+			h.CommunityId = communityIDs[0]
+			reply, err := houses.CreateHouse(ctx, &pb.CreateHouseRequest{House: h})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			ids = append(ids, reply.House.Id)
+		}
+
+		queue, err := list(ctx, &pb.ListModerationQueueRequest{})
+		if err != nil {
+			t.Fatalf("ListModerationQueue() error = %v", err)
+		}
+		if queue.Total != 2 || len(queue.Items) != 2 {
+			t.Fatalf("ListModerationQueue() = %v, want the duplicate and the bait", queue.Items)
+		}
+		dup, bait := queue.Items[0], queue.Items[1]
+		if dup.HouseId != ids[3] || len(dup.Reasons) != 1 || dup.Reasons[0].Kind != "duplicate" || len(dup.Reasons[0].HouseIds) != 1 || dup.Reasons[0].HouseIds[0] != ids[0] {
+			t.Errorf("first item = %v, want house %d duplicating %d", dup, ids[3], ids[0])
+		}
+		if dup.House.GetId() != ids[3] || dup.Status != 0 {
+			t.Errorf("first item house = %v, status %d, want the pending listing", dup.House, dup.Status)
+		}
+		kinds := map[string]*pb.ModerationReason{}
+		for _, r := range bait.Reasons {
+			kinds[r.Kind] = r
+		}
+		if bait.HouseId != ids[4] || len(kinds) != 2 || kinds["price"] == nil || kinds["image"] == nil {
+			t.Fatalf("second item = %v, want house %d flagged for price and image", bait, ids[4])
+		}
+		if img := kinds["image"]; len(img.HouseIds) != 1 || img.HouseIds[0] != ids[2] {
+			t.Errorf("image reason = %v, want similar to house %d", img, ids[2])
+		}
+		var sum int32
+		for _, r := range bait.Reasons {
+			sum += r.Score
+		}
+		if sum > 100 {
+			sum = 100
+		}
+		if bait.Score != sum || bait.Score <= dup.Score {
+			t.Errorf("scores = %d and %d, want the bait scored higher, as the sum of its reasons up to 100", bait.Score, dup.Score)
+		}
+
+		t.Run("review", func(t *testing.T) {
+			reply, err := review(ctx, &pb.ReviewListingRequest{Id: bait.Id, ReviewerId: 9, Note: "虚假低价"})
+			if err != nil {
+				t.Fatalf("ReviewListing() error = %v", err)
+			}
+			if reply.Item.Status != 2 || reply.Item.ReviewerId != 9 || reply.Item.ReviewedAt == 0 {
+				t.Errorf("ReviewListing() = %v, want rejected by 9", reply.Item)
+			}
+			h, err := houses.GetHouse(ctx, &pb.GetHouseRequest{Id: ids[4]})
+			if err != nil {
+				t.Fatalf("GetHouse() error = %v", err)
+			}
+			if h.House.Status != 2 {
+				t.Errorf("rejected house status = %d, want offline", h.House.Status)
+			}
+			if _, err := review(ctx, &pb.ReviewListingRequest{Id: dup.Id, ReviewerId: 9, Approve: true}); err != nil {
+				t.Fatalf("ReviewListing() error = %v", err)
+			}
+
+			queue, err := list(ctx, &pb.ListModerationQueueRequest{})
+			if err != nil {
+				t.Fatalf("ListModerationQueue() error = %v", err)
+			}
+			if queue.Total != 0 {
+				t.Errorf("pending = %v, want none", queue.Items)
+			}
+			rejected, err := list(ctx, &pb.ListModerationQueueRequest{Status: 2})
+			if err != nil {
+				t.Fatalf("ListModerationQueue() error = %v", err)
+			}
+			if rejected.Total != 1 || rejected.Items[0].HouseId != ids[4] {
+				t.Errorf("rejected = %v, want house %d", rejected.Items, ids[4])
+			}
+		})
+
+		t.Run("check", func(t *testing.T) {
+			// 已通过的重复房源不再入队
+			reply, err := check(ctx, &pb.CheckListingRequest{HouseId: ids[3]})
+			if err != nil {
+				t.Fatalf("CheckListing() error = %v", err)
+			}
+			if reply.Queued {
+				t.Errorf("CheckListing() = %v, want the approved duplicate left alone", reply.Item)
+			}
+			// 先发布的那套在重新检测时也会发现重复
+			reply, err = check(ctx, &pb.CheckListingRequest{HouseId: ids[0]})
+			if err != nil {
+				t.Fatalf("CheckListing() error = %v", err)
+			}
+			if !reply.Queued || reply.Item.Reasons[0].Kind != "duplicate" || reply.Item.Reasons[0].HouseIds[0] != ids[3] {
+				t.Errorf("CheckListing() = %v, want house %d duplicating %d", reply.Item, ids[0], ids[3])
+			}
+			// 下架的房源不再比较
+			reply, err = check(ctx, &pb.CheckListingRequest{HouseId: ids[4]})
+			if err != nil {
+				t.Fatalf("CheckListing() error = %v", err)
+			}
+			if reply.Queued {
+				t.Errorf("CheckListing() = %v, want the offline listing skipped", reply.Item)
+			}
+		})
+
+		tests := []struct {
+			name       string
+			do         func() error
+			wantReason string
+		}{
+			{"review twice", func() error {
+				_, err := review(ctx, &pb.ReviewListingRequest{Id: bait.Id, Approve: true})
+				return err
+			}, "MODERATION_REVIEWED"},
+			{"review unknown", func() error {
+				_, err := review(ctx, &pb.ReviewListingRequest{Id: bait.Id + 100, Approve: true})
+				return err
+			}, "MODERATION_NOT_FOUND"},
+			{"check unknown house", func() error {
+				_, err := check(ctx, &pb.CheckListingRequest{HouseId: ids[4] + 100})
+				return err
+			}, "HOUSE_NOT_FOUND"},
+			{"building of another community", func() error {
+				_, err := houses.CreateHouse(ctx, &pb.CreateHouseRequest{House: &pb.HouseInfo{
+					Title: "滨江花园 两室", CommunityId: communityIDs[1], BuildingId: buildingID, Area: 90, Price: 7000000,
+				}})
+				return err
+			}, "BUILDING_NOT_FOUND"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := tt.do(); errors.Reason(err) != tt.wantReason {
+					t.Errorf("error = %v, want reason %s", err, tt.wantReason)
+				}
+			})
+		}
+	})
+}
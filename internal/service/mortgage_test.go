@@ -0,0 +1,140 @@
+package service_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	pb "anjuke/api/transaction/v4"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestTransactionService_CalculateMortgage(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		calculate := call(pb.NewTransactionClient(env.GRPC).CalculateMortgage)
+		if transport == "http" {
+			calculate = call(pb.NewTransactionHTTPClient(env.HTTP).CalculateMortgage)
+		}
+		ctx := context.Background()
+
+		// 贷款100万、30年的常见算例：商贷4.9%，公积金3.25%
+		loan := func(r *pb.CalculateMortgageRequest) *pb.CalculateMortgageRequest {
+			r.Price, r.Area, r.Home, r.DownPaymentPercent, r.Years = 2000000, 90, 1, 50, 30
+			return r
+		}
+		tests := []struct {
+			name         string
+			req          *pb.CalculateMortgageRequest
+			wantReason   string
+			wantMonthly  float64 // 首月月供
+			wantSecond   float64 // 第二个月月供
+			wantInterest float64 // 利息总额
+		}{
+			{"commercial equal installment", loan(&pb.CalculateMortgageRequest{LoanType: 1, Method: 1, CommercialRate: 4.9}), "", 5307.27, 5307.27, 910616.19},
+			{"commercial equal principal", loan(&pb.CalculateMortgageRequest{LoanType: 1, Method: 2, CommercialRate: 4.9}), "", 6861.11, 6849.77, 737041.67},
+			{"fund equal installment", loan(&pb.CalculateMortgageRequest{LoanType: 2, Method: 1, FundRate: 3.25}), "", 4352.06, 4352.06, 566742.75},
+			{"combined", &pb.CalculateMortgageRequest{Price: 2500000, Area: 90, Home: 1, DownPaymentPercent: 20, LoanType: 3, FundAmount: 1000000,
+				Years: 30, Method: 1, CommercialRate: 4.9, FundRate: 3.25}, "", 9659.33, 9659.33, 1477358.94},
+			{"down payment too low", &pb.CalculateMortgageRequest{Price: 2000000, Area: 90, Home: 2, DownPaymentPercent: 20, LoanType: 1, Years: 30, Method: 1}, "DOWN_PAYMENT_TOO_LOW", 0, 0, 0},
+			{"fund over limit", &pb.CalculateMortgageRequest{Price: 5000000, Area: 90, Home: 1, LoanType: 2, Years: 30, Method: 1}, "FUND_LOAN_EXCEEDED", 0, 0, 0},
+			{"fund part over loan", &pb.CalculateMortgageRequest{Price: 1000000, Area: 90, Home: 1, LoanType: 3, FundAmount: 900000, Years: 30, Method: 1}, "FUND_LOAN_EXCEEDED", 0, 0, 0},
+			{"term too long", &pb.CalculateMortgageRequest{Price: 2000000, Area: 90, Home: 1, LoanType: 1, Years: 31, Method: 1}, "MORTGAGE_INVALID", 0, 0, 0},
+			{"no method", loan(&pb.CalculateMortgageRequest{LoanType: 1}), "MORTGAGE_INVALID", 0, 0, 0},
+			{"third home", &pb.CalculateMortgageRequest{Price: 2000000, Area: 90, Home: 3, LoanType: 1, Years: 30, Method: 1}, "MORTGAGE_INVALID", 0, 0, 0},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := calculate(ctx, tt.req)
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("CalculateMortgage() error = %v, want reason %s", err, tt.wantReason)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("CalculateMortgage() error = %v", err)
+				}
+				s := reply.Schedule
+				if len(s) != int(tt.req.Years)*12 {
+					t.Fatalf("schedule has %d months, want %d", len(s), tt.req.Years*12)
+				}
+				if reply.MonthlyPayment != tt.wantMonthly || s[0].Payment != tt.wantMonthly || s[1].Payment != tt.wantSecond {
+					t.Errorf("payments = %v, %v, want %v, %v", s[0].Payment, s[1].Payment, tt.wantMonthly, tt.wantSecond)
+				}
+				// 月供和每月利息取整到分，利息总额与不取整的公式结果相差不超过2元
+				if math.Abs(reply.TotalInterest-tt.wantInterest) > 2 {
+					t.Errorf("TotalInterest = %v, want about %v", reply.TotalInterest, tt.wantInterest)
+				}
+				var principal, interest float64
+				for _, p := range s {
+					principal += p.Principal
+					interest += p.Interest
+				}
+				loan := float64(reply.CommercialLoan + reply.FundLoan)
+				if math.Abs(principal-loan) > 0.01 || math.Abs(interest-reply.TotalInterest) > 0.01 || s[len(s)-1].Balance != 0 {
+					t.Errorf("schedule repays %v and %v interest, last balance %v, want %v and %v", principal, interest, s[len(s)-1].Balance, loan, reply.TotalInterest)
+				}
+				if math.Abs(reply.TotalPayment-loan-reply.TotalInterest) > 0.01 {
+					t.Errorf("TotalPayment = %v, want loan plus interest", reply.TotalPayment)
+				}
+			})
+		}
+
+		t.Run("defaults", func(t *testing.T) {
+			reply, err := calculate(ctx, &pb.CalculateMortgageRequest{Price: 2000000, Area: 90, Home: 2, LoanType: 3, Years: 30, Method: 1})
+			if err != nil {
+				t.Fatalf("CalculateMortgage() error = %v", err)
+			}
+			// 二套最低首付25%，公积金取上限160万，余下走商贷
+			if reply.DownPayment != 500000 || reply.FundLoan != 1500000 || reply.CommercialLoan != 0 {
+				t.Errorf("down payment %d, fund %d, commercial %d, want 500000, 1500000, 0", reply.DownPayment, reply.FundLoan, reply.CommercialLoan)
+			}
+			if reply.FundRate != 3.075 || reply.CommercialRate != 0 {
+				t.Errorf("rates = %v, %v, want the second-home fund rate only", reply.FundRate, reply.CommercialRate)
+			}
+		})
+
+		t.Run("fees", func(t *testing.T) {
+			fees := []struct {
+				name        string
+				req         *pb.CalculateMortgageRequest
+				want        map[string]float64
+				wantUpfront float64
+			}{
+				{"first home held two years", &pb.CalculateMortgageRequest{Price: 5000000, Area: 100, Home: 1, DownPaymentPercent: 100, LoanType: 1, Years: 30, Method: 1, YearsHeld: 2},
+					map[string]float64{"deed_tax": 50000, "agency_fee": 100000}, 5150000},
+				{"large second home held one year", &pb.CalculateMortgageRequest{Price: 5000000, Area: 150, Home: 2, DownPaymentPercent: 40, LoanType: 1, Years: 30, Method: 1, YearsHeld: 1},
+					map[string]float64{"deed_tax": 100000, "vat": 252380.95, "agency_fee": 100000}, 2452380.95},
+				{"large first home", &pb.CalculateMortgageRequest{Price: 5000000, Area: 150, Home: 1, LoanType: 1, Years: 30, Method: 1, YearsHeld: 5},
+					map[string]float64{"deed_tax": 75000, "agency_fee": 100000}, 925000},
+			}
+			for _, tt := range fees {
+				reply, err := calculate(ctx, tt.req)
+				if err != nil {
+					t.Fatalf("%s: CalculateMortgage() error = %v", tt.name, err)
+				}
+				got := map[string]float64{}
+				for _, f := range reply.Fees {
+					got[f.Key] = f.Amount
+				}
+				if len(got) != len(tt.want) {
+					t.Errorf("%s: fees = %v, want %v", tt.name, got, tt.want)
+				}
+				for k, v := range tt.want {
+					if got[k] != v {
+						t.Errorf("%s: %s = %v, want %v", tt.name, k, got[k], v)
+					}
+				}
+				if reply.UpfrontCost != tt.wantUpfront {
+					t.Errorf("%s: UpfrontCost = %v, want %v", tt.name, reply.UpfrontCost, tt.wantUpfront)
+				}
+			}
+			full, _ := calculate(ctx, fees[0].req)
+			if len(full.GetSchedule()) != 0 || full.GetMonthlyPayment() != 0 {
+				t.Errorf("full payment schedule = %d months, want none", len(full.GetSchedule()))
+			}
+		})
+	})
+}
@@ -19,5 +19,57 @@ func NewPointsService(v5uc *biz.PointsUsecase) *PointsService {
 }
 
 func (s *PointsService) CreatePoints(ctx context.Context, req *pb.CreatePointsRequest) (*pb.CreatePointsReply, error) {
-	return &pb.CreatePointsReply{}, nil
+	r, err := s.v5uc.Earn(ctx, &biz.PointsRecord{
+		UserID:  uint(req.UserId),
+		Amount:  req.Amount,
+		Reason:  req.Reason,
+		BizType: req.BizType,
+		BizID:   uint(req.BizId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreatePointsReply{Record: pointsRecord(r)}, nil
+}
+
+func (s *PointsService) RedeemPoints(ctx context.Context, req *pb.RedeemPointsRequest) (*pb.RedeemPointsReply, error) {
+	r, err := s.v5uc.Redeem(ctx, &biz.PointsRecord{
+		UserID:  uint(req.UserId),
+		Amount:  req.Amount,
+		Reason:  req.Reason,
+		BizType: req.BizType,
+		BizID:   uint(req.BizId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RedeemPointsReply{Record: pointsRecord(r)}, nil
+}
+
+func (s *PointsService) GetPoints(ctx context.Context, req *pb.GetPointsRequest) (*pb.GetPointsReply, error) {
+	balance, err := s.v5uc.Balance(ctx, uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.v5uc.ListRecords(ctx, uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.GetPointsReply{Balance: balance}
+	for _, r := range list {
+		reply.Records = append(reply.Records, pointsRecord(r))
+	}
+	return reply, nil
+}
+
+func pointsRecord(r *biz.PointsRecord) *pb.PointsRecord {
+	return &pb.PointsRecord{
+		Id:        uint64(r.ID),
+		Amount:    r.Amount,
+		Balance:   r.Balance,
+		Reason:    r.Reason,
+		BizType:   r.BizType,
+		BizId:     uint64(r.BizID),
+		CreatedAt: r.CreatedAt.Unix(),
+	}
 }
@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	pb "anjuke/api/points/v5"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestPointsService(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		createPoints, getPoints := call(pb.NewPointsClient(env.GRPC).CreatePoints), call(pb.NewPointsClient(env.GRPC).GetPoints)
+		if transport == "http" {
+			createPoints, getPoints = call(pb.NewPointsHTTPClient(env.HTTP).CreatePoints), call(pb.NewPointsHTTPClient(env.HTTP).GetPoints)
+		}
+		ctx := context.Background()
+
+		tests := []struct {
+			name        string
+			req         *pb.CreatePointsRequest
+			wantReason  string
+			wantBalance int64
+		}{
+			{"sign in", &pb.CreatePointsRequest{UserId: 7, Amount: 10, Reason: "签到"}, "", 10},
+			{"deal reward", &pb.CreatePointsRequest{UserId: 7, Amount: 1000, Reason: "成交奖励", BizType: "deal", BizId: 1}, "", 1010},
+			{"zero amount", &pb.CreatePointsRequest{UserId: 7, Reason: "签到"}, "POINTS_AMOUNT", 1010},
+			{"negative amount", &pb.CreatePointsRequest{UserId: 7, Amount: -5}, "POINTS_AMOUNT", 1010},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := createPoints(ctx, tt.req)
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("CreatePoints() error = %v, want reason %s", err, tt.wantReason)
+					}
+				} else if err != nil {
+					t.Fatalf("CreatePoints() error = %v", err)
+				} else if reply.Record.Balance != tt.wantBalance {
+					t.Errorf("record balance = %d, want %d", reply.Record.Balance, tt.wantBalance)
+				}
+
+				got, err := getPoints(ctx, &pb.GetPointsRequest{UserId: 7})
+				if err != nil {
+					t.Fatalf("GetPoints() error = %v", err)
+				}
+				if got.Balance != tt.wantBalance {
+					t.Errorf("GetPoints() balance = %d, want %d", got.Balance, tt.wantBalance)
+				}
+			})
+		}
+
+		got, err := getPoints(ctx, &pb.GetPointsRequest{UserId: 7})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Records) != 2 || got.Records[0].Reason != "成交奖励" {
+			t.Errorf("GetPoints() records = %v, want newest first", got.Records)
+		}
+	})
+}
+
+// 并发兑换不能超过余额
+func TestPointsService_ConcurrentRedeem(t *testing.T) {
+	env := testutil.NewEnv(t)
+	client := pb.NewPointsClient(env.GRPC)
+	ctx := context.Background()
+	if _, err := client.CreatePoints(ctx, &pb.CreatePointsRequest{UserId: 8, Amount: 100, Reason: "签到"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	redeemed := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.RedeemPoints(ctx, &pb.RedeemPointsRequest{UserId: 8, Amount: 10, Reason: "兑换"})
+			switch {
+			case err == nil:
+				mu.Lock()
+				redeemed++
+				mu.Unlock()
+			case errors.Reason(err) != "POINTS_INSUFFICIENT":
+				t.Errorf("RedeemPoints() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, err := client.GetPoints(ctx, &pb.GetPointsRequest{UserId: 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redeemed != 10 || got.Balance != 0 {
+		t.Errorf("redeemed %d times, balance = %d, want 10 times and 0", redeemed, got.Balance)
+	}
+}
@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	pb "anjuke/api/house/v3"
+	userpb "anjuke/api/user/v2"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestHouseService_PriceHistory(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		grpcClient, httpClient := pb.NewHouseClient(env.GRPC), pb.NewHouseHTTPClient(env.HTTP)
+		createHouse, changePrice := call(grpcClient.CreateHouse), call(grpcClient.ChangeHousePrice)
+		getHistory, setAlert := call(grpcClient.GetPriceHistory), call(grpcClient.SetPriceAlert)
+		listNotifications := call(userpb.NewUserClient(env.GRPC).ListNotifications)
+		if transport == "http" {
+			createHouse, changePrice = call(httpClient.CreateHouse), call(httpClient.ChangeHousePrice)
+			getHistory, setAlert = call(httpClient.GetPriceHistory), call(httpClient.SetPriceAlert)
+			listNotifications = call(userpb.NewUserHTTPClient(env.HTTP).ListNotifications)
+		}
+		ctx := context.Background()
+
+		created, err := createHouse(ctx, &pb.CreateHouseRequest{House: &pb.HouseInfo{
+			Title: "仁恒河滨城 2室1厅", City: "上海", District: "浦东", Rooms: 2, Area: 80, Price: 8000000,
+		}})
+		if err != nil {
+			t.Fatalf("CreateHouse() error = %v", err)
+		}
+		id := created.House.Id
+
+		for _, a := range []struct {
+			req        *pb.SetPriceAlertRequest
+			wantReason string
+		}{
+			{&pb.SetPriceAlertRequest{UserId: 7, HouseId: id, DropPercent: 5}, ""},
+			{&pb.SetPriceAlertRequest{UserId: 8, HouseId: id, DropPercent: 20}, ""},
+			{&pb.SetPriceAlertRequest{UserId: 9, HouseId: id, DropPercent: 100}, "PRICE_ALERT_INVALID"},
+			{&pb.SetPriceAlertRequest{UserId: 9, HouseId: id + 100, DropPercent: 5}, "HOUSE_NOT_FOUND"},
+		} {
+			reply, err := setAlert(ctx, a.req)
+			if a.wantReason != "" {
+				if errors.Reason(err) != a.wantReason {
+					t.Fatalf("SetPriceAlert(%v) error = %v, want reason %s", a.req, err, a.wantReason)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("SetPriceAlert(%v) error = %v", a.req, err)
+			}
+			if reply.BasePrice != 8000000 {
+				t.Errorf("SetPriceAlert(%v) base price = %d, want 8000000", a.req, reply.BasePrice)
+			}
+		}
+
+		// 用户7降幅5%提醒，用户8降幅20%提醒
+		tests := []struct {
+			name       string
+			price      int64
+			wantReason string
+			wantNotes  map[uint64]int // 每个用户累计收到的降价通知数
+		}{
+			{"small drop", 7800000, "", map[uint64]int{7: 0, 8: 0}},
+			{"drop past threshold", 7500000, "", map[uint64]int{7: 1, 8: 0}},
+			{"same price", 7500000, "", map[uint64]int{7: 1, 8: 0}},
+			{"rise moves base up", 7600000, "", map[uint64]int{7: 1, 8: 0}},
+			{"drop from new base", 7200000, "", map[uint64]int{7: 2, 8: 0}},
+			{"invalid price", 0, "HOUSE_INVALID", map[uint64]int{7: 2, 8: 0}},
+			{"large drop", 6000000, "", map[uint64]int{7: 3, 8: 1}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := changePrice(ctx, &pb.ChangeHousePriceRequest{Id: id, Price: tt.price})
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("ChangeHousePrice() error = %v, want reason %s", err, tt.wantReason)
+					}
+				} else if err != nil {
+					t.Fatalf("ChangeHousePrice() error = %v", err)
+				} else if reply.House.Price != tt.price || reply.House.UnitPrice != tt.price/80 {
+					t.Errorf("ChangeHousePrice() = %d (%d/㎡), want %d", reply.House.Price, reply.House.UnitPrice, tt.price)
+				}
+				for user, want := range tt.wantNotes {
+					got, err := listNotifications(ctx, &userpb.ListNotificationsRequest{UserId: user})
+					if err != nil {
+						t.Fatalf("ListNotifications() error = %v", err)
+					}
+					if len(got.Notifications) != want {
+						t.Errorf("user %d notifications = %v, want %d", user, got.Notifications, want)
+					}
+				}
+			})
+		}
+
+		history, err := getHistory(ctx, &pb.GetPriceHistoryRequest{Id: id})
+		if err != nil {
+			t.Fatalf("GetPriceHistory() error = %v", err)
+		}
+		want := []int64{7800000, 7500000, 7600000, 7200000, 6000000}
+		if len(history.Changes) != len(want) {
+			t.Fatalf("GetPriceHistory() = %v, want %d changes", history.Changes, len(want))
+		}
+		for i, c := range history.Changes {
+			if c.NewPrice != want[i] || i > 0 && c.OldPrice != want[i-1] {
+				t.Errorf("change %d = %v, want new price %d", i, c, want[i])
+			}
+		}
+
+		got, err := listNotifications(ctx, &userpb.ListNotificationsRequest{UserId: 8})
+		if err != nil {
+			t.Fatalf("ListNotifications() error = %v", err)
+		}
+		if n := got.Notifications[0]; n.Kind != "price_drop" || n.BizId != id {
+			t.Errorf("notification = %v, want price_drop of house %d", n, id)
+		}
+
+		// 取消提醒后不再通知
+		if _, err := setAlert(ctx, &pb.SetPriceAlertRequest{UserId: 7, HouseId: id}); err != nil {
+			t.Fatalf("SetPriceAlert() error = %v", err)
+		}
+		if _, err := changePrice(ctx, &pb.ChangeHousePriceRequest{Id: id, Price: 5000000}); err != nil {
+			t.Fatalf("ChangeHousePrice() error = %v", err)
+		}
+		if got, err := listNotifications(ctx, &userpb.ListNotificationsRequest{UserId: 7}); err != nil || len(got.Notifications) != 3 {
+			t.Errorf("ListNotifications() = %v, %v, want 3 after removing the alert", got, err)
+		}
+	})
+}
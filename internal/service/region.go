@@ -0,0 +1,57 @@
+package service
+
+import (
+	"anjuke/internal/biz"
+	"context"
+
+	pb "anjuke/api/region/v8"
+)
+
+type RegionService struct {
+	pb.UnimplementedRegionServer
+	v8uc *biz.RegionUsecase
+}
+
+func NewRegionService(v8uc *biz.RegionUsecase) *RegionService {
+	return &RegionService{
+		v8uc: v8uc,
+	}
+}
+
+func (s *RegionService) ListChildren(ctx context.Context, req *pb.ListChildrenRequest) (*pb.ListChildrenReply, error) {
+	list, err := s.v8uc.Children(ctx, uint(req.ParentId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListChildrenReply{Regions: regionInfos(list)}, nil
+}
+
+func (s *RegionService) GetRegionPath(ctx context.Context, req *pb.GetRegionPathRequest) (*pb.GetRegionPathReply, error) {
+	path, err := s.v8uc.Path(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetRegionPathReply{Regions: regionInfos(path)}, nil
+}
+
+func (s *RegionService) ReloadRegions(ctx context.Context, req *pb.ReloadRegionsRequest) (*pb.ReloadRegionsReply, error) {
+	n, err := s.v8uc.Reload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReloadRegionsReply{Count: int32(n)}, nil
+}
+
+func regionInfos(list []*biz.Region) []*pb.RegionInfo {
+	infos := make([]*pb.RegionInfo, 0, len(list))
+	for _, r := range list {
+		infos = append(infos, &pb.RegionInfo{
+			Id:        uint64(r.ID),
+			ParentId:  uint64(r.ParentID),
+			Level:     r.Level,
+			Name:      r.Name,
+			ShortName: r.ShortName,
+		})
+	}
+	return infos
+}
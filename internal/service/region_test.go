@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	customerpb "anjuke/api/customer/v6"
+	housepb "anjuke/api/house/v3"
+	pb "anjuke/api/region/v8"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestRegionService(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		grpcClient, httpClient := pb.NewRegionClient(env.GRPC), pb.NewRegionHTTPClient(env.HTTP)
+		listChildren, getRegionPath, reloadRegions := call(grpcClient.ListChildren), call(grpcClient.GetRegionPath), call(grpcClient.ReloadRegions)
+		if transport == "http" {
+			listChildren, getRegionPath, reloadRegions = call(httpClient.ListChildren), call(httpClient.GetRegionPath), call(httpClient.ReloadRegions)
+		}
+		ctx := context.Background()
+
+		t.Run("children", func(t *testing.T) {
+			tests := []struct {
+				name       string
+				parentID   uint64
+				wantName   string
+				wantReason string
+			}{
+				{"provinces", 0, "上海市", ""},
+				{"districts", 310100, "浦东新区", ""},
+				{"business areas", 310115, "陆家嘴", ""},
+				{"unknown parent", 999999, "", "REGION_NOT_FOUND"},
+			}
+			for _, tt := range tests {
+				reply, err := listChildren(ctx, &pb.ListChildrenRequest{ParentId: tt.parentID})
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Errorf("%s: ListChildren() error = %v, want reason %s", tt.name, err, tt.wantReason)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s: ListChildren() error = %v", tt.name, err)
+				}
+				found := false
+				for _, r := range reply.Regions {
+					found = found || r.Name == tt.wantName
+				}
+				if !found {
+					t.Errorf("%s: ListChildren(%d) = %v, want %s", tt.name, tt.parentID, reply.Regions, tt.wantName)
+				}
+			}
+		})
+
+		t.Run("path", func(t *testing.T) {
+			reply, err := getRegionPath(ctx, &pb.GetRegionPathRequest{Id: 310115001})
+			if err != nil {
+				t.Fatalf("GetRegionPath() error = %v", err)
+			}
+			var names []string
+			for _, r := range reply.Regions {
+				names = append(names, r.ShortName)
+			}
+			if len(names) != 4 || names[0] != "上海" || names[2] != "浦东" || names[3] != "陆家嘴" {
+				t.Errorf("GetRegionPath() = %q, want 上海/上海/浦东/陆家嘴", names)
+			}
+			_, err = getRegionPath(ctx, &pb.GetRegionPathRequest{Id: 404})
+			if !errors.IsNotFound(err) {
+				t.Errorf("GetRegionPath() error = %v, want REGION_NOT_FOUND", err)
+			}
+		})
+
+		t.Run("reload", func(t *testing.T) {
+			if _, err := reloadRegions(testutil.Anonymous(ctx), &pb.ReloadRegionsRequest{}); errors.Reason(err) != "ADMIN_UNAUTHORIZED" {
+				t.Errorf("anonymous ReloadRegions() error = %v, want ADMIN_UNAUTHORIZED", err)
+			}
+			reply, err := reloadRegions(ctx, &pb.ReloadRegionsRequest{})
+			if err != nil {
+				t.Fatalf("ReloadRegions() error = %v", err)
+			}
+			if reply.Count == 0 {
+				t.Errorf("ReloadRegions() count = 0")
+			}
+		})
+	})
+}
+
+// 房源和客户按区域ID补全名称，或按名称匹配区域ID
+func TestRegionLocate(t *testing.T) {
+	env := testutil.NewEnv(t)
+	houses, customers := housepb.NewHouseClient(env.GRPC), customerpb.NewCustomerClient(env.GRPC)
+	ctx := context.Background()
+
+	tests := []struct {
+		name                   string
+		house                  *housepb.HouseInfo
+		wantRegion             uint64
+		wantCity, wantDistrict string
+		wantReason             string
+	}{
+		{"by id", &housepb.HouseInfo{RegionId: 310115001, City: "北京"}, 310115001, "上海", "浦东", ""},
+		{"by short names", &housepb.HouseInfo{City: "上海", District: "浦东"}, 310115, "上海", "浦东", ""},
+		{"by full names", &housepb.HouseInfo{City: "上海市", District: "浦东新区"}, 310115, "上海", "浦东", ""},
+		{"unknown district", &housepb.HouseInfo{City: "上海", District: "不存在"}, 310100, "上海", "不存在", ""},
+		{"unknown city", &housepb.HouseInfo{City: "火星", District: "一区"}, 0, "火星", "一区", ""},
+		{"unknown id", &housepb.HouseInfo{RegionId: 404}, 0, "", "", "REGION_NOT_FOUND"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.house.Area, tt.house.Price = 50, 1000000
+			reply, err := houses.CreateHouse(ctx, &housepb.CreateHouseRequest{House: tt.house})
+			if tt.wantReason != "" {
+				if errors.Reason(err) != tt.wantReason {
+					t.Fatalf("CreateHouse() error = %v, want reason %s", err, tt.wantReason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			h := reply.House
+			if h.RegionId != tt.wantRegion || h.City != tt.wantCity || h.District != tt.wantDistrict {
+				t.Errorf("CreateHouse() region=%d city=%s district=%s, want %d %s %s",
+					h.RegionId, h.City, h.District, tt.wantRegion, tt.wantCity, tt.wantDistrict)
+			}
+		})
+	}
+
+	t.Run("customer", func(t *testing.T) {
+		reply, err := customers.CreateCustomer(ctx, &customerpb.CreateCustomerRequest{Customer: &customerpb.CustomerInfo{
+			Name: "王先生", Mobile: "13900000001", RegionId: 110108,
+		}})
+		if err != nil {
+			t.Fatalf("CreateCustomer() error = %v", err)
+		}
+		if reply.Customer.District != "海淀" || reply.Customer.RegionId != 110108 {
+			t.Errorf("CreateCustomer() = %v, want district 海淀", reply.Customer)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	communitypb "anjuke/api/community/v7"
+	pb "anjuke/api/house/v3"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestHouseService_Rent(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		createHouse, getHouse := call(pb.NewHouseClient(env.GRPC).CreateHouse), call(pb.NewHouseClient(env.GRPC).GetHouse)
+		if transport == "http" {
+			createHouse, getHouse = call(pb.NewHouseHTTPClient(env.HTTP).CreateHouse), call(pb.NewHouseHTTPClient(env.HTTP).GetHouse)
+		}
+		ctx := context.Background()
+
+		whole, err := createHouse(ctx, &pb.CreateHouseRequest{House: &pb.HouseInfo{
+			Title: "徐汇 一室户 拎包入住", City: "上海", District: "徐汇", ListingType: 1, Rooms: 1, Area: 40,
+			MonthlyRent: 6500, DepositMonths: 1, PayMonths: 3, Utilities: []string{"网费", " 物业费", "网费"}, AvailableFrom: "2025-07-01",
+		}})
+		if err != nil {
+			t.Fatalf("CreateHouse() error = %v", err)
+		}
+		h := whole.House
+		if h.Price != 6500 || h.MonthlyRent != 6500 || h.UnitPrice != 162 || h.PaymentCycle != "押一付三" || h.RentalMode != 0 {
+			t.Errorf("CreateHouse() = %v, want the rent as price, paid 押一付三", h)
+		}
+		if !reflect.DeepEqual(h.Utilities, []string{"网费", "物业费"}) || h.AvailableFrom != "2025-07-01" {
+			t.Errorf("utilities = %v, available from %q", h.Utilities, h.AvailableFrom)
+		}
+
+		// 只填价格的出租房源按月付、免押
+		monthly, err := createHouse(ctx, &pb.CreateHouseRequest{House: &pb.HouseInfo{Title: "静安 老洋房", City: "上海", District: "静安", ListingType: 1, Area: 60, Price: 9000}})
+		if err != nil {
+			t.Fatalf("CreateHouse() error = %v", err)
+		}
+		if monthly.House.MonthlyRent != 9000 || monthly.House.PayMonths != 1 || monthly.House.PaymentCycle != "免押付一" {
+			t.Errorf("CreateHouse() = %v, want paid monthly without deposit", monthly.House)
+		}
+
+		community, err := communitypb.NewCommunityClient(env.GRPC).CreateCommunity(ctx, &communitypb.CreateCommunityRequest{Community: &communitypb.CommunityInfo{
+			Name: "仁恒河滨城", City: "上海", District: "浦东", BuildYear: 2010,
+		}})
+		if err != nil {
+			t.Fatalf("CreateCommunity() error = %v", err)
+		}
+		shared, err := createHouse(ctx, &pb.CreateHouseRequest{House: &pb.HouseInfo{
+			Title: "河滨城 三室合租", OwnerId: 5, CommunityId: community.Community.Id, ListingType: 1, RentalMode: 1,
+			Rooms: 3, Halls: 1, Baths: 2, Floor: 12, Area: 110, MonthlyRent: 9900, DepositMonths: 1,
+		}})
+		if err != nil {
+			t.Fatalf("CreateHouse() error = %v", err)
+		}
+		apartment := shared.House
+		var roomIDs []uint64
+		for _, r := range []*pb.HouseInfo{
+			{Title: "河滨城 主卧 独卫", RoomName: "主卧", Area: 20, MonthlyRent: 4200, DepositMonths: 1},
+			{Title: "河滨城 次卧", RoomName: "次卧", Area: 12, MonthlyRent: 2800, DepositMonths: 1, Utilities: []string{"网费"}},
+		} {
+			r.ListingType, r.ParentId = 1, apartment.Id
+			reply, err := createHouse(ctx, &pb.CreateHouseRequest{House: r})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			got := reply.House
+			if got.OwnerId != 5 || got.CommunityName != "仁恒河滨城" || got.District != "浦东" || got.Floor != 12 || got.Rooms != 3 || got.RentalMode != 1 {
+				t.Errorf("room = %v, want the apartment's owner, location and layout", got)
+			}
+			roomIDs = append(roomIDs, got.Id)
+		}
+		got, err := getHouse(ctx, &pb.GetHouseRequest{Id: apartment.Id})
+		if err != nil {
+			t.Fatalf("GetHouse() error = %v", err)
+		}
+		if len(got.Rooms) != 2 || got.Rooms[0].Id != roomIDs[0] || got.Rooms[1].Id != roomIDs[1] || got.Rooms[0].RoomName != "主卧" {
+			t.Errorf("GetHouse() rooms = %v, want %v", got.Rooms, roomIDs)
+		}
+		if got, err := getHouse(ctx, &pb.GetHouseRequest{Id: h.Id}); err != nil || len(got.Rooms) != 0 {
+			t.Errorf("GetHouse() = %v, %v, want a whole rental without rooms", got, err)
+		}
+
+		tests := []struct {
+			name  string
+			house *pb.HouseInfo
+		}{
+			{"rent terms on a sale", &pb.HouseInfo{Title: "出售", Area: 90, Price: 5000000, PayMonths: 3}},
+			{"deposit too large", &pb.HouseInfo{Title: "押四", ListingType: 1, Area: 40, Price: 6000, DepositMonths: 4}},
+			{"pay months too large", &pb.HouseInfo{Title: "付十三", ListingType: 1, Area: 40, Price: 6000, PayMonths: 13}},
+			{"unknown utility", &pb.HouseInfo{Title: "含车位", ListingType: 1, Area: 40, Price: 6000, Utilities: []string{"停车费"}}},
+			{"unknown rental mode", &pb.HouseInfo{Title: "分租", ListingType: 1, Area: 40, Price: 6000, RentalMode: 2}},
+			{"room without name", &pb.HouseInfo{Title: "房间", ListingType: 1, ParentId: apartment.Id, Area: 10, Price: 2000}},
+			{"room of a whole rental", &pb.HouseInfo{Title: "房间", ListingType: 1, ParentId: h.Id, RoomName: "主卧", Area: 10, Price: 2000}},
+			{"room name without parent", &pb.HouseInfo{Title: "房间", ListingType: 1, RoomName: "主卧", Area: 10, Price: 2000}},
+			{"malformed date", &pb.HouseInfo{Title: "七月", ListingType: 1, Area: 40, Price: 6000, AvailableFrom: "07/01"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := createHouse(ctx, &pb.CreateHouseRequest{House: tt.house}); errors.Reason(err) != "RENT_INVALID" {
+					t.Errorf("CreateHouse() error = %v, want RENT_INVALID", err)
+				}
+			})
+		}
+	})
+}
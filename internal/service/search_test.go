@@ -0,0 +1,103 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "anjuke/api/house/v3"
+	transactionpb "anjuke/api/transaction/v4"
+	"anjuke/internal/testutil"
+)
+
+func TestHouseService_SearchHouses(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		grpcClient, httpClient := pb.NewHouseClient(env.GRPC), pb.NewHouseHTTPClient(env.HTTP)
+		createHouse, searchHouses := call(grpcClient.CreateHouse), call(grpcClient.SearchHouses)
+		if transport == "http" {
+			createHouse, searchHouses = call(httpClient.CreateHouse), call(httpClient.SearchHouses)
+		}
+		ctx := context.Background()
+
+		ids := map[string]uint64{}
+		for _, h := range []*pb.HouseInfo{
+			{Title: "仁恒河滨城 2室1厅 南北通透", Description: "近地铁，业主诚意出售", CommunityName: "仁恒河滨城",
+				City: "上海", District: "浦东", Rooms: 2, Area: 89, Price: 8000000, Tags: []string{"满五唯一", " ", "满五唯一"}},
+			{Title: "静安 三室两厅 学区房", Description: "对口重点小学", City: "上海", District: "静安", Rooms: 3, Area: 120, Price: 15000000},
+			{Title: "徐汇 一室户 拎包入住", City: "上海", District: "徐汇", Rooms: 1, Area: 40, Price: 6500, ListingType: 1},
+		} {
+			reply, err := createHouse(ctx, &pb.CreateHouseRequest{House: h})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			ids[h.District] = reply.House.Id
+		}
+
+		rent := int32(1)
+		tests := []struct {
+			name string
+			req  *pb.SearchHousesRequest
+			want []string
+		}{
+			{"synonym", &pb.SearchHousesRequest{Keyword: "两室"}, []string{"浦东"}},
+			{"synonym reversed", &pb.SearchHousesRequest{Keyword: "3室"}, []string{"静安"}},
+			{"description", &pb.SearchHousesRequest{Keyword: "地铁"}, []string{"浦东"}},
+			{"tag", &pb.SearchHousesRequest{Keyword: "满五唯一"}, []string{"浦东"}},
+			{"every word", &pb.SearchHousesRequest{Keyword: "学区 浦东"}, nil},
+			{"region", &pb.SearchHousesRequest{RegionId: 310106}, []string{"静安"}},
+			{"city region", &pb.SearchHousesRequest{RegionId: 310100, Rooms: 1}, []string{"徐汇"}},
+			{"listing type", &pb.SearchHousesRequest{ListingType: &rent}, []string{"徐汇"}},
+			{"price", &pb.SearchHousesRequest{MinPrice: 1000000, MaxPrice: 10000000}, []string{"浦东"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := searchHouses(ctx, tt.req)
+				if err != nil {
+					t.Fatalf("SearchHouses() error = %v", err)
+				}
+				var got []string
+				for _, hit := range reply.Hits {
+					got = append(got, hit.House.District)
+				}
+				if strings.Join(got, ",") != strings.Join(tt.want, ",") || reply.Total != uint64(len(tt.want)) {
+					t.Errorf("SearchHouses(%v) = %q (total %d), want %q", tt.req, got, reply.Total, tt.want)
+				}
+			})
+		}
+
+		t.Run("highlight", func(t *testing.T) {
+			reply, err := searchHouses(ctx, &pb.SearchHousesRequest{Keyword: "河滨城"})
+			if err != nil {
+				t.Fatalf("SearchHouses() error = %v", err)
+			}
+			if len(reply.Hits) != 1 {
+				t.Fatalf("SearchHouses() = %v, want 1 hit", reply.Hits)
+			}
+			if hl := reply.Hits[0].Highlights["title"]; !strings.Contains(hl, "<mark>") {
+				t.Errorf("title highlight = %q, want <mark>", hl)
+			}
+			if tags := reply.Hits[0].House.Tags; len(tags) != 1 || tags[0] != "满五唯一" {
+				t.Errorf("Tags = %q, want [满五唯一]", tags)
+			}
+		})
+
+		// 成交后房源标记为已售，随 house.changed 事件移出索引
+		t.Run("sold house removed", func(t *testing.T) {
+			deals := transactionpb.NewTransactionClient(env.GRPC)
+			d, err := deals.CreateTransaction(ctx, &transactionpb.CreateTransactionRequest{HouseId: ids["浦东"], BuyerId: 2, AgentId: 3, Price: 7900000})
+			if err != nil {
+				t.Fatalf("CreateTransaction() error = %v", err)
+			}
+			if _, err := deals.CompleteTransaction(ctx, &transactionpb.CompleteTransactionRequest{Id: d.Deal.Id}); err != nil {
+				t.Fatalf("CompleteTransaction() error = %v", err)
+			}
+			reply, err := searchHouses(ctx, &pb.SearchHousesRequest{Keyword: "两室"})
+			if err != nil {
+				t.Fatalf("SearchHouses() error = %v", err)
+			}
+			if reply.Total != 0 {
+				t.Errorf("SearchHouses() = %v, want sold house gone", reply.Hits)
+			}
+		})
+	})
+}
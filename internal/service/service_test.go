@@ -0,0 +1,28 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"anjuke/internal/testutil"
+)
+
+// transports names the client each test case is run through.
+var transports = []string{"grpc", "http"}
+
+// call adapts a generated gRPC or HTTP client method to a transport-neutral
+// func, so one table of cases can drive both clients.
+func call[Req, Reply, Opt any](f func(context.Context, Req, ...Opt) (Reply, error)) func(context.Context, Req) (Reply, error) {
+	return func(ctx context.Context, in Req) (Reply, error) {
+		return f(ctx, in)
+	}
+}
+
+// eachTransport runs fn once per transport, each against a fresh environment.
+func eachTransport(t *testing.T, fn func(t *testing.T, env *testutil.Env, transport string)) {
+	for _, tr := range transports {
+		t.Run(tr, func(t *testing.T) {
+			fn(t, testutil.NewEnv(t), tr)
+		})
+	}
+}
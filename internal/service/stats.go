@@ -0,0 +1,60 @@
+package service
+
+import (
+	"anjuke/internal/biz"
+	"context"
+
+	pb "anjuke/api/stats/v10"
+)
+
+type StatsService struct {
+	pb.UnimplementedStatsServer
+	v10uc *biz.StatsUsecase
+}
+
+func NewStatsService(v10uc *biz.StatsUsecase) *StatsService {
+	return &StatsService{
+		v10uc: v10uc,
+	}
+}
+
+func (s *StatsService) GetMarketStats(ctx context.Context, req *pb.GetMarketStatsRequest) (*pb.GetMarketStatsReply, error) {
+	stats, err := s.v10uc.GetMarketStats(ctx, uint(req.CommunityId), req.City, req.District)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMarketStatsReply{Stats: marketStats(stats)}, nil
+}
+
+func (s *StatsService) GetPriceTrend(ctx context.Context, req *pb.GetPriceTrendRequest) (*pb.GetPriceTrendReply, error) {
+	list, err := s.v10uc.GetPriceTrend(ctx, uint(req.CommunityId), req.City, req.District, int(req.Months))
+	if err != nil {
+		return nil, err
+	}
+	points := make([]*pb.MarketStats, 0, len(list))
+	for _, m := range list {
+		points = append(points, marketStats(m))
+	}
+	return &pb.GetPriceTrendReply{Points: points}, nil
+}
+
+func (s *StatsService) RefreshMarketStats(ctx context.Context, req *pb.RefreshMarketStatsRequest) (*pb.RefreshMarketStatsReply, error) {
+	if err := s.v10uc.RefreshMonth(ctx, req.Month); err != nil {
+		return nil, err
+	}
+	return &pb.RefreshMarketStatsReply{}, nil
+}
+
+func marketStats(m *biz.MarketStats) *pb.MarketStats {
+	return &pb.MarketStats{
+		Month:               m.Month,
+		ListingCount:        m.ListingCount,
+		NewListings:         m.NewListings,
+		AvgListingUnitPrice: m.AvgListingUnitPrice,
+		DealCount:           m.DealCount,
+		AvgDealUnitPrice:    m.AvgDealUnitPrice,
+		AvgDaysOnMarket:     m.AvgDaysOnMarket,
+		ListingPriceChange:  m.ListingPriceChange,
+		DealPriceChange:     m.DealPriceChange,
+	}
+}
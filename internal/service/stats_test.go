@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	communitypb "anjuke/api/community/v7"
+	housepb "anjuke/api/house/v3"
+	pb "anjuke/api/stats/v10"
+	transactionpb "anjuke/api/transaction/v4"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestStatsService(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		client := pb.NewStatsClient(env.GRPC)
+		getStats, getTrend, refresh := call(client.GetMarketStats), call(client.GetPriceTrend), call(client.RefreshMarketStats)
+		if transport == "http" {
+			client := pb.NewStatsHTTPClient(env.HTTP)
+			getStats, getTrend, refresh = call(client.GetMarketStats), call(client.GetPriceTrend), call(client.RefreshMarketStats)
+		}
+		ctx := context.Background()
+
+		communities := communitypb.NewCommunityClient(env.GRPC)
+		var communityIDs []uint64
+		for _, name := range []string{"仁恒河滨城", "世茂滨江花园"} {
+			reply, err := communities.CreateCommunity(ctx, &communitypb.CreateCommunityRequest{Community: &communitypb.CommunityInfo{
+				Name: name, City: "上海", District: "浦东", BuildYear: 2010,
+			}})
+			if err != nil {
+				t.Fatalf("CreateCommunity() error = %v", err)
+			}
+			communityIDs = append(communityIDs, reply.Community.Id)
+		}
+		houses := housepb.NewHouseClient(env.GRPC)
+		var ids []uint64
+		for _, h := range []*housepb.HouseInfo{
+			{Title: "河滨城 两室", CommunityId: communityIDs[0], Area: 80, Price: 8000000},
+			{Title: "河滨城 三室", CommunityId: communityIDs[0], Area: 100, Price: 9000000},
+			{Title: "河滨城 高层", CommunityId: communityIDs[0], Area: 90, Price: 9000000},
+			{Title: "河滨城 出租", CommunityId: communityIDs[0], ListingType: 1, Area: 90, Price: 12000},
+			{Title: "滨江花园 两室", CommunityId: communityIDs[1], Area: 100, Price: 7000000},
+		} {
+			reply, err := houses.CreateHouse(ctx, &housepb.CreateHouseRequest{House: h})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			ids = append(ids, reply.House.Id)
+		}
+		// 高层那套以873万成交，单价97000
+		deals := transactionpb.NewTransactionClient(env.GRPC)
+		deal, err := deals.CreateTransaction(ctx, &transactionpb.CreateTransactionRequest{HouseId: ids[2], BuyerId: 2, AgentId: 3, Price: 8730000})
+		if err != nil {
+			t.Fatalf("CreateTransaction() error = %v", err)
+		}
+		if _, err := deals.CompleteTransaction(ctx, &transactionpb.CompleteTransactionRequest{Id: deal.Deal.Id}); err != nil {
+			t.Fatalf("CompleteTransaction() error = %v", err)
+		}
+
+		before, err := getStats(ctx, &pb.GetMarketStatsRequest{CommunityId: communityIDs[0]})
+		if err != nil {
+			t.Fatalf("GetMarketStats() error = %v", err)
+		}
+		if before.Stats.ListingCount != 0 || before.Stats.Month != time.Now().Format("2006-01") {
+			t.Errorf("GetMarketStats() before refresh = %v, want zeros of this month", before.Stats)
+		}
+		if _, err := refresh(testutil.Anonymous(ctx), &pb.RefreshMarketStatsRequest{}); errors.Reason(err) != "ADMIN_UNAUTHORIZED" {
+			t.Errorf("anonymous RefreshMarketStats() error = %v, want ADMIN_UNAUTHORIZED", err)
+		}
+		if _, err := refresh(ctx, &pb.RefreshMarketStatsRequest{}); err != nil {
+			t.Fatalf("RefreshMarketStats() error = %v", err)
+		}
+
+		tests := []struct {
+			name       string
+			req        *pb.GetMarketStatsRequest
+			wantReason string
+			want       *pb.MarketStats
+		}{
+			// 出租房源不计入，已售的一套计入成交
+			{"community", &pb.GetMarketStatsRequest{CommunityId: communityIDs[0]}, "",
+				&pb.MarketStats{ListingCount: 2, NewListings: 3, AvgListingUnitPrice: (100000 + 90000) / 2, DealCount: 1, AvgDealUnitPrice: 97000}},
+			{"other community", &pb.GetMarketStatsRequest{CommunityId: communityIDs[1]}, "",
+				&pb.MarketStats{ListingCount: 1, NewListings: 1, AvgListingUnitPrice: 70000}},
+			{"district", &pb.GetMarketStatsRequest{City: "上海", District: "浦东"}, "",
+				&pb.MarketStats{ListingCount: 3, NewListings: 4, AvgListingUnitPrice: (100000 + 90000 + 70000) / 3, DealCount: 1, AvgDealUnitPrice: 97000}},
+			{"empty district", &pb.GetMarketStatsRequest{City: "上海", District: "静安"}, "", &pb.MarketStats{}},
+			{"no district", &pb.GetMarketStatsRequest{City: "上海"}, "STATS_INVALID", nil},
+			{"unknown community", &pb.GetMarketStatsRequest{CommunityId: communityIDs[1] + 100}, "COMMUNITY_NOT_FOUND", nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := getStats(ctx, tt.req)
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("GetMarketStats() error = %v, want reason %s", err, tt.wantReason)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("GetMarketStats() error = %v", err)
+				}
+				got := reply.Stats
+				if got.ListingCount != tt.want.ListingCount || got.NewListings != tt.want.NewListings || got.AvgListingUnitPrice != tt.want.AvgListingUnitPrice ||
+					got.DealCount != tt.want.DealCount || got.AvgDealUnitPrice != tt.want.AvgDealUnitPrice {
+					t.Errorf("GetMarketStats() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+
+		t.Run("trend", func(t *testing.T) {
+			reply, err := getTrend(ctx, &pb.GetPriceTrendRequest{CommunityId: communityIDs[0], Months: 3})
+			if err != nil {
+				t.Fatalf("GetPriceTrend() error = %v", err)
+			}
+			if len(reply.Points) != 3 {
+				t.Fatalf("GetPriceTrend() = %d points, want 3", len(reply.Points))
+			}
+			now := time.Now()
+			cur := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+			for i, p := range reply.Points {
+				if want := cur.AddDate(0, i-2, 0).Format("2006-01"); p.Month != want {
+					t.Errorf("point %d month = %s, want %s", i, p.Month, want)
+				}
+			}
+			// 之前的月份没有数据，补0且不算环比
+			if p := reply.Points[0]; p.ListingCount != 0 || p.AvgListingUnitPrice != 0 {
+				t.Errorf("first point = %v, want zeros", p)
+			}
+			if p := reply.Points[2]; p.AvgListingUnitPrice != 95000 || p.ListingPriceChange != 0 {
+				t.Errorf("last point = %v, want this month without a change", p)
+			}
+
+			reply, err = getTrend(ctx, &pb.GetPriceTrendRequest{City: "上海", District: "浦东"})
+			if err != nil {
+				t.Fatalf("GetPriceTrend() error = %v", err)
+			}
+			if len(reply.Points) != 12 {
+				t.Errorf("GetPriceTrend() = %d points, want the default 12", len(reply.Points))
+			}
+		})
+
+		t.Run("refresh", func(t *testing.T) {
+			// 房源都是本月挂牌，过去的月份汇总为空
+			if _, err := refresh(ctx, &pb.RefreshMarketStatsRequest{Month: "2020-01"}); err != nil {
+				t.Fatalf("RefreshMarketStats() error = %v", err)
+			}
+			if _, err := refresh(ctx, &pb.RefreshMarketStatsRequest{Month: "2020-13"}); errors.Reason(err) != "STATS_INVALID" {
+				t.Errorf("RefreshMarketStats() error = %v, want reason STATS_INVALID", err)
+			}
+			// 重新汇总覆盖当月，不会重复计数
+			if _, err := refresh(ctx, &pb.RefreshMarketStatsRequest{Month: time.Now().Format("2006-01")}); err != nil {
+				t.Fatalf("RefreshMarketStats() error = %v", err)
+			}
+			reply, err := getStats(ctx, &pb.GetMarketStatsRequest{CommunityId: communityIDs[0]})
+			if err != nil {
+				t.Fatalf("GetMarketStats() error = %v", err)
+			}
+			if reply.Stats.ListingCount != 2 || reply.Stats.DealCount != 1 {
+				t.Errorf("GetMarketStats() after refresh = %v, want 2 listings and 1 deal", reply.Stats)
+			}
+		})
+	})
+}
@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	communitypb "anjuke/api/community/v7"
+	pb "anjuke/api/house/v3"
+	"anjuke/internal/testutil"
+)
+
+func TestHouseService_Suggest(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		grpcClient, httpClient := pb.NewHouseClient(env.GRPC), pb.NewHouseHTTPClient(env.HTTP)
+		suggest, searchHouses := call(grpcClient.Suggest), call(grpcClient.SearchHouses)
+		if transport == "http" {
+			suggest, searchHouses = call(httpClient.Suggest), call(httpClient.SearchHouses)
+		}
+		ctx := context.Background()
+
+		communities := communitypb.NewCommunityClient(env.GRPC)
+		for _, c := range []*communitypb.CommunityInfo{
+			{Name: "万科城市花园", City: "上海", District: "浦东"},
+			{Name: "万科翡翠", City: "北京", District: "朝阳"},
+			{Name: "仁恒河滨城", Aliases: []string{"河滨城"}, City: "上海", District: "浦东"},
+		} {
+			if _, err := communities.CreateCommunity(ctx, &communitypb.CreateCommunityRequest{Community: c}); err != nil {
+				t.Fatalf("CreateCommunity() error = %v", err)
+			}
+		}
+
+		tests := []struct {
+			name, keyword, city string
+			want                []string // kind:text，按顺序出现在结果中；热度相同时短的在前
+			absent              string
+		}{
+			{"initials", "wk", "", []string{"community:万科翡翠", "community:万科城市花园"}, ""},
+			{"full pinyin", "WanKe", "", []string{"community:万科翡翠", "community:万科城市花园"}, ""},
+			{"city", "wk", "北京", []string{"community:万科翡翠"}, "万科城市花园"},
+			{"alias", "hbc", "", []string{"community:仁恒河滨城"}, ""},
+			{"district", "浦东", "", []string{"district:浦东"}, ""},
+			{"business area before station", "lujiazui", "", []string{"business_area:陆家嘴", "station:陆家嘴"}, ""},
+			{"no match", "zzzz", "", nil, "万科城市花园"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := suggest(ctx, &pb.SuggestRequest{Keyword: tt.keyword, City: tt.city})
+				if err != nil {
+					t.Fatalf("Suggest() error = %v", err)
+				}
+				var got []string
+				for _, s := range reply.Suggestions {
+					got = append(got, s.Kind+":"+s.Text)
+					if s.Text == tt.absent {
+						t.Errorf("Suggest(%q, %q) = %q, want no %s", tt.keyword, tt.city, got, tt.absent)
+					}
+				}
+				i := 0
+				for _, g := range got {
+					if i < len(tt.want) && g == tt.want[i] {
+						i++
+					}
+				}
+				if i != len(tt.want) {
+					t.Errorf("Suggest(%q, %q) = %q, want %q in order", tt.keyword, tt.city, got, tt.want)
+				}
+			})
+		}
+
+		// 搜索次数多的排在前面，并出现在热搜词中
+		t.Run("popularity", func(t *testing.T) {
+			// 空格和大小写不同的搜索计为同一个词
+			for _, kw := range []string{"万科城市花园", "万科 城市花园", " 万科城市 花园"} {
+				if _, err := searchHouses(ctx, &pb.SearchHousesRequest{Keyword: kw}); err != nil {
+					t.Fatalf("SearchHouses() error = %v", err)
+				}
+			}
+			if _, err := searchHouses(ctx, &pb.SearchHousesRequest{Keyword: "学区房"}); err != nil {
+				t.Fatalf("SearchHouses() error = %v", err)
+			}
+			reply, err := suggest(ctx, &pb.SuggestRequest{Keyword: "wk"})
+			if err != nil {
+				t.Fatalf("Suggest() error = %v", err)
+			}
+			if len(reply.Suggestions) == 0 || reply.Suggestions[0].Text != "万科城市花园" {
+				t.Errorf("Suggest(wk) = %v, want 万科城市花园 first", reply.Suggestions)
+			}
+			hot, err := suggest(ctx, &pb.SuggestRequest{})
+			if err != nil {
+				t.Fatalf("Suggest() error = %v", err)
+			}
+			if len(hot.Suggestions) != 2 || hot.Suggestions[0].Text != "万科城市花园" || hot.Suggestions[0].Kind != "keyword" {
+				t.Errorf("hot keywords = %v, want 万科城市花园, 学区房", hot.Suggestions)
+			}
+		})
+	})
+}
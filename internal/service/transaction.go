@@ -3,21 +3,281 @@ package service
 import (
 	"anjuke/internal/biz"
 	"context"
+	"time"
 
 	pb "anjuke/api/transaction/v4"
 )
 
 type TransactionService struct {
 	pb.UnimplementedTransactionServer
-	v4uc *biz.TransactionUsecase
+	v4uc     *biz.TransactionUsecase
+	mortgage *biz.MortgageUsecase
+	lease    *biz.LeaseUsecase
+	billing  *biz.BillingUsecase
 }
 
-func NewTransactionService(v4uc *biz.TransactionUsecase) *TransactionService {
+func NewTransactionService(v4uc *biz.TransactionUsecase, mortgage *biz.MortgageUsecase, lease *biz.LeaseUsecase, billing *biz.BillingUsecase) *TransactionService {
 	return &TransactionService{
-		v4uc: v4uc,
+		v4uc:     v4uc,
+		mortgage: mortgage,
+		lease:    lease,
+		billing:  billing,
 	}
 }
 
 func (s *TransactionService) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.CreateTransactionReply, error) {
-	return &pb.CreateTransactionReply{}, nil
+	d, err := s.v4uc.CreateDeal(ctx, &biz.Deal{
+		HouseID: uint(req.HouseId),
+		BuyerID: uint(req.BuyerId),
+		AgentID: uint(req.AgentId),
+		Price:   req.Price,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateTransactionReply{Deal: dealInfo(d)}, nil
+}
+
+func (s *TransactionService) CompleteTransaction(ctx context.Context, req *pb.CompleteTransactionRequest) (*pb.CompleteTransactionReply, error) {
+	d, err := s.v4uc.CompleteDeal(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompleteTransactionReply{Deal: dealInfo(d)}, nil
+}
+
+func (s *TransactionService) CalculateMortgage(ctx context.Context, req *pb.CalculateMortgageRequest) (*pb.CalculateMortgageReply, error) {
+	q, err := s.mortgage.Calculate(ctx, &biz.MortgageRequest{
+		Price:              req.Price,
+		Area:               req.Area,
+		Home:               req.Home,
+		DownPaymentPercent: req.DownPaymentPercent,
+		LoanType:           req.LoanType,
+		FundAmount:         req.FundAmount,
+		Years:              req.Years,
+		Method:             req.Method,
+		CommercialRate:     req.CommercialRate,
+		FundRate:           req.FundRate,
+		YearsHeld:          req.YearsHeld,
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.CalculateMortgageReply{
+		DownPayment:    q.DownPayment,
+		CommercialLoan: q.CommercialLoan,
+		FundLoan:       q.FundLoan,
+		CommercialRate: q.CommercialRate,
+		FundRate:       q.FundRate,
+		MonthlyPayment: q.MonthlyPayment,
+		TotalInterest:  q.TotalInterest,
+		TotalPayment:   q.TotalPayment,
+		TotalFees:      q.TotalFees,
+		UpfrontCost:    q.UpfrontCost,
+	}
+	for _, f := range q.Fees {
+		reply.Fees = append(reply.Fees, &pb.PurchaseFee{Key: f.Key, Label: f.Label, Amount: f.Amount})
+	}
+	for _, p := range q.Schedule {
+		reply.Schedule = append(reply.Schedule, &pb.MortgagePayment{
+			Month:     p.Month,
+			Payment:   p.Payment,
+			Principal: p.Principal,
+			Interest:  p.Interest,
+			Balance:   p.Balance,
+		})
+	}
+	return reply, nil
+}
+
+func (s *TransactionService) SignLease(ctx context.Context, req *pb.SignLeaseRequest) (*pb.SignLeaseReply, error) {
+	start, err := biz.ParseLeaseDate(req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	l, err := s.lease.SignLease(ctx, &biz.Lease{
+		HouseID:       uint(req.HouseId),
+		TenantID:      uint(req.TenantId),
+		StartDate:     start,
+		Months:        req.Months,
+		MonthlyRent:   req.MonthlyRent,
+		DepositMonths: req.DepositMonths,
+		PayMonths:     req.PayMonths,
+		Charges:       leaseCharges(req.Charges),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignLeaseReply{Lease: leaseInfo(l)}, nil
+}
+
+func (s *TransactionService) GetLease(ctx context.Context, req *pb.GetLeaseRequest) (*pb.GetLeaseReply, error) {
+	l, err := s.lease.GetLease(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLeaseReply{Lease: leaseInfo(l)}, nil
+}
+
+func (s *TransactionService) ListLeases(ctx context.Context, req *pb.ListLeasesRequest) (*pb.ListLeasesReply, error) {
+	list, err := s.lease.ListLeases(ctx, uint(req.TenantId), uint(req.LandlordId), uint(req.HouseId))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListLeasesReply{}
+	for _, l := range list {
+		reply.Leases = append(reply.Leases, leaseInfo(l))
+	}
+	return reply, nil
+}
+
+func (s *TransactionService) RenewLease(ctx context.Context, req *pb.RenewLeaseRequest) (*pb.RenewLeaseReply, error) {
+	l, err := s.lease.RenewLease(ctx, uint(req.Id), req.Months, req.MonthlyRent)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenewLeaseReply{Lease: leaseInfo(l)}, nil
+}
+
+func (s *TransactionService) TerminateLease(ctx context.Context, req *pb.TerminateLeaseRequest) (*pb.TerminateLeaseReply, error) {
+	date, err := biz.ParseLeaseDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	l, err := s.lease.TerminateLease(ctx, uint(req.Id), date, req.Penalty, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TerminateLeaseReply{Lease: leaseInfo(l)}, nil
+}
+
+func (s *TransactionService) ListBills(ctx context.Context, req *pb.ListBillsRequest) (*pb.ListBillsReply, error) {
+	list, err := s.billing.ListBills(ctx, &biz.BillFilter{
+		TenantID:   uint(req.TenantId),
+		LandlordID: uint(req.LandlordId),
+		LeaseID:    uint(req.LeaseId),
+		Status:     req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListBillsReply{}
+	for _, b := range list {
+		reply.Bills = append(reply.Bills, billInfo(b))
+	}
+	return reply, nil
+}
+
+func (s *TransactionService) MarkBillPaid(ctx context.Context, req *pb.MarkBillPaidRequest) (*pb.MarkBillPaidReply, error) {
+	b, err := s.billing.MarkBillPaid(ctx, uint(req.Id), uint(req.LandlordId), req.WaiveLateFee, req.Note)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MarkBillPaidReply{Bill: billInfo(b)}, nil
+}
+
+func (s *TransactionService) RunBilling(ctx context.Context, req *pb.RunBillingRequest) (*pb.RunBillingReply, error) {
+	date, err := biz.ParseLeaseDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	res, err := s.billing.Run(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RunBillingReply{Issued: int32(res.Issued), Overdue: int32(res.Overdue), Expired: int32(res.Expired)}, nil
+}
+
+func leaseCharges(list []*pb.LeaseCharge) []*biz.LeaseCharge {
+	var res []*biz.LeaseCharge
+	for _, c := range list {
+		res = append(res, &biz.LeaseCharge{Name: c.Name, Monthly: c.Monthly})
+	}
+	return res
+}
+
+func leaseChargeInfos(list []*biz.LeaseCharge) []*pb.LeaseCharge {
+	var res []*pb.LeaseCharge
+	for _, c := range list {
+		res = append(res, &pb.LeaseCharge{Name: c.Name, Monthly: c.Monthly})
+	}
+	return res
+}
+
+func billInfo(b *biz.RentBill) *pb.BillInfo {
+	info := &pb.BillInfo{
+		Id:          uint64(b.ID),
+		LeaseId:     uint64(b.LeaseID),
+		HouseId:     uint64(b.HouseID),
+		TenantId:    uint64(b.TenantID),
+		LandlordId:  uint64(b.LandlordID),
+		Kind:        b.Kind,
+		Seq:         b.Seq,
+		PeriodStart: b.PeriodStart.Format(biz.LeaseDateLayout),
+		PeriodEnd:   b.PeriodEnd.Format(biz.LeaseDateLayout),
+		DueDate:     b.DueDate.Format(biz.LeaseDateLayout),
+		Amount:      b.Amount,
+		Items:       leaseChargeInfos(b.Items),
+		LateFee:     b.LateFee,
+		Status:      b.Status,
+		PaidAmount:  b.PaidAmount,
+		Note:        b.Note,
+	}
+	if b.PaidAt != nil {
+		info.PaidAt = b.PaidAt.Unix()
+	}
+	return info
+}
+
+func leaseInfo(l *biz.Lease) *pb.LeaseInfo {
+	info := &pb.LeaseInfo{
+		Id:              uint64(l.ID),
+		HouseId:         uint64(l.HouseID),
+		LandlordId:      uint64(l.LandlordID),
+		TenantId:        uint64(l.TenantID),
+		MonthlyRent:     l.MonthlyRent,
+		DepositMonths:   l.DepositMonths,
+		PayMonths:       l.PayMonths,
+		Deposit:         l.Deposit,
+		StartDate:       l.StartDate.Format(biz.LeaseDateLayout),
+		Months:          l.Months,
+		EndDate:         l.EndDate.Format(biz.LeaseDateLayout),
+		Status:          l.Status,
+		RenewedFrom:     uint64(l.RenewedFrom),
+		RenewalId:       uint64(l.RenewalID),
+		Penalty:         l.Penalty,
+		TerminateReason: l.TerminateReason,
+		Charges:         leaseChargeInfos(l.Charges),
+	}
+	if l.TerminatedAt != nil {
+		info.TerminatedAt = l.TerminatedAt.Format(biz.LeaseDateLayout)
+	}
+	for _, p := range l.Schedule() {
+		info.Schedule = append(info.Schedule, &pb.RentPeriod{
+			Seq:     p.Seq,
+			Start:   p.Start.Format(biz.LeaseDateLayout),
+			End:     p.End.Format(biz.LeaseDateLayout),
+			DueDate: p.DueDate.Format(biz.LeaseDateLayout),
+			Amount:  p.Amount,
+		})
+	}
+	return info
+}
+
+func dealInfo(d *biz.Deal) *pb.DealInfo {
+	info := &pb.DealInfo{
+		Id:      uint64(d.ID),
+		HouseId: uint64(d.HouseID),
+		BuyerId: uint64(d.BuyerID),
+		AgentId: uint64(d.AgentID),
+		Price:   d.Price,
+		Status:  d.Status,
+	}
+	if d.CompletedAt != nil {
+		info.CompletedAt = d.CompletedAt.Unix()
+	}
+	return info
 }
@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	housepb "anjuke/api/house/v3"
+	pointspb "anjuke/api/points/v5"
+	pb "anjuke/api/transaction/v4"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestTransactionService(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		create, complete := call(pb.NewTransactionClient(env.GRPC).CreateTransaction), call(pb.NewTransactionClient(env.GRPC).CompleteTransaction)
+		if transport == "http" {
+			create, complete = call(pb.NewTransactionHTTPClient(env.HTTP).CreateTransaction), call(pb.NewTransactionHTTPClient(env.HTTP).CompleteTransaction)
+		}
+		ctx := context.Background()
+
+		created, err := create(ctx, &pb.CreateTransactionRequest{HouseId: 1, BuyerId: 2, AgentId: 3, Price: 5000000})
+		if err != nil {
+			t.Fatalf("CreateTransaction() error = %v", err)
+		}
+		if created.Deal.Status != 0 || created.Deal.CompletedAt != 0 {
+			t.Fatalf("new deal = %v, want pending", created.Deal)
+		}
+
+		tests := []struct {
+			name       string
+			id         uint64
+			wantReason string
+		}{
+			{"complete", created.Deal.Id, ""},
+			{"complete twice", created.Deal.Id, "DEAL_STATE"},
+			{"unknown deal", 404, "DEAL_NOT_FOUND"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := complete(ctx, &pb.CompleteTransactionRequest{Id: tt.id})
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("CompleteTransaction() error = %v, want reason %s", err, tt.wantReason)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("CompleteTransaction() error = %v", err)
+				}
+				if reply.Deal.Status != 1 || reply.Deal.CompletedAt == 0 {
+					t.Errorf("completed deal = %v", reply.Deal)
+				}
+			})
+		}
+
+		t.Run("invalid", func(t *testing.T) {
+			_, err := create(ctx, &pb.CreateTransactionRequest{HouseId: 1})
+			if errors.Reason(err) != "DEAL_INVALID" {
+				t.Errorf("CreateTransaction() error = %v, want DEAL_INVALID", err)
+			}
+		})
+
+		// 成交事件：买方获得积分，房源标记为已售
+		t.Run("deal completed event", func(t *testing.T) {
+			house, err := housepb.NewHouseClient(env.GRPC).CreateHouse(ctx, &housepb.CreateHouseRequest{
+				House: &housepb.HouseInfo{Title: "保利花园 3室2厅", Area: 100, Price: 6000000},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			d, err := create(ctx, &pb.CreateTransactionRequest{HouseId: house.House.Id, BuyerId: 9, AgentId: 3, Price: 5900000})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := complete(ctx, &pb.CompleteTransactionRequest{Id: d.Deal.Id}); err != nil {
+				t.Fatal(err)
+			}
+			points, err := pointspb.NewPointsClient(env.GRPC).GetPoints(ctx, &pointspb.GetPointsRequest{UserId: 9})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if points.Balance != 1000 {
+				t.Errorf("buyer balance = %d, want 1000", points.Balance)
+			}
+			got, err := housepb.NewHouseClient(env.GRPC).GetHouse(ctx, &housepb.GetHouseRequest{Id: house.House.Id})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.House.Status != 1 {
+				t.Errorf("house status = %d, want sold", got.House.Status)
+			}
+		})
+	})
+}
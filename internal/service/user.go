@@ -3,49 +3,82 @@ package service
 import (
 	v2 "anjuke/api/user/v2"
 	"anjuke/internal/biz"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
 )
 
 type UserService struct {
 	v2.UnimplementedUserServer
-	v2uc *biz.UserUsecase
+	v2uc    *biz.UserUsecase
+	notices *biz.NotificationUsecase
 }
 
-func NewUserService(v2uc *biz.UserUsecase) *UserService {
+func NewUserService(v2uc *biz.UserUsecase, notices *biz.NotificationUsecase) *UserService {
 	return &UserService{
-		v2uc: v2uc,
+		v2uc:    v2uc,
+		notices: notices,
 	}
 }
 
 // todo:用户登录注册一体化
-//func (s *UserService) CreateUser(ctx context.Context, req *v2.CreateUserRequest) (*v2.CreateUserReply, error) {
-//	user, err := s.v2uc.GetUser(ctx, req.Mobile)
-//	if err != nil {
-//		return nil, fmt.Errorf("查询失败: %v", err)
-//	}
-//
-//	// 用户不存在时才创建
-//	if user == nil || user.Mobile == "" {
-//		_, err := s.v2uc.CreateUser(ctx, &biz.User{
-//			Mobile:   req.Mobile,
-//			NickName: req.NickName,
-//			Password: req.Password, // 注意：密码应该加密
-//			Birthday: 0,            // 设置默认值
-//			Gender:   0,            // 设置默认值
-//			Grade:    0,            // 设置默认值
-//		})
-//		if err != nil {
-//			return nil, fmt.Errorf("创建用户失败: %v", err)
-//		}
-//		return &v2.CreateUserReply{
-//			Success: "注册成功",
-//		}, nil
-//	}
-//
-//	// 用户已存在，检查密码
-//	if user.Password != req.Password { // 注意：实际应该对比加密后的密码
-//		return nil, fmt.Errorf("密码错误")
-//	}
-//	return &v2.CreateUserReply{
-//		Success: "登录成功",
-//	}, nil
-//}
+func (s *UserService) CreateUser(ctx context.Context, req *v2.CreateUserRequest) (*v2.CreateUserReply, error) {
+	user, err := s.v2uc.GetUser(ctx, req.Mobile)
+	if err != nil {
+		return nil, fmt.Errorf("查询失败: %v", err)
+	}
+
+	// 用户不存在时才创建
+	if user == nil || user.Mobile == "" {
+		_, err := s.v2uc.CreateUser(ctx, &biz.User{
+			Mobile:   req.Mobile,
+			NickName: req.NickName,
+			Password: req.Password, // 注意：密码应该加密
+			Birthday: 0,            // 设置默认值
+			Gender:   0,            // 设置默认值
+			Grade:    0,            // 设置默认值
+		})
+		if errors.Is(err, biz.ErrContentBlocked) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("创建用户失败: %v", err)
+		}
+		return &v2.CreateUserReply{
+			Success: "注册成功",
+		}, nil
+	}
+
+	// 用户已存在，检查密码
+	ok, err := s.v2uc.CheckPassword(ctx, req.Mobile, req.Password) // 注意：实际应该对比加密后的密码
+	if err != nil {
+		return nil, fmt.Errorf("查询失败: %v", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("密码错误")
+	}
+	return &v2.CreateUserReply{
+		Success: "登录成功",
+	}, nil
+}
+
+func (s *UserService) ListNotifications(ctx context.Context, req *v2.ListNotificationsRequest) (*v2.ListNotificationsReply, error) {
+	list, err := s.notices.ListNotifications(ctx, uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v2.ListNotificationsReply{}
+	for _, n := range list {
+		reply.Notifications = append(reply.Notifications, &v2.NotificationInfo{
+			Id:        uint64(n.ID),
+			Kind:      n.Kind,
+			Title:     n.Title,
+			Content:   n.Content,
+			BizType:   n.BizType,
+			BizId:     uint64(n.BizID),
+			CreatedAt: n.CreatedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
@@ -0,0 +1,42 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	v2 "anjuke/api/user/v2"
+	"anjuke/internal/testutil"
+)
+
+func TestUserService_CreateUser(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		createUser := call(v2.NewUserClient(env.GRPC).CreateUser)
+		if transport == "http" {
+			createUser = call(v2.NewUserHTTPClient(env.HTTP).CreateUser)
+		}
+
+		// 登录注册一体化：首次注册，之后按密码登录
+		tests := []struct {
+			name    string
+			req     *v2.CreateUserRequest
+			want    string
+			wantErr bool
+		}{
+			{"register", &v2.CreateUserRequest{Mobile: "13900000001", NickName: "张三", Password: "secret"}, "注册成功", false},
+			{"login", &v2.CreateUserRequest{Mobile: "13900000001", Password: "secret"}, "登录成功", false},
+			{"wrong password", &v2.CreateUserRequest{Mobile: "13900000001", Password: "bad"}, "", true},
+			{"empty mobile", &v2.CreateUserRequest{Password: "secret"}, "", true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := createUser(context.Background(), tt.req)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("CreateUser() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err == nil && reply.Success != tt.want {
+					t.Errorf("CreateUser() = %q, want %q", reply.Success, tt.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	communitypb "anjuke/api/community/v7"
+	pb "anjuke/api/house/v3"
+	transactionpb "anjuke/api/transaction/v4"
+	"anjuke/internal/testutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestHouseService_EstimatePrice(t *testing.T) {
+	eachTransport(t, func(t *testing.T, env *testutil.Env, transport string) {
+		estimate := call(pb.NewHouseClient(env.GRPC).EstimatePrice)
+		if transport == "http" {
+			estimate = call(pb.NewHouseHTTPClient(env.HTTP).EstimatePrice)
+		}
+		ctx := context.Background()
+
+		communities := communitypb.NewCommunityClient(env.GRPC)
+		var communityIDs []uint64
+		for _, name := range []string{"仁恒河滨城", "世茂滨江花园"} {
+			reply, err := communities.CreateCommunity(ctx, &communitypb.CreateCommunityRequest{Community: &communitypb.CommunityInfo{
+				Name: name, City: "上海", District: "浦东", BuildYear: 2010,
+			}})
+			if err != nil {
+				t.Fatalf("CreateCommunity() error = %v", err)
+			}
+			communityIDs = append(communityIDs, reply.Community.Id)
+		}
+
+		houses := pb.NewHouseClient(env.GRPC)
+		var ids []uint64
+		for _, h := range []*pb.HouseInfo{
+			{Title: "河滨城 两室", CommunityId: communityIDs[0], Area: 80, Floor: 8, TotalFloors: 30, Orientation: "南北", Price: 8000000},
+			{Title: "河滨城 三室", CommunityId: communityIDs[0], Area: 100, Floor: 15, TotalFloors: 30, Orientation: "南", Price: 9500000},
+			{Title: "河滨城 高层", CommunityId: communityIDs[0], Area: 90, Floor: 25, TotalFloors: 30, Orientation: "南北", Price: 9000000},
+			{Title: "河滨城 出租", CommunityId: communityIDs[0], ListingType: 1, Area: 90, Price: 12000},
+		} {
+			reply, err := houses.CreateHouse(ctx, &pb.CreateHouseRequest{House: h})
+			if err != nil {
+				t.Fatalf("CreateHouse() error = %v", err)
+			}
+			ids = append(ids, reply.House.Id)
+		}
+		// 高层那套以873万成交，单价97000
+		deals := transactionpb.NewTransactionClient(env.GRPC)
+		deal, err := deals.CreateTransaction(ctx, &transactionpb.CreateTransactionRequest{HouseId: ids[2], BuyerId: 2, AgentId: 3, Price: 8730000})
+		if err != nil {
+			t.Fatalf("CreateTransaction() error = %v", err)
+		}
+		if _, err := deals.CompleteTransaction(ctx, &transactionpb.CompleteTransactionRequest{Id: deal.Deal.Id}); err != nil {
+			t.Fatalf("CompleteTransaction() error = %v", err)
+		}
+
+		t.Run("community", func(t *testing.T) {
+			reply, err := estimate(ctx, &pb.EstimatePriceRequest{CommunityId: communityIDs[0], Area: 90, Floor: 25, TotalFloors: 30, Orientation: "南北"})
+			if err != nil {
+				t.Fatalf("EstimatePrice() error = %v", err)
+			}
+			if reply.Scope != "community" || len(reply.Comparables) != 3 {
+				t.Fatalf("EstimatePrice() = %s with %v, want 3 comparables in the community", reply.Scope, reply.Comparables)
+			}
+			// 同户型的成交无需修正，权重最高
+			sale := reply.Comparables[0]
+			if sale.Source != "sale" || sale.HouseId != ids[2] || sale.UnitPrice != 97000 || sale.AdjustedUnitPrice != 97000 {
+				t.Errorf("first comparable = %v, want the unadjusted sale", sale)
+			}
+			for _, c := range reply.Comparables[1:] {
+				if c.Source != "listing" || c.Weight >= sale.Weight || c.AdjustedUnitPrice == c.UnitPrice {
+					t.Errorf("comparable = %v, want an adjusted listing weighing less than the sale", c)
+				}
+			}
+			if reply.Price < 90*95000 || reply.Price > 90*100000 || reply.Price%1000 != 0 {
+				t.Errorf("Price = %d, want between the comparables, in thousands", reply.Price)
+			}
+			if !(reply.Low < reply.Price && reply.Price < reply.High) || reply.Confidence <= 0 || reply.Confidence > 100 {
+				t.Errorf("range = [%d, %d], confidence %d, want around %d", reply.Low, reply.High, reply.Confidence, reply.Price)
+			}
+		})
+
+		t.Run("existing house", func(t *testing.T) {
+			reply, err := estimate(ctx, &pb.EstimatePriceRequest{HouseId: ids[0]})
+			if err != nil {
+				t.Fatalf("EstimatePrice() error = %v", err)
+			}
+			for _, c := range reply.Comparables {
+				if c.HouseId == ids[0] {
+					t.Errorf("comparables = %v, want the valued house left out", reply.Comparables)
+				}
+			}
+			if len(reply.Comparables) != 2 {
+				t.Errorf("EstimatePrice() comparables = %v, want 2", reply.Comparables)
+			}
+		})
+
+		tests := []struct {
+			name       string
+			req        *pb.EstimatePriceRequest
+			wantReason string
+			wantScope  string
+		}{
+			// 世茂滨江花园没有案例，扩大到浦东
+			{"widened to district", &pb.EstimatePriceRequest{CommunityId: communityIDs[1], Area: 90}, "", "district"},
+			{"district", &pb.EstimatePriceRequest{City: "上海", District: "浦东", Area: 120, BuildYear: 2000}, "", "district"},
+			{"no comparables", &pb.EstimatePriceRequest{City: "上海", District: "静安", Area: 90}, "NO_COMPARABLES", ""},
+			{"no area", &pb.EstimatePriceRequest{CommunityId: communityIDs[0]}, "VALUATION_INVALID", ""},
+			{"no location", &pb.EstimatePriceRequest{City: "上海", Area: 90}, "VALUATION_INVALID", ""},
+			{"unknown house", &pb.EstimatePriceRequest{HouseId: ids[3] + 100}, "HOUSE_NOT_FOUND", ""},
+			{"unknown community", &pb.EstimatePriceRequest{CommunityId: communityIDs[1] + 100, Area: 90}, "COMMUNITY_NOT_FOUND", ""},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				reply, err := estimate(ctx, tt.req)
+				if tt.wantReason != "" {
+					if errors.Reason(err) != tt.wantReason {
+						t.Fatalf("EstimatePrice() error = %v, want reason %s", err, tt.wantReason)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("EstimatePrice() error = %v", err)
+				}
+				if reply.Scope != tt.wantScope || len(reply.Comparables) != 3 {
+					t.Errorf("EstimatePrice() = %s with %d comparables, want %s with 3", reply.Scope, len(reply.Comparables), tt.wantScope)
+				}
+			})
+		}
+	})
+}
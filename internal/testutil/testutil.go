@@ -0,0 +1,104 @@
+// Package testutil starts the gRPC and HTTP servers on the in-memory repos
+// from package memory, for service-level tests through the generated clients.
+package testutil
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"anjuke/internal/conf"
+	"anjuke/internal/server"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type servers struct {
+	grpc *kgrpc.Server
+	http *khttp.Server
+}
+
+func newServers(gs *kgrpc.Server, hs *khttp.Server) *servers {
+	return &servers{grpc: gs, http: hs}
+}
+
+// AdminToken is the operator token of the test servers; the Env clients
+// send it unless the call context is marked by Anonymous.
+const AdminToken = "test-admin-token-0123"
+
+type anonymousKey struct{}
+
+// Anonymous marks ctx so the Env clients call without the operator token.
+func Anonymous(ctx context.Context) context.Context {
+	return context.WithValue(ctx, anonymousKey{}, true)
+}
+
+// operatorToken is a client middleware sending AdminToken.
+func operatorToken(handler middleware.Handler) middleware.Handler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		if tr, ok := transport.FromClientContext(ctx); ok && ctx.Value(anonymousKey{}) == nil {
+			tr.RequestHeader().Set(server.AdminTokenHeader, AdminToken)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Env is a running server graph with clients connected to both transports.
+type Env struct {
+	GRPC *grpc.ClientConn
+	HTTP *khttp.Client
+}
+
+// NewEnv starts the servers on random local ports and stops them, together
+// with the clients, when the test finishes.
+func NewEnv(t testing.TB) *Env {
+	t.Helper()
+	c := &conf.Server{
+		Http:  &conf.Server_HTTP{Addr: "127.0.0.1:0", Timeout: durationpb.New(5 * time.Second)},
+		Grpc:  &conf.Server_GRPC{Addr: "127.0.0.1:0", Timeout: durationpb.New(5 * time.Second)},
+		Admin: &conf.Server_Admin{Token: AdminToken},
+	}
+	srv, cleanup, err := wireServers(c, conf.NewDynamic(&conf.Bootstrap{Server: c}), log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+
+	ctx := context.Background()
+	for _, s := range []interface {
+		Start(context.Context) error
+		Stop(context.Context) error
+		Endpoint() (*url.URL, error)
+	}{srv.grpc, srv.http} {
+		// Endpoint listens, so the port is known before Start.
+		if _, err := s.Endpoint(); err != nil {
+			t.Fatal(err)
+		}
+		s := s
+		go func() { _ = s.Start(ctx) }()
+		t.Cleanup(func() { _ = s.Stop(ctx) })
+	}
+
+	ge, _ := srv.grpc.Endpoint()
+	conn, err := kgrpc.DialInsecure(ctx, kgrpc.WithEndpoint(ge.Host), kgrpc.WithMiddleware(operatorToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	he, _ := srv.http.Endpoint()
+	hc, err := khttp.NewClient(ctx, khttp.WithEndpoint(he.Host), khttp.WithMiddleware(operatorToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = hc.Close() })
+
+	return &Env{GRPC: conn, HTTP: hc}
+}
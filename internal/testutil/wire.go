@@ -0,0 +1,22 @@
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package testutil
+
+import (
+	"anjuke/internal/biz"
+	"anjuke/internal/conf"
+	"anjuke/internal/data/memory"
+	"anjuke/internal/server"
+	"anjuke/internal/service"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireServers builds the full service graph on the in-memory repos.
+func wireServers(*conf.Server, *conf.Dynamic, log.Logger) (*servers, func(), error) {
+	panic(wire.Build(memory.ProviderSet, biz.ProviderSet, service.ProviderSet, server.ProviderSet, newServers))
+}